@@ -24,9 +24,12 @@ val services = mapOf(
     "autoscalingplans" to ServiceSpec("Auto Scaling Plans"),
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
+    "bedrock" to ServiceSpec("Bedrock"),
+    "bedrockagent" to ServiceSpec("Agents for Amazon Bedrock"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chime" to ServiceSpec("Chime"),
+    "cleanrooms" to ServiceSpec("Clean Rooms"),
     "cloud9" to ServiceSpec("Cloud9"),
     "cloudcontrol" to ServiceSpec("Cloud Control API"),
     "cloudformation" to ServiceSpec("CloudFormation", vpcLock = true),
@@ -53,6 +56,7 @@ val services = mapOf(
     "datapipeline" to ServiceSpec("Data Pipeline"),
     "datasync" to ServiceSpec("DataSync", vpcLock = true),
     "dax" to ServiceSpec("DynamoDB Accelerator (DAX)"),
+    "deadline" to ServiceSpec("Deadline Cloud"),
     "deploy" to ServiceSpec("CodeDeploy"),
     "detective" to ServiceSpec("Detective"),
     "devicefarm" to ServiceSpec("Device Farm"),
@@ -60,6 +64,7 @@ val services = mapOf(
     "dlm" to ServiceSpec("DLM (Data Lifecycle Manager)"),
     "dms" to ServiceSpec("DMS (Database Migration)", vpcLock = true),
     "docdb" to ServiceSpec("DocDB (DocumentDB)", vpcLock = true),
+    "drs" to ServiceSpec("DRS (Elastic Disaster Recovery)"),
     "ds" to ServiceSpec("DS (Directory Service)", vpcLock = true),
     "dynamodb" to ServiceSpec("DynamoDB"),
     "ec2" to ServiceSpec("EC2 (Elastic Compute Cloud)", vpcLock = true),
@@ -77,11 +82,13 @@ val services = mapOf(
     "emr" to ServiceSpec("EMR", vpcLock = true),
     "emrcontainers" to ServiceSpec("EMR Containers"),
     "emrserverless" to ServiceSpec("EMR Serverless"),
+    "entityresolution" to ServiceSpec("Entity Resolution"),
     "events" to ServiceSpec("EventBridge"),
     "evidently" to ServiceSpec("CloudWatch Evidently"),
     "firehose" to ServiceSpec("Kinesis Firehose"),
     "fis" to ServiceSpec("FIS (Fault Injection Simulator)"),
     "fms" to ServiceSpec("FMS (Firewall Manager)"),
+    "forecast" to ServiceSpec("Forecast"),
     "fsx" to ServiceSpec("FSx", vpcLock = true),
     "gamelift" to ServiceSpec("GameLift"),
     "glacier" to ServiceSpec("S3 Glacier"),
@@ -89,7 +96,9 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "greengrassv2" to ServiceSpec("IoT Greengrass V2"),
     "guardduty" to ServiceSpec("GuardDuty"),
+    "healthlake" to ServiceSpec("HealthLake"),
     "iam" to ServiceSpec("IAM (Identity & Access Management)"),
     "identitystore" to ServiceSpec("SSO Identity Store"),
     "imagebuilder" to ServiceSpec("EC2 Image Builder"),
@@ -98,8 +107,11 @@ val services = mapOf(
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
     "iotevents" to ServiceSpec("IoT Events"),
+    "iotsitewise" to ServiceSpec("IoT SiteWise"),
+    "iottwinmaker" to ServiceSpec("IoT TwinMaker"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
+    "ivsrealtime" to ServiceSpec("IVS (Interactive Video) Real-Time"),
     "kafka" to ServiceSpec("Managed Streaming for Kafka", vpcLock = true),
     "kafkaconnect" to ServiceSpec("Managed Streaming for Kafka Connect"),
     "keyspaces" to ServiceSpec("Keyspaces (for Apache Cassandra)"),
@@ -109,31 +121,39 @@ val services = mapOf(
     "lakeformation" to ServiceSpec("Lake Formation"),
     "lambda" to ServiceSpec("Lambda", vpcLock = true),
     "lexmodels" to ServiceSpec("Lex Model Building"),
+    "lexv2models" to ServiceSpec("Lex Models V2"),
     "licensemanager" to ServiceSpec("License Manager"),
     "lightsail" to ServiceSpec("Lightsail"),
     "location" to ServiceSpec("Location"),
     "logs" to ServiceSpec("CloudWatch Logs"),
-    "macie" to ServiceSpec("Macie Classic"),
     "macie2" to ServiceSpec("Macie"),
+    "managedblockchain" to ServiceSpec("Managed Blockchain"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
     "mediapackage" to ServiceSpec("Elemental MediaPackage"),
+    "mediapackagev2" to ServiceSpec("Elemental MediaPackage Version 2"),
     "mediastore" to ServiceSpec("Elemental MediaStore"),
     "memorydb" to ServiceSpec("MemoryDB for Redis"),
+    "mgn" to ServiceSpec("Application Migration (Mgn)"),
     "mq" to ServiceSpec("MQ", vpcLock = true),
     "mwaa" to ServiceSpec("MWAA (Managed Workflows for Apache Airflow)", vpcLock = true),
     "neptune" to ServiceSpec("Neptune"),
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager"),
+    "omics" to ServiceSpec("Omics"),
     "opensearch" to ServiceSpec("OpenSearch"),
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
     "organizations" to ServiceSpec("Organizations"),
     "outposts" to ServiceSpec("Outposts"),
+    "paymentcryptography" to ServiceSpec("Payment Cryptography Control Plane"),
+    "personalize" to ServiceSpec("Personalize"),
     "pinpoint" to ServiceSpec("Pinpoint"),
     "pipes" to ServiceSpec("EventBridge Pipes"),
+    "polly" to ServiceSpec("Polly"),
     "pricing" to ServiceSpec("Pricing Calculator"),
+    "qbusiness" to ServiceSpec("Q Business"),
     "qldb" to ServiceSpec("QLDB (Quantum Ledger Database)"),
     "quicksight" to ServiceSpec("QuickSight"),
     "ram" to ServiceSpec("RAM (Resource Access Manager)"),
@@ -141,6 +161,8 @@ val services = mapOf(
     "redshift" to ServiceSpec("Redshift", vpcLock = true),
     "redshiftdata" to ServiceSpec("Redshift Data"),
     "redshiftserverless" to ServiceSpec("Redshift Serverless"),
+    "rekognition" to ServiceSpec("Rekognition"),
+    "resiliencehub" to ServiceSpec("Resilience Hub"),
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
@@ -169,21 +191,28 @@ val services = mapOf(
     "shield" to ServiceSpec("Shield"),
     "signer" to ServiceSpec("Signer"),
     "simpledb" to ServiceSpec("SDB (SimpleDB)"),
+    "simspaceweaver" to ServiceSpec("SimSpace Weaver"),
     "sns" to ServiceSpec("SNS (Simple Notification)"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
+    "ssmcontacts" to ServiceSpec("SSM Incident Manager Contacts"),
+    "ssmincidents" to ServiceSpec("SSM Incident Manager Incidents"),
     "ssoadmin" to ServiceSpec("SSO Admin"),
     "storagegateway" to ServiceSpec("Storage Gateway", vpcLock = true),
     "sts" to ServiceSpec("STS (Security Token)"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
+    "textract" to ServiceSpec("Textract"),
+    "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB"),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
+    "verifiedpermissions" to ServiceSpec("Verified Permissions"),
     "waf" to ServiceSpec("WAF Classic"),
     "wafregional" to ServiceSpec("WAF Classic Regional"),
     "wafv2" to ServiceSpec("WAF"),
     "worklink" to ServiceSpec("WorkLink"),
     "workspaces" to ServiceSpec("WorkSpaces", vpcLock = true),
+    "workspacesweb" to ServiceSpec("WorkSpaces Web"),
     "xray" to ServiceSpec("X-Ray"),
 )
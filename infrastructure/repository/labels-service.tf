@@ -5,7 +5,6 @@ variable "service_labels" {
     "account",
     "acm",
     "acmpca",
-    "alexaforbusiness",
     "amp",
     "amplify",
     "amplifybackend",
@@ -31,6 +30,8 @@ variable "service_labels" {
     "backup",
     "backupgateway",
     "batch",
+    "bedrock",
+    "bedrockagent",
     "billingconductor",
     "braket",
     "budgets",
@@ -39,6 +40,7 @@ variable "service_labels" {
     "chimesdkidentity",
     "chimesdkmeetings",
     "chimesdkmessaging",
+    "cleanrooms",
     "cloud9",
     "cloudcontrol",
     "clouddirectory",
@@ -76,6 +78,7 @@ variable "service_labels" {
     "datapipeline",
     "datasync",
     "dax",
+    "deadline",
     "deploy",
     "detective",
     "devicefarm",
@@ -109,6 +112,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "emrserverless",
+    "entityresolution",
     "events",
     "evidently",
     "finspace",
@@ -131,7 +135,6 @@ variable "service_labels" {
     "guardduty",
     "health",
     "healthlake",
-    "honeycode",
     "iam",
     "identitystore",
     "imagebuilder",
@@ -155,6 +158,7 @@ variable "service_labels" {
     "ipam",
     "ivs",
     "ivschat",
+    "ivsrealtime",
     "kafka",
     "kafkaconnect",
     "kendra",
@@ -170,9 +174,9 @@ variable "service_labels" {
     "lakeformation",
     "lambda",
     "lexmodels",
-    "lexmodelsv2",
     "lexruntime",
     "lexruntimev2",
+    "lexv2models",
     "licensemanager",
     "lightsail",
     "location",
@@ -192,6 +196,7 @@ variable "service_labels" {
     "mediaconvert",
     "medialive",
     "mediapackage",
+    "mediapackagev2",
     "mediapackagevod",
     "mediastore",
     "mediastoredata",
@@ -203,7 +208,6 @@ variable "service_labels" {
     "migrationhubconfig",
     "migrationhubrefactorspaces",
     "migrationhubstrategy",
-    "mobile",
     "mq",
     "mturk",
     "mwaa",
@@ -211,6 +215,7 @@ variable "service_labels" {
     "networkfirewall",
     "networkmanager",
     "nimble",
+    "omics",
     "opensearch",
     "opensearchserverless",
     "opsworks",
@@ -218,6 +223,7 @@ variable "service_labels" {
     "organizations",
     "outposts",
     "panorama",
+    "paymentcryptography",
     "personalize",
     "personalizeevents",
     "personalizeruntime",
@@ -229,6 +235,7 @@ variable "service_labels" {
     "polly",
     "pricing",
     "proton",
+    "qbusiness",
     "qldb",
     "qldbsession",
     "quicksight",
@@ -277,6 +284,7 @@ variable "service_labels" {
     "shield",
     "signer",
     "simpledb",
+    "simspaceweaver",
     "sms",
     "snowball",
     "snowdevicemanagement",
@@ -294,6 +302,7 @@ variable "service_labels" {
     "swf",
     "synthetics",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
     "transcribe",
@@ -301,6 +310,7 @@ variable "service_labels" {
     "transfer",
     "transitgateway",
     "translate",
+    "verifiedpermissions",
     "voiceid",
     "vpc",
     "vpnclient",
@@ -33,7 +33,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
 	"github.com/aws/aws-sdk-go/service/amplifyuibuilder"
@@ -60,6 +59,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/backupgateway"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
 	"github.com/aws/aws-sdk-go/service/billingconductor"
 	"github.com/aws/aws-sdk-go/service/braket"
 	"github.com/aws/aws-sdk-go/service/budgets"
@@ -67,6 +68,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/chimesdkidentity"
 	"github.com/aws/aws-sdk-go/service/chimesdkmeetings"
 	"github.com/aws/aws-sdk-go/service/chimesdkmessaging"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
 	"github.com/aws/aws-sdk-go/service/cloud9"
 	"github.com/aws/aws-sdk-go/service/clouddirectory"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -107,6 +109,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/datapipeline"
 	"github.com/aws/aws-sdk-go/service/datasync"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/deadline"
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/devopsguru"
@@ -135,6 +138,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/emr"
 	"github.com/aws/aws-sdk-go/service/emrcontainers"
 	"github.com/aws/aws-sdk-go/service/emrserverless"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/aws/aws-sdk-go/service/finspace"
 	"github.com/aws/aws-sdk-go/service/finspacedata"
@@ -155,7 +159,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/aws/aws-sdk-go/service/healthlake"
-	"github.com/aws/aws-sdk-go/service/honeycode"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
@@ -175,6 +178,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iottwinmaker"
 	"github.com/aws/aws-sdk-go/service/iotwireless"
 	"github.com/aws/aws-sdk-go/service/ivs"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/aws/aws-sdk-go/service/kafkaconnect"
 	"github.com/aws/aws-sdk-go/service/keyspaces"
@@ -199,7 +203,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -210,6 +213,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/mediaconnect"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
 	"github.com/aws/aws-sdk-go/service/mediapackagevod"
 	"github.com/aws/aws-sdk-go/service/mediastore"
 	"github.com/aws/aws-sdk-go/service/mediastoredata"
@@ -220,7 +224,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
 	"github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces"
 	"github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations"
-	"github.com/aws/aws-sdk-go/service/mobile"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mturk"
 	"github.com/aws/aws-sdk-go/service/mwaa"
@@ -228,12 +231,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/omics"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/outposts"
 	"github.com/aws/aws-sdk-go/service/panorama"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
 	"github.com/aws/aws-sdk-go/service/personalize"
 	"github.com/aws/aws-sdk-go/service/personalizeevents"
 	"github.com/aws/aws-sdk-go/service/personalizeruntime"
@@ -245,6 +250,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/pricing"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
 	"github.com/aws/aws-sdk-go/service/proton"
+	"github.com/aws/aws-sdk-go/service/qbusiness"
 	"github.com/aws/aws-sdk-go/service/qldb"
 	"github.com/aws/aws-sdk-go/service/qldbsession"
 	"github.com/aws/aws-sdk-go/service/quicksight"
@@ -285,6 +291,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/shield"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
 	"github.com/aws/aws-sdk-go/service/sms"
 	"github.com/aws/aws-sdk-go/service/snowball"
 	"github.com/aws/aws-sdk-go/service/snowdevicemanagement"
@@ -301,11 +308,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
 	"github.com/aws/aws-sdk-go/service/timestreamquery"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transcribestreamingservice"
 	"github.com/aws/aws-sdk-go/service/transfer"
 	"github.com/aws/aws-sdk-go/service/translate"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
 	"github.com/aws/aws-sdk-go/service/voiceid"
 	"github.com/aws/aws-sdk-go/service/waf"
 	"github.com/aws/aws-sdk-go/service/wafregional"
@@ -351,7 +360,6 @@ type AWSClient struct {
 	apigatewayv2Conn                 *apigatewayv2.ApiGatewayV2
 	accessanalyzerConn               *accessanalyzer.AccessAnalyzer
 	accountConn                      *account.Account
-	alexaforbusinessConn             *alexaforbusiness.AlexaForBusiness
 	amplifyConn                      *amplify.Amplify
 	amplifybackendConn               *amplifybackend.AmplifyBackend
 	amplifyuibuilderConn             *amplifyuibuilder.AmplifyUIBuilder
@@ -373,6 +381,8 @@ type AWSClient struct {
 	backupConn                       *backup.Backup
 	backupgatewayConn                *backupgateway.BackupGateway
 	batchConn                        *batch.Batch
+	bedrockConn                      *bedrock.Bedrock
+	bedrockagentConn                 *bedrockagent.BedrockAgent
 	billingconductorConn             *billingconductor.BillingConductor
 	braketConn                       *braket.Braket
 	budgetsConn                      *budgets.Budgets
@@ -382,6 +392,7 @@ type AWSClient struct {
 	chimesdkidentityConn             *chimesdkidentity.ChimeSDKIdentity
 	chimesdkmeetingsConn             *chimesdkmeetings.ChimeSDKMeetings
 	chimesdkmessagingConn            *chimesdkmessaging.ChimeSDKMessaging
+	cleanroomsConn                   *cleanrooms.CleanRooms
 	cloud9Conn                       *cloud9.Cloud9
 	cloudcontrolClient               *cloudcontrol.Client
 	clouddirectoryConn               *clouddirectory.CloudDirectory
@@ -422,6 +433,7 @@ type AWSClient struct {
 	dataexchangeConn                 *dataexchange.DataExchange
 	datapipelineConn                 *datapipeline.DataPipeline
 	datasyncConn                     *datasync.DataSync
+	deadlineConn                     *deadline.Deadline
 	deployConn                       *codedeploy.CodeDeploy
 	detectiveConn                    *detective.Detective
 	devopsguruConn                   *devopsguru.DevOpsGuru
@@ -449,6 +461,7 @@ type AWSClient struct {
 	elasticinferenceConn             *elasticinference.ElasticInference
 	elastictranscoderConn            *elastictranscoder.ElasticTranscoder
 	esConn                           *elasticsearchservice.ElasticsearchService
+	entityresolutionConn             *entityresolution.EntityResolution
 	eventsConn                       *eventbridge.EventBridge
 	evidentlyConn                    *cloudwatchevidently.CloudWatchEvidently
 	fisClient                        *fis.Client
@@ -471,10 +484,10 @@ type AWSClient struct {
 	guarddutyConn                    *guardduty.GuardDuty
 	healthConn                       *health.Health
 	healthlakeConn                   *healthlake.HealthLake
-	honeycodeConn                    *honeycode.Honeycode
 	iamConn                          *iam.IAM
 	ivsConn                          *ivs.IVS
 	ivschatClient                    *ivschat.Client
+	ivsrealtimeConn                  *ivsrealtime.IVSRealTime
 	identitystoreClient              *identitystore.Client
 	imagebuilderConn                 *imagebuilder.Imagebuilder
 	inspectorConn                    *inspector.Inspector
@@ -523,7 +536,6 @@ type AWSClient struct {
 	mturkConn                        *mturk.MTurk
 	mwaaConn                         *mwaa.MWAA
 	machinelearningConn              *machinelearning.MachineLearning
-	macieConn                        *macie.Macie
 	macie2Conn                       *macie2.Macie2
 	managedblockchainConn            *managedblockchain.ManagedBlockchain
 	marketplacecatalogConn           *marketplacecatalog.MarketplaceCatalog
@@ -534,6 +546,7 @@ type AWSClient struct {
 	mediaconvertConn                 *mediaconvert.MediaConvert
 	medialiveClient                  *medialive.Client
 	mediapackageConn                 *mediapackage.MediaPackage
+	mediapackagev2Conn               *mediapackagev2.MediaPackageV2
 	mediapackagevodConn              *mediapackagevod.MediaPackageVod
 	mediastoreConn                   *mediastore.MediaStore
 	mediastoredataConn               *mediastoredata.MediaStoreData
@@ -544,11 +557,11 @@ type AWSClient struct {
 	migrationhubconfigConn           *migrationhubconfig.MigrationHubConfig
 	migrationhubrefactorspacesConn   *migrationhubrefactorspaces.MigrationHubRefactorSpaces
 	migrationhubstrategyConn         *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations
-	mobileConn                       *mobile.Mobile
 	neptuneConn                      *neptune.Neptune
 	networkfirewallConn              *networkfirewall.NetworkFirewall
 	networkmanagerConn               *networkmanager.NetworkManager
 	nimbleConn                       *nimblestudio.NimbleStudio
+	omicsConn                        *omics.Omics
 	opensearchConn                   *opensearchservice.OpenSearchService
 	opensearchserverlessClient       *opensearchserverless.Client
 	opsworksConn                     *opsworks.OpsWorks
@@ -557,6 +570,7 @@ type AWSClient struct {
 	outpostsConn                     *outposts.Outposts
 	piConn                           *pi.PI
 	panoramaConn                     *panorama.Panorama
+	paymentcryptographyConn          *paymentcryptography.PaymentCryptography
 	personalizeConn                  *personalize.Personalize
 	personalizeeventsConn            *personalizeevents.PersonalizeEvents
 	personalizeruntimeConn           *personalizeruntime.PersonalizeRuntime
@@ -567,6 +581,7 @@ type AWSClient struct {
 	pollyConn                        *polly.Polly
 	pricingConn                      *pricing.Pricing
 	protonConn                       *proton.Proton
+	qbusinessConn                    *qbusiness.QBusiness
 	qldbConn                         *qldb.QLDB
 	qldbsessionConn                  *qldbsession.QLDBSession
 	quicksightConn                   *quicksight.QuickSight
@@ -625,6 +640,7 @@ type AWSClient struct {
 	servicequotasConn                *servicequotas.ServiceQuotas
 	shieldConn                       *shield.Shield
 	signerConn                       *signer.Signer
+	simspaceweaverConn               *simspaceweaver.SimSpaceWeaver
 	sdbConn                          *simpledb.SimpleDB
 	snowdevicemanagementConn         *snowdevicemanagement.SnowDeviceManagement
 	snowballConn                     *snowball.Snowball
@@ -632,12 +648,14 @@ type AWSClient struct {
 	supportConn                      *support.Support
 	syntheticsConn                   *synthetics.Synthetics
 	textractConn                     *textract.Textract
+	timestreaminfluxdbConn           *timestreaminfluxdb.TimestreamInfluxDB
 	timestreamqueryConn              *timestreamquery.TimestreamQuery
 	timestreamwriteConn              *timestreamwrite.TimestreamWrite
 	transcribeClient                 *transcribe.Client
 	transcribestreamingConn          *transcribestreamingservice.TranscribeStreamingService
 	transferConn                     *transfer.Transfer
 	translateConn                    *translate.Translate
+	verifiedpermissionsConn          *verifiedpermissions.VerifiedPermissions
 	voiceidConn                      *voiceid.VoiceID
 	wafConn                          *waf.WAF
 	wafregionalConn                  *wafregional.WAFRegional
@@ -687,10 +705,6 @@ func (client *AWSClient) AccountConn() *account.Account {
 	return client.accountConn
 }
 
-func (client *AWSClient) AlexaForBusinessConn() *alexaforbusiness.AlexaForBusiness {
-	return client.alexaforbusinessConn
-}
-
 func (client *AWSClient) AmplifyConn() *amplify.Amplify {
 	return client.amplifyConn
 }
@@ -775,6 +789,14 @@ func (client *AWSClient) BatchConn() *batch.Batch {
 	return client.batchConn
 }
 
+func (client *AWSClient) BedrockConn() *bedrock.Bedrock {
+	return client.bedrockConn
+}
+
+func (client *AWSClient) BedrockAgentConn() *bedrockagent.BedrockAgent {
+	return client.bedrockagentConn
+}
+
 func (client *AWSClient) BillingConductorConn() *billingconductor.BillingConductor {
 	return client.billingconductorConn
 }
@@ -811,6 +833,10 @@ func (client *AWSClient) ChimeSDKMessagingConn() *chimesdkmessaging.ChimeSDKMess
 	return client.chimesdkmessagingConn
 }
 
+func (client *AWSClient) CleanRoomsConn() *cleanrooms.CleanRooms {
+	return client.cleanroomsConn
+}
+
 func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
 	return client.cloud9Conn
 }
@@ -971,6 +997,10 @@ func (client *AWSClient) DataSyncConn() *datasync.DataSync {
 	return client.datasyncConn
 }
 
+func (client *AWSClient) DeadlineConn() *deadline.Deadline {
+	return client.deadlineConn
+}
+
 func (client *AWSClient) DeployConn() *codedeploy.CodeDeploy {
 	return client.deployConn
 }
@@ -1083,6 +1113,10 @@ func (client *AWSClient) ElasticsearchConn() *elasticsearchservice.Elasticsearch
 	return client.esConn
 }
 
+func (client *AWSClient) EntityResolutionConn() *entityresolution.EntityResolution {
+	return client.entityresolutionConn
+}
+
 func (client *AWSClient) EventsConn() *eventbridge.EventBridge {
 	return client.eventsConn
 }
@@ -1171,10 +1205,6 @@ func (client *AWSClient) HealthLakeConn() *healthlake.HealthLake {
 	return client.healthlakeConn
 }
 
-func (client *AWSClient) HoneycodeConn() *honeycode.Honeycode {
-	return client.honeycodeConn
-}
-
 func (client *AWSClient) IAMConn() *iam.IAM {
 	return client.iamConn
 }
@@ -1187,6 +1217,10 @@ func (client *AWSClient) IVSChatClient() *ivschat.Client {
 	return client.ivschatClient
 }
 
+func (client *AWSClient) IVSRealTimeConn() *ivsrealtime.IVSRealTime {
+	return client.ivsrealtimeConn
+}
+
 func (client *AWSClient) IdentityStoreClient() *identitystore.Client {
 	return client.identitystoreClient
 }
@@ -1383,10 +1417,6 @@ func (client *AWSClient) MachineLearningConn() *machinelearning.MachineLearning
 	return client.machinelearningConn
 }
 
-func (client *AWSClient) MacieConn() *macie.Macie {
-	return client.macieConn
-}
-
 func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
 	return client.macie2Conn
 }
@@ -1427,6 +1457,10 @@ func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
 	return client.mediapackageConn
 }
 
+func (client *AWSClient) MediaPackageV2Conn() *mediapackagev2.MediaPackageV2 {
+	return client.mediapackagev2Conn
+}
+
 func (client *AWSClient) MediaPackageVODConn() *mediapackagevod.MediaPackageVod {
 	return client.mediapackagevodConn
 }
@@ -1467,10 +1501,6 @@ func (client *AWSClient) MigrationHubStrategyConn() *migrationhubstrategyrecomme
 	return client.migrationhubstrategyConn
 }
 
-func (client *AWSClient) MobileConn() *mobile.Mobile {
-	return client.mobileConn
-}
-
 func (client *AWSClient) NeptuneConn() *neptune.Neptune {
 	return client.neptuneConn
 }
@@ -1487,6 +1517,10 @@ func (client *AWSClient) NimbleConn() *nimblestudio.NimbleStudio {
 	return client.nimbleConn
 }
 
+func (client *AWSClient) OmicsConn() *omics.Omics {
+	return client.omicsConn
+}
+
 func (client *AWSClient) OpenSearchConn() *opensearchservice.OpenSearchService {
 	return client.opensearchConn
 }
@@ -1519,6 +1553,10 @@ func (client *AWSClient) PanoramaConn() *panorama.Panorama {
 	return client.panoramaConn
 }
 
+func (client *AWSClient) PaymentCryptographyConn() *paymentcryptography.PaymentCryptography {
+	return client.paymentcryptographyConn
+}
+
 func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
 	return client.personalizeConn
 }
@@ -1559,6 +1597,10 @@ func (client *AWSClient) ProtonConn() *proton.Proton {
 	return client.protonConn
 }
 
+func (client *AWSClient) QBusinessConn() *qbusiness.QBusiness {
+	return client.qbusinessConn
+}
+
 func (client *AWSClient) QLDBConn() *qldb.QLDB {
 	return client.qldbConn
 }
@@ -1803,6 +1845,10 @@ func (client *AWSClient) SignerConn() *signer.Signer {
 	return client.signerConn
 }
 
+func (client *AWSClient) SimSpaceWeaverConn() *simspaceweaver.SimSpaceWeaver {
+	return client.simspaceweaverConn
+}
+
 func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
 	return client.sdbConn
 }
@@ -1831,6 +1877,10 @@ func (client *AWSClient) TextractConn() *textract.Textract {
 	return client.textractConn
 }
 
+func (client *AWSClient) TimestreamInfluxDBConn() *timestreaminfluxdb.TimestreamInfluxDB {
+	return client.timestreaminfluxdbConn
+}
+
 func (client *AWSClient) TimestreamQueryConn() *timestreamquery.TimestreamQuery {
 	return client.timestreamqueryConn
 }
@@ -1855,6 +1905,10 @@ func (client *AWSClient) TranslateConn() *translate.Translate {
 	return client.translateConn
 }
 
+func (client *AWSClient) VerifiedPermissionsConn() *verifiedpermissions.VerifiedPermissions {
+	return client.verifiedpermissionsConn
+}
+
 func (client *AWSClient) VoiceIDConn() *voiceid.VoiceID {
 	return client.voiceidConn
 }
@@ -39,9 +39,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
@@ -67,6 +70,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
@@ -74,6 +78,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -91,26 +96,33 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/forecast"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ivsrealtime"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -123,27 +135,36 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/omics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/polly"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
@@ -151,6 +172,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
@@ -176,21 +199,28 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/simspaceweaver"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/textract"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -532,83 +562,84 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_dynamodb_table":      dynamodb.DataSourceTable(),
 			"aws_dynamodb_table_item": dynamodb.DataSourceTableItem(),
 
-			"aws_ami":                                        ec2.DataSourceAMI(),
-			"aws_ami_ids":                                    ec2.DataSourceAMIIDs(),
-			"aws_availability_zone":                          ec2.DataSourceAvailabilityZone(),
-			"aws_availability_zones":                         ec2.DataSourceAvailabilityZones(),
-			"aws_customer_gateway":                           ec2.DataSourceCustomerGateway(),
-			"aws_ebs_default_kms_key":                        ec2.DataSourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                  ec2.DataSourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                               ec2.DataSourceEBSSnapshot(),
-			"aws_ebs_snapshot_ids":                           ec2.DataSourceEBSSnapshotIDs(),
-			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
-			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
-			"aws_ec2_client_vpn_endpoint":                    ec2.DataSourceClientVPNEndpoint(),
-			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
-			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
-			"aws_ec2_host":                                   ec2.DataSourceHost(),
-			"aws_ec2_instance_type_offering":                 ec2.DataSourceInstanceTypeOffering(),
-			"aws_ec2_instance_type_offerings":                ec2.DataSourceInstanceTypeOfferings(),
-			"aws_ec2_instance_type":                          ec2.DataSourceInstanceType(),
-			"aws_ec2_instance_types":                         ec2.DataSourceInstanceTypes(),
-			"aws_ec2_local_gateway_route_table":              ec2.DataSourceLocalGatewayRouteTable(),
-			"aws_ec2_local_gateway_route_tables":             ec2.DataSourceLocalGatewayRouteTables(),
-			"aws_ec2_local_gateway_virtual_interface":        ec2.DataSourceLocalGatewayVirtualInterface(),
-			"aws_ec2_local_gateway_virtual_interface_group":  ec2.DataSourceLocalGatewayVirtualInterfaceGroup(),
-			"aws_ec2_local_gateway_virtual_interface_groups": ec2.DataSourceLocalGatewayVirtualInterfaceGroups(),
-			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
-			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
-			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_lists":                   ec2.DataSourceManagedPrefixLists(),
-			"aws_ec2_network_insights_analysis":              ec2.DataSourceNetworkInsightsAnalysis(),
-			"aws_ec2_network_insights_path":                  ec2.DataSourceNetworkInsightsPath(),
-			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
-			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
-			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
-			"aws_ec2_transit_gateway_attachment":             ec2.DataSourceTransitGatewayAttachment(),
-			"aws_ec2_transit_gateway_connect":                ec2.DataSourceTransitGatewayConnect(),
-			"aws_ec2_transit_gateway_connect_peer":           ec2.DataSourceTransitGatewayConnectPeer(),
-			"aws_ec2_transit_gateway_dx_gateway_attachment":  ec2.DataSourceTransitGatewayDxGatewayAttachment(),
-			"aws_ec2_transit_gateway_multicast_domain":       ec2.DataSourceTransitGatewayMulticastDomain(),
-			"aws_ec2_transit_gateway_peering_attachment":     ec2.DataSourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_route_table":            ec2.DataSourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_tables":           ec2.DataSourceTransitGatewayRouteTables(),
-			"aws_ec2_transit_gateway_vpc_attachment":         ec2.DataSourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachments":        ec2.DataSourceTransitGatewayVPCAttachments(),
-			"aws_ec2_transit_gateway_vpn_attachment":         ec2.DataSourceTransitGatewayVPNAttachment(),
-			"aws_eip":                                        ec2.DataSourceEIP(),
-			"aws_eips":                                       ec2.DataSourceEIPs(),
-			"aws_instance":                                   ec2.DataSourceInstance(),
-			"aws_instances":                                  ec2.DataSourceInstances(),
-			"aws_internet_gateway":                           ec2.DataSourceInternetGateway(),
-			"aws_key_pair":                                   ec2.DataSourceKeyPair(),
-			"aws_launch_template":                            ec2.DataSourceLaunchTemplate(),
-			"aws_nat_gateway":                                ec2.DataSourceNATGateway(),
-			"aws_nat_gateways":                               ec2.DataSourceNATGateways(),
-			"aws_network_acls":                               ec2.DataSourceNetworkACLs(),
-			"aws_network_interface":                          ec2.DataSourceNetworkInterface(),
-			"aws_network_interfaces":                         ec2.DataSourceNetworkInterfaces(),
-			"aws_prefix_list":                                ec2.DataSourcePrefixList(),
-			"aws_route_table":                                ec2.DataSourceRouteTable(),
-			"aws_route_tables":                               ec2.DataSourceRouteTables(),
-			"aws_route":                                      ec2.DataSourceRoute(),
-			"aws_security_group":                             ec2.DataSourceSecurityGroup(),
-			"aws_security_groups":                            ec2.DataSourceSecurityGroups(),
-			"aws_subnet_ids":                                 ec2.DataSourceSubnetIDs(),
-			"aws_subnet":                                     ec2.DataSourceSubnet(),
-			"aws_subnets":                                    ec2.DataSourceSubnets(),
-			"aws_vpc_dhcp_options":                           ec2.DataSourceVPCDHCPOptions(),
-			"aws_vpc_endpoint_service":                       ec2.DataSourceVPCEndpointService(),
-			"aws_vpc_endpoint":                               ec2.DataSourceVPCEndpoint(),
-			"aws_vpc_ipam_pool":                              ec2.DataSourceIPAMPool(),
-			"aws_vpc_ipam_pools":                             ec2.DataSourceIPAMPools(),
-			"aws_vpc_ipam_pool_cidrs":                        ec2.DataSourceIPAMPoolCIDRs(),
-			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceIPAMPreviewNextCIDR(),
-			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
-			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
-			"aws_vpc":                                        ec2.DataSourceVPC(),
-			"aws_vpcs":                                       ec2.DataSourceVPCs(),
-			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
+			"aws_ami":                                           ec2.DataSourceAMI(),
+			"aws_ami_ids":                                       ec2.DataSourceAMIIDs(),
+			"aws_availability_zone":                             ec2.DataSourceAvailabilityZone(),
+			"aws_availability_zones":                            ec2.DataSourceAvailabilityZones(),
+			"aws_customer_gateway":                              ec2.DataSourceCustomerGateway(),
+			"aws_ebs_default_kms_key":                           ec2.DataSourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                     ec2.DataSourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                  ec2.DataSourceEBSSnapshot(),
+			"aws_ebs_snapshot_ids":                              ec2.DataSourceEBSSnapshotIDs(),
+			"aws_ebs_volume":                                    ec2.DataSourceEBSVolume(),
+			"aws_ebs_volumes":                                   ec2.DataSourceEBSVolumes(),
+			"aws_ec2_client_vpn_endpoint":                       ec2.DataSourceClientVPNEndpoint(),
+			"aws_ec2_coip_pool":                                 ec2.DataSourceCoIPPool(),
+			"aws_ec2_coip_pools":                                ec2.DataSourceCoIPPools(),
+			"aws_ec2_host":                                      ec2.DataSourceHost(),
+			"aws_ec2_instance_type_offering":                    ec2.DataSourceInstanceTypeOffering(),
+			"aws_ec2_instance_type_offerings":                   ec2.DataSourceInstanceTypeOfferings(),
+			"aws_ec2_instance_type":                             ec2.DataSourceInstanceType(),
+			"aws_ec2_instance_types":                            ec2.DataSourceInstanceTypes(),
+			"aws_ec2_local_gateway_route_table":                 ec2.DataSourceLocalGatewayRouteTable(),
+			"aws_ec2_local_gateway_route_tables":                ec2.DataSourceLocalGatewayRouteTables(),
+			"aws_ec2_local_gateway_route_table_vpc_association": ec2.DataSourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_local_gateway_virtual_interface":           ec2.DataSourceLocalGatewayVirtualInterface(),
+			"aws_ec2_local_gateway_virtual_interface_group":     ec2.DataSourceLocalGatewayVirtualInterfaceGroup(),
+			"aws_ec2_local_gateway_virtual_interface_groups":    ec2.DataSourceLocalGatewayVirtualInterfaceGroups(),
+			"aws_ec2_local_gateway":                             ec2.DataSourceLocalGateway(),
+			"aws_ec2_local_gateways":                            ec2.DataSourceLocalGateways(),
+			"aws_ec2_managed_prefix_list":                       ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_lists":                      ec2.DataSourceManagedPrefixLists(),
+			"aws_ec2_network_insights_analysis":                 ec2.DataSourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                     ec2.DataSourceNetworkInsightsPath(),
+			"aws_ec2_serial_console_access":                     ec2.DataSourceSerialConsoleAccess(),
+			"aws_ec2_spot_price":                                ec2.DataSourceSpotPrice(),
+			"aws_ec2_transit_gateway":                           ec2.DataSourceTransitGateway(),
+			"aws_ec2_transit_gateway_attachment":                ec2.DataSourceTransitGatewayAttachment(),
+			"aws_ec2_transit_gateway_connect":                   ec2.DataSourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":              ec2.DataSourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_dx_gateway_attachment":     ec2.DataSourceTransitGatewayDxGatewayAttachment(),
+			"aws_ec2_transit_gateway_multicast_domain":          ec2.DataSourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_peering_attachment":        ec2.DataSourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_route_table":               ec2.DataSourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_tables":              ec2.DataSourceTransitGatewayRouteTables(),
+			"aws_ec2_transit_gateway_vpc_attachment":            ec2.DataSourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachments":           ec2.DataSourceTransitGatewayVPCAttachments(),
+			"aws_ec2_transit_gateway_vpn_attachment":            ec2.DataSourceTransitGatewayVPNAttachment(),
+			"aws_eip":                                           ec2.DataSourceEIP(),
+			"aws_eips":                                          ec2.DataSourceEIPs(),
+			"aws_instance":                                      ec2.DataSourceInstance(),
+			"aws_instances":                                     ec2.DataSourceInstances(),
+			"aws_internet_gateway":                              ec2.DataSourceInternetGateway(),
+			"aws_key_pair":                                      ec2.DataSourceKeyPair(),
+			"aws_launch_template":                               ec2.DataSourceLaunchTemplate(),
+			"aws_nat_gateway":                                   ec2.DataSourceNATGateway(),
+			"aws_nat_gateways":                                  ec2.DataSourceNATGateways(),
+			"aws_network_acls":                                  ec2.DataSourceNetworkACLs(),
+			"aws_network_interface":                             ec2.DataSourceNetworkInterface(),
+			"aws_network_interfaces":                            ec2.DataSourceNetworkInterfaces(),
+			"aws_prefix_list":                                   ec2.DataSourcePrefixList(),
+			"aws_route_table":                                   ec2.DataSourceRouteTable(),
+			"aws_route_tables":                                  ec2.DataSourceRouteTables(),
+			"aws_route":                                         ec2.DataSourceRoute(),
+			"aws_security_group":                                ec2.DataSourceSecurityGroup(),
+			"aws_security_groups":                               ec2.DataSourceSecurityGroups(),
+			"aws_subnet_ids":                                    ec2.DataSourceSubnetIDs(),
+			"aws_subnet":                                        ec2.DataSourceSubnet(),
+			"aws_subnets":                                       ec2.DataSourceSubnets(),
+			"aws_vpc_dhcp_options":                              ec2.DataSourceVPCDHCPOptions(),
+			"aws_vpc_endpoint_service":                          ec2.DataSourceVPCEndpointService(),
+			"aws_vpc_endpoint":                                  ec2.DataSourceVPCEndpoint(),
+			"aws_vpc_ipam_pool":                                 ec2.DataSourceIPAMPool(),
+			"aws_vpc_ipam_pools":                                ec2.DataSourceIPAMPools(),
+			"aws_vpc_ipam_pool_cidrs":                           ec2.DataSourceIPAMPoolCIDRs(),
+			"aws_vpc_ipam_preview_next_cidr":                    ec2.DataSourceIPAMPreviewNextCIDR(),
+			"aws_vpc_peering_connection":                        ec2.DataSourceVPCPeeringConnection(),
+			"aws_vpc_peering_connections":                       ec2.DataSourceVPCPeeringConnections(),
+			"aws_vpc":                                           ec2.DataSourceVPC(),
+			"aws_vpcs":                                          ec2.DataSourceVPCs(),
+			"aws_vpn_gateway":                                   ec2.DataSourceVPNGateway(),
 
 			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
 			"aws_ecr_image":               ecr.DataSourceImage(),
@@ -676,21 +707,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_guardduty_detector": guardduty.DataSourceDetector(),
 
-			"aws_iam_account_alias":           iam.DataSourceAccountAlias(),
-			"aws_iam_group":                   iam.DataSourceGroup(),
-			"aws_iam_instance_profile":        iam.DataSourceInstanceProfile(),
-			"aws_iam_instance_profiles":       iam.DataSourceInstanceProfiles(),
-			"aws_iam_openid_connect_provider": iam.DataSourceOpenIDConnectProvider(),
-			"aws_iam_policy":                  iam.DataSourcePolicy(),
-			"aws_iam_policy_document":         iam.DataSourcePolicyDocument(),
-			"aws_iam_role":                    iam.DataSourceRole(),
-			"aws_iam_roles":                   iam.DataSourceRoles(),
-			"aws_iam_saml_provider":           iam.DataSourceSAMLProvider(),
-			"aws_iam_server_certificate":      iam.DataSourceServerCertificate(),
-			"aws_iam_session_context":         iam.DataSourceSessionContext(),
-			"aws_iam_user":                    iam.DataSourceUser(),
-			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
-			"aws_iam_users":                   iam.DataSourceUsers(),
+			"aws_iam_account_alias":               iam.DataSourceAccountAlias(),
+			"aws_iam_group":                       iam.DataSourceGroup(),
+			"aws_iam_instance_profile":            iam.DataSourceInstanceProfile(),
+			"aws_iam_instance_profiles":           iam.DataSourceInstanceProfiles(),
+			"aws_iam_openid_connect_provider":     iam.DataSourceOpenIDConnectProvider(),
+			"aws_iam_policy":                      iam.DataSourcePolicy(),
+			"aws_iam_policy_document":             iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation": iam.DataSourcePrincipalPolicySimulation(),
+			"aws_iam_role":                        iam.DataSourceRole(),
+			"aws_iam_roles":                       iam.DataSourceRoles(),
+			"aws_iam_saml_provider":               iam.DataSourceSAMLProvider(),
+			"aws_iam_server_certificate":          iam.DataSourceServerCertificate(),
+			"aws_iam_session_context":             iam.DataSourceSessionContext(),
+			"aws_iam_user":                        iam.DataSourceUser(),
+			"aws_iam_user_ssh_key":                iam.DataSourceUserSSHKey(),
+			"aws_iam_users":                       iam.DataSourceUsers(),
 
 			"aws_identitystore_group": identitystore.DataSourceGroup(),
 			"aws_identitystore_user":  identitystore.DataSourceUser(),
@@ -946,6 +978,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_accessanalyzer_archive_rule": accessanalyzer.ResourceArchiveRule(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
+			"aws_account_primary_contact":   account.ResourcePrimaryContact(),
+			"aws_account_region":            account.ResourceRegion(),
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
@@ -1058,10 +1092,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_appsync_resolver":                    appsync.ResourceResolver(),
 			"aws_appsync_type":                        appsync.ResourceType(),
 
-			"aws_athena_database":     athena.ResourceDatabase(),
-			"aws_athena_data_catalog": athena.ResourceDataCatalog(),
-			"aws_athena_named_query":  athena.ResourceNamedQuery(),
-			"aws_athena_workgroup":    athena.ResourceWorkGroup(),
+			"aws_athena_capacity_assignment_configuration": athena.ResourceCapacityAssignmentConfiguration(),
+			"aws_athena_capacity_reservation":              athena.ResourceCapacityReservation(),
+			"aws_athena_database":                          athena.ResourceDatabase(),
+			"aws_athena_data_catalog":                      athena.ResourceDataCatalog(),
+			"aws_athena_named_query":                       athena.ResourceNamedQuery(),
+			"aws_athena_prepared_statement":                athena.ResourcePreparedStatement(),
+			"aws_athena_workgroup":                         athena.ResourceWorkGroup(),
 
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
@@ -1090,6 +1127,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.ResourceSchedulingPolicy(),
 
+			"aws_bedrock_custom_model":                           bedrock.ResourceCustomModel(),
+			"aws_bedrock_model_invocation_logging_configuration": bedrock.ResourceModelInvocationLoggingConfiguration(),
+			"aws_bedrock_provisioned_model_throughput":           bedrock.ResourceProvisionedModelThroughput(),
+
+			"aws_bedrockagent_flow":         bedrockagent.ResourceFlow(),
+			"aws_bedrockagent_flow_alias":   bedrockagent.ResourceFlowAlias(),
+			"aws_bedrockagent_flow_version": bedrockagent.ResourceFlowVersion(),
+
 			"aws_budgets_budget":        budgets.ResourceBudget(),
 			"aws_budgets_budget_action": budgets.ResourceBudgetAction(),
 
@@ -1106,6 +1151,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_chime_voice_connector_termination":             chime.ResourceVoiceConnectorTermination(),
 			"aws_chime_voice_connector_termination_credentials": chime.ResourceVoiceConnectorTerminationCredentials(),
 
+			"aws_cleanrooms_collaboration":                cleanrooms.ResourceCollaboration(),
+			"aws_cleanrooms_configured_table":             cleanrooms.ResourceConfiguredTable(),
+			"aws_cleanrooms_configured_table_association": cleanrooms.ResourceConfiguredTableAssociation(),
+			"aws_cleanrooms_membership":                   cleanrooms.ResourceMembership(),
+
 			"aws_cloud9_environment_ec2":        cloud9.ResourceEnvironmentEC2(),
 			"aws_cloud9_environment_membership": cloud9.ResourceEnvironmentMembership(),
 
@@ -1200,8 +1250,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_cognito_user_pool_domain":           cognitoidp.ResourceUserPoolDomain(),
 			"aws_cognito_user_pool_ui_customization": cognitoidp.ResourceUserPoolUICustomization(),
 
-			"aws_comprehend_document_classifier": comprehend.ResourceDocumentClassifier(),
-			"aws_comprehend_entity_recognizer":   comprehend.ResourceEntityRecognizer(),
+			"aws_comprehend_document_classifier":          comprehend.ResourceDocumentClassifier(),
+			"aws_comprehend_document_classifier_endpoint": comprehend.ResourceDocumentClassifierEndpoint(),
+			"aws_comprehend_entity_recognizer":            comprehend.ResourceEntityRecognizer(),
 
 			"aws_config_aggregate_authorization":       configservice.ResourceAggregateAuthorization(),
 			"aws_config_config_rule":                   configservice.ResourceConfigRule(),
@@ -1258,6 +1309,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_dax_parameter_group": dax.ResourceParameterGroup(),
 			"aws_dax_subnet_group":    dax.ResourceSubnetGroup(),
 
+			"aws_deadline_farm":                    deadline.ResourceFarm(),
+			"aws_deadline_fleet":                   deadline.ResourceFleet(),
+			"aws_deadline_queue":                   deadline.ResourceQueue(),
+			"aws_deadline_queue_fleet_association": deadline.ResourceQueueFleetAssociation(),
+
 			"aws_devicefarm_device_pool":       devicefarm.ResourceDevicePool(),
 			"aws_devicefarm_instance_profile":  devicefarm.ResourceInstanceProfile(),
 			"aws_devicefarm_network_profile":   devicefarm.ResourceNetworkProfile(),
@@ -1307,6 +1363,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_docdb_global_cluster":          docdb.ResourceGlobalCluster(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
 
+			"aws_drs_replication_configuration_template": drs.ResourceReplicationConfigurationTemplate(),
+
 			"aws_directory_service_conditional_forwarder":     ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":                 ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":          ds.ResourceLogSubscription(),
@@ -1377,6 +1435,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
 			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_announcement":     ec2.ResourceTransitGatewayRouteTableAnnouncement(),
 			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
 			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
 			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
@@ -1531,6 +1590,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_emrserverless_application": emrserverless.ResourceApplication(),
 
+			"aws_entityresolution_matching_workflow": entityresolution.ResourceMatchingWorkflow(),
+			"aws_entityresolution_schema_mapping":    entityresolution.ResourceSchemaMapping(),
+
 			"aws_evidently_feature": evidently.ResourceFeature(),
 			"aws_evidently_project": evidently.ResourceProject(),
 			"aws_evidently_segment": evidently.ResourceSegment(),
@@ -1542,6 +1604,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
 			"aws_fms_policy":        fms.ResourcePolicy(),
 
+			"aws_forecast_dataset":       forecast.ResourceDataset(),
+			"aws_forecast_dataset_group": forecast.ResourceDatasetGroup(),
+			"aws_forecast_forecast":      forecast.ResourceForecast(),
+			"aws_forecast_predictor":     forecast.ResourcePredictor(),
+
 			"aws_fsx_backup":                        fsx.ResourceBackup(),
 			"aws_fsx_lustre_file_system":            fsx.ResourceLustreFileSystem(),
 			"aws_fsx_data_repository_association":   fsx.ResourceDataRepositoryAssociation(),
@@ -1564,9 +1631,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_glacier_vault":      glacier.ResourceVault(),
 			"aws_glacier_vault_lock": glacier.ResourceVaultLock(),
 
-			"aws_globalaccelerator_accelerator":    globalaccelerator.ResourceAccelerator(),
-			"aws_globalaccelerator_endpoint_group": globalaccelerator.ResourceEndpointGroup(),
-			"aws_globalaccelerator_listener":       globalaccelerator.ResourceListener(),
+			"aws_globalaccelerator_accelerator":                   globalaccelerator.ResourceAccelerator(),
+			"aws_globalaccelerator_custom_routing_accelerator":    globalaccelerator.ResourceCustomRoutingAccelerator(),
+			"aws_globalaccelerator_custom_routing_endpoint":       globalaccelerator.ResourceCustomRoutingEndpoint(),
+			"aws_globalaccelerator_custom_routing_endpoint_group": globalaccelerator.ResourceCustomRoutingEndpointGroup(),
+			"aws_globalaccelerator_custom_routing_listener":       globalaccelerator.ResourceCustomRoutingListener(),
+			"aws_globalaccelerator_cross_account_attachment":      globalaccelerator.ResourceCrossAccountAttachment(),
+			"aws_globalaccelerator_endpoint_group":                globalaccelerator.ResourceEndpointGroup(),
+			"aws_globalaccelerator_listener":                      globalaccelerator.ResourceListener(),
 
 			"aws_glue_catalog_database":                 glue.ResourceCatalogDatabase(),
 			"aws_glue_catalog_table":                    glue.ResourceCatalogTable(),
@@ -1584,6 +1656,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_glue_schema":                           glue.ResourceSchema(),
 			"aws_glue_security_configuration":           glue.ResourceSecurityConfiguration(),
 			"aws_glue_trigger":                          glue.ResourceTrigger(),
+			"aws_glue_usage_profile":                    glue.ResourceUsageProfile(),
 			"aws_glue_user_defined_function":            glue.ResourceUserDefinedFunction(),
 			"aws_glue_workflow":                         glue.ResourceWorkflow(),
 
@@ -1593,6 +1666,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_grafana_workspace_api_key":            grafana.ResourceWorkspaceAPIKey(),
 			"aws_grafana_workspace_saml_configuration": grafana.ResourceWorkspaceSAMLConfiguration(),
 
+			"aws_greengrassv2_component_version": greengrassv2.ResourceComponentVersion(),
+			"aws_greengrassv2_deployment":        greengrassv2.ResourceDeployment(),
+
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
@@ -1603,6 +1679,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
 			"aws_guardduty_threatintelset":             guardduty.ResourceThreatIntelSet(),
 
+			"aws_healthlake_fhir_datastore": healthlake.ResourceFHIRDatastore(),
+
 			"aws_iam_access_key":                  iam.ResourceAccessKey(),
 			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
 			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
@@ -1652,6 +1730,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
+			"aws_iot_domain_configuration":       iot.ResourceDomainConfiguration(),
+			"aws_iot_fleet_metric":               iot.ResourceFleetMetric(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
 			"aws_iot_logging_options":            iot.ResourceLoggingOptions(),
 			"aws_iot_policy":                     iot.ResourcePolicy(),
@@ -1666,6 +1746,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_iot_topic_rule":                 iot.ResourceTopicRule(),
 			"aws_iot_topic_rule_destination":     iot.ResourceTopicRuleDestination(),
 
+			"aws_iotsitewise_asset":       iotsitewise.ResourceAsset(),
+			"aws_iotsitewise_asset_model": iotsitewise.ResourceAssetModel(),
+			"aws_iotsitewise_gateway":     iotsitewise.ResourceGateway(),
+			"aws_iotsitewise_portal":      iotsitewise.ResourcePortal(),
+
+			"aws_iottwinmaker_component_type": iottwinmaker.ResourceComponentType(),
+			"aws_iottwinmaker_entity":         iottwinmaker.ResourceEntity(),
+			"aws_iottwinmaker_scene":          iottwinmaker.ResourceScene(),
+			"aws_iottwinmaker_workspace":      iottwinmaker.ResourceWorkspace(),
+
 			"aws_ivs_channel":                 ivs.ResourceChannel(),
 			"aws_ivs_playback_key_pair":       ivs.ResourcePlaybackKeyPair(),
 			"aws_ivs_recording_configuration": ivs.ResourceRecordingConfiguration(),
@@ -1673,6 +1763,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ivschat_logging_configuration": ivschat.ResourceLoggingConfiguration(),
 			"aws_ivschat_room":                  ivschat.ResourceRoom(),
 
+			"aws_ivs_stage": ivsrealtime.ResourceStage(),
+
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
@@ -1733,6 +1825,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_lex_intent":    lexmodels.ResourceIntent(),
 			"aws_lex_slot_type": lexmodels.ResourceSlotType(),
 
+			"aws_lexv2models_bot":        lexv2models.ResourceBot(),
+			"aws_lexv2models_bot_locale": lexv2models.ResourceBotLocale(),
+			"aws_lexv2models_intent":     lexv2models.ResourceIntent(),
+			"aws_lexv2models_slot_type":  lexv2models.ResourceSlotType(),
+
 			"aws_licensemanager_association":           licensemanager.ResourceAssociation(),
 			"aws_licensemanager_license_configuration": licensemanager.ResourceLicenseConfiguration(),
 
@@ -1777,10 +1874,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_macie2_organization_admin_account":          macie2.ResourceOrganizationAdminAccount(),
 			"aws_macie2_classification_export_configuration": macie2.ResourceClassificationExportConfiguration(),
 
+			"aws_managedblockchain_member":  managedblockchain.ResourceMember(),
+			"aws_managedblockchain_network": managedblockchain.ResourceNetwork(),
+			"aws_managedblockchain_node":    managedblockchain.ResourceNode(),
+
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
 
 			"aws_media_package_channel": mediapackage.ResourceChannel(),
 
+			"aws_media_packagev2_channel":         mediapackagev2.ResourceChannel(),
+			"aws_media_packagev2_channel_group":   mediapackagev2.ResourceChannelGroup(),
+			"aws_media_packagev2_origin_endpoint": mediapackagev2.ResourceOriginEndpoint(),
+
 			"aws_medialive_channel":              medialive.ResourceChannel(),
 			"aws_medialive_input":                medialive.ResourceInput(),
 			"aws_medialive_input_security_group": medialive.ResourceInputSecurityGroup(),
@@ -1796,6 +1901,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_memorydb_subnet_group":    memorydb.ResourceSubnetGroup(),
 			"aws_memorydb_user":            memorydb.ResourceUser(),
 
+			"aws_mgn_launch_configuration_template":      mgn.ResourceLaunchConfigurationTemplate(),
+			"aws_mgn_replication_configuration_template": mgn.ResourceReplicationConfigurationTemplate(),
+
 			"aws_mq_broker":        mq.ResourceBroker(),
 			"aws_mq_configuration": mq.ResourceConfiguration(),
 
@@ -1835,11 +1943,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_networkmanager_vpc_attachment":                           networkmanager.ResourceVPCAttachment(),
 			"aws_networkmanager_site_to_site_vpn_attachment":              networkmanager.ResourceSiteToSiteVPNAttachment(),
 
+			"aws_omics_reference_store": omics.ResourceReferenceStore(),
+			"aws_omics_run_group":       omics.ResourceRunGroup(),
+			"aws_omics_sequence_store":  omics.ResourceSequenceStore(),
+			"aws_omics_workflow":        omics.ResourceWorkflow(),
+
 			"aws_opensearch_domain":                      opensearch.ResourceDomain(),
 			"aws_opensearch_domain_policy":               opensearch.ResourceDomainPolicy(),
 			"aws_opensearch_domain_saml_options":         opensearch.ResourceDomainSAMLOptions(),
 			"aws_opensearch_outbound_connection":         opensearch.ResourceOutboundConnection(),
 			"aws_opensearch_inbound_connection_accepter": opensearch.ResourceInboundConnectionAccepter(),
+			"aws_opensearch_package":                     opensearch.ResourcePackage(),
+			"aws_opensearch_package_association":         opensearch.ResourcePackageAssociation(),
 
 			"aws_opsworks_application":       opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":      opsworks.ResourceCustomLayer(),
@@ -1866,6 +1981,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_organizations_policy":                  organizations.ResourcePolicy(),
 			"aws_organizations_policy_attachment":       organizations.ResourcePolicyAttachment(),
 
+			"aws_paymentcryptography_key":       paymentcryptography.ResourceKey(),
+			"aws_paymentcryptography_key_alias": paymentcryptography.ResourceKeyAlias(),
+
+			"aws_personalize_campaign":      personalize.ResourceCampaign(),
+			"aws_personalize_dataset":       personalize.ResourceDataset(),
+			"aws_personalize_dataset_group": personalize.ResourceDatasetGroup(),
+			"aws_personalize_schema":        personalize.ResourceSchema(),
+			"aws_personalize_solution":      personalize.ResourceSolution(),
+
 			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),
 			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),
 			"aws_pinpoint_apns_sandbox_channel":      pinpoint.ResourceAPNSSandboxChannel(),
@@ -1878,6 +2002,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
+			"aws_polly_lexicon": polly.ResourceLexicon(),
+
+			"aws_qbusiness_application": qbusiness.ResourceApplication(),
+			"aws_qbusiness_index":       qbusiness.ResourceIndex(),
+			"aws_qbusiness_retriever":   qbusiness.ResourceRetriever(),
+
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 			"aws_qldb_stream": qldb.ResourceStream(),
 
@@ -1906,32 +2036,37 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_db_snapshot":                               rds.ResourceSnapshot(),
 			"aws_db_snapshot_copy":                          rds.ResourceSnapshotCopy(),
 			"aws_db_subnet_group":                           rds.ResourceSubnetGroup(),
+			"aws_rds_blue_green_deployment":                 rds.ResourceBlueGreenDeployment(),
 			"aws_rds_cluster":                               rds.ResourceCluster(),
 			"aws_rds_cluster_activity_stream":               rds.ResourceClusterActivityStream(),
 			"aws_rds_cluster_endpoint":                      rds.ResourceClusterEndpoint(),
 			"aws_rds_cluster_instance":                      rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
+			"aws_rds_custom_db_engine_version":              rds.ResourceCustomDBEngineVersion(),
 			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
+			"aws_rds_integration":                           rds.ResourceIntegration(),
 			"aws_rds_reserved_instance":                     rds.ResourceReservedInstance(),
 
-			"aws_redshift_authentication_profile":        redshift.ResourceAuthenticationProfile(),
-			"aws_redshift_cluster":                       redshift.ResourceCluster(),
-			"aws_redshift_cluster_iam_roles":             redshift.ResourceClusterIAMRoles(),
-			"aws_redshift_endpoint_access":               redshift.ResourceEndpointAccess(),
-			"aws_redshift_endpoint_authorization":        redshift.ResourceEndpointAuthorization(),
-			"aws_redshift_event_subscription":            redshift.ResourceEventSubscription(),
-			"aws_redshift_hsm_client_certificate":        redshift.ResourceHSMClientCertificate(),
-			"aws_redshift_hsm_configuration":             redshift.ResourceHSMConfiguration(),
-			"aws_redshift_parameter_group":               redshift.ResourceParameterGroup(),
-			"aws_redshift_partner":                       redshift.ResourcePartner(),
-			"aws_redshift_scheduled_action":              redshift.ResourceScheduledAction(),
-			"aws_redshift_security_group":                redshift.ResourceSecurityGroup(),
-			"aws_redshift_snapshot_copy_grant":           redshift.ResourceSnapshotCopyGrant(),
-			"aws_redshift_snapshot_schedule":             redshift.ResourceSnapshotSchedule(),
-			"aws_redshift_snapshot_schedule_association": redshift.ResourceSnapshotScheduleAssociation(),
-			"aws_redshift_subnet_group":                  redshift.ResourceSubnetGroup(),
-			"aws_redshift_usage_limit":                   redshift.ResourceUsageLimit(),
+			"aws_redshift_authentication_profile":          redshift.ResourceAuthenticationProfile(),
+			"aws_redshift_cluster":                         redshift.ResourceCluster(),
+			"aws_redshift_cluster_iam_roles":               redshift.ResourceClusterIAMRoles(),
+			"aws_redshift_data_share_authorization":        redshift.ResourceDataShareAuthorization(),
+			"aws_redshift_data_share_consumer_association": redshift.ResourceDataShareConsumerAssociation(),
+			"aws_redshift_endpoint_access":                 redshift.ResourceEndpointAccess(),
+			"aws_redshift_endpoint_authorization":          redshift.ResourceEndpointAuthorization(),
+			"aws_redshift_event_subscription":              redshift.ResourceEventSubscription(),
+			"aws_redshift_hsm_client_certificate":          redshift.ResourceHSMClientCertificate(),
+			"aws_redshift_hsm_configuration":               redshift.ResourceHSMConfiguration(),
+			"aws_redshift_parameter_group":                 redshift.ResourceParameterGroup(),
+			"aws_redshift_partner":                         redshift.ResourcePartner(),
+			"aws_redshift_scheduled_action":                redshift.ResourceScheduledAction(),
+			"aws_redshift_security_group":                  redshift.ResourceSecurityGroup(),
+			"aws_redshift_snapshot_copy_grant":             redshift.ResourceSnapshotCopyGrant(),
+			"aws_redshift_snapshot_schedule":               redshift.ResourceSnapshotSchedule(),
+			"aws_redshift_snapshot_schedule_association":   redshift.ResourceSnapshotScheduleAssociation(),
+			"aws_redshift_subnet_group":                    redshift.ResourceSubnetGroup(),
+			"aws_redshift_usage_limit":                     redshift.ResourceUsageLimit(),
 
 			"aws_redshiftdata_statement": redshiftdata.ResourceStatement(),
 
@@ -1942,6 +2077,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_redshiftserverless_usage_limit":     redshiftserverless.ResourceUsageLimit(),
 			"aws_redshiftserverless_workgroup":       redshiftserverless.ResourceWorkgroup(),
 
+			"aws_rekognition_project":          rekognition.ResourceProject(),
+			"aws_rekognition_project_version":  rekognition.ResourceProjectVersion(),
+			"aws_rekognition_stream_processor": rekognition.ResourceStreamProcessor(),
+
+			"aws_resiliencehub_app":               resiliencehub.ResourceApp(),
+			"aws_resiliencehub_resiliency_policy": resiliencehub.ResourceResiliencyPolicy(),
+
 			"aws_resourcegroups_group": resourcegroups.ResourceGroup(),
 
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
@@ -2024,10 +2166,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_sagemaker_image":                                     sagemaker.ResourceImage(),
 			"aws_sagemaker_image_version":                             sagemaker.ResourceImageVersion(),
 			"aws_sagemaker_model":                                     sagemaker.ResourceModel(),
+			"aws_sagemaker_model_card":                                sagemaker.ResourceModelCard(),
 			"aws_sagemaker_model_package_group":                       sagemaker.ResourceModelPackageGroup(),
 			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(),
+			"aws_sagemaker_monitoring_schedule":                       sagemaker.ResourceMonitoringSchedule(),
 			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
+			"aws_sagemaker_pipeline":                                  sagemaker.ResourcePipeline(),
 			"aws_sagemaker_project":                                   sagemaker.ResourceProject(),
 			"aws_sagemaker_space":                                     sagemaker.ResourceSpace(),
 			"aws_sagemaker_servicecatalog_portfolio_status":           sagemaker.ResourceServicecatalogPortfolioStatus(),
@@ -2118,6 +2263,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
 			"aws_signer_signing_profile_permission": signer.ResourceSigningProfilePermission(),
 
+			"aws_simspaceweaver_simulation": simspaceweaver.ResourceSimulation(),
+
 			"aws_sns_platform_application": sns.ResourcePlatformApplication(),
 			"aws_sns_sms_preferences":      sns.ResourceSMSPreferences(),
 			"aws_sns_topic":                sns.ResourceTopic(),
@@ -2142,6 +2289,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 			"aws_ssm_service_setting":           ssm.ResourceServiceSetting(),
 
+			"aws_ssmcontacts_contact":         ssmcontacts.ResourceContact(),
+			"aws_ssmcontacts_contact_channel": ssmcontacts.ResourceContactChannel(),
+			"aws_ssmcontacts_plan":            ssmcontacts.ResourcePlan(),
+
+			"aws_ssmincidents_replication_set": ssmincidents.ResourceReplicationSet(),
+			"aws_ssmincidents_response_plan":   ssmincidents.ResourceResponsePlan(),
+
 			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
 			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
 			"aws_ssoadmin_instance_access_control_attributes": ssoadmin.ResourceAccessControlAttributes(),
@@ -2165,6 +2319,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_synthetics_canary": synthetics.ResourceCanary(),
 
+			"aws_textract_adapter":         textract.ResourceAdapter(),
+			"aws_textract_adapter_version": textract.ResourceAdapterVersion(),
+
+			"aws_timestreaminfluxdb_db_instance": timestreaminfluxdb.ResourceDBInstance(),
+
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
 
@@ -2180,6 +2339,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_transfer_user":     transfer.ResourceUser(),
 			"aws_transfer_workflow": transfer.ResourceWorkflow(),
 
+			"aws_verifiedpermissions_identity_source": verifiedpermissions.ResourceIdentitySource(),
+
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
 			"aws_waf_ipset":                   waf.ResourceIPSet(),
@@ -2221,6 +2382,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_workspaces_ip_group":  workspaces.ResourceIPGroup(),
 			"aws_workspaces_workspace": workspaces.ResourceWorkspace(),
 
+			"aws_workspacesweb_browser_settings":             workspacesweb.ResourceBrowserSettings(),
+			"aws_workspacesweb_browser_settings_association": workspacesweb.ResourceBrowserSettingsAssociation(),
+			"aws_workspacesweb_network_settings":             workspacesweb.ResourceNetworkSettings(),
+			"aws_workspacesweb_network_settings_association": workspacesweb.ResourceNetworkSettingsAssociation(),
+			"aws_workspacesweb_portal":                       workspacesweb.ResourcePortal(),
+			"aws_workspacesweb_user_settings":                workspacesweb.ResourceUserSettings(),
+			"aws_workspacesweb_user_settings_association":    workspacesweb.ResourceUserSettingsAssociation(),
+
 			"aws_xray_encryption_config": xray.ResourceEncryptionConfig(),
 			"aws_xray_group":             xray.ResourceGroup(),
 			"aws_xray_sampling_rule":     xray.ResourceSamplingRule(),
@@ -2577,6 +2746,18 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	// Retired services with no SDK client. The endpoints are accepted, but ignored,
+	// until the next major version so that existing configurations remain valid.
+	for _, serviceKey := range []string{"alexaforbusiness", "honeycode", "macie", "mobile"} {
+		endpointsAttributes[serviceKey] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Use this to override the default service endpoint URL",
+			Deprecated:  fmt.Sprintf("The %s service has been retired and this endpoint is ignored. It will be removed in the next major version.", serviceKey),
+		}
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -29,9 +29,12 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ce"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/chime"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
@@ -57,6 +60,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datapipeline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/detective"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
@@ -64,6 +68,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -81,11 +86,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/forecast"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
@@ -93,7 +100,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrass"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
@@ -102,8 +111,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iotanalytics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ivschat"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ivsrealtime"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -116,31 +128,40 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediastore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/omics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/polly"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ram"
@@ -148,6 +169,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
@@ -176,22 +199,29 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/simspaceweaver"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/textract"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/worklink"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 	"golang.org/x/exp/slices"
 )
@@ -221,9 +251,12 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		autoscalingplans.ServicePackage,
 		backup.ServicePackage,
 		batch.ServicePackage,
+		bedrock.ServicePackage,
+		bedrockagent.ServicePackage,
 		budgets.ServicePackage,
 		ce.ServicePackage,
 		chime.ServicePackage,
+		cleanrooms.ServicePackage,
 		cloud9.ServicePackage,
 		cloudcontrol.ServicePackage,
 		cloudformation.ServicePackage,
@@ -249,6 +282,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		datapipeline.ServicePackage,
 		datasync.ServicePackage,
 		dax.ServicePackage,
+		deadline.ServicePackage,
 		deploy.ServicePackage,
 		detective.ServicePackage,
 		devicefarm.ServicePackage,
@@ -256,6 +290,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		dlm.ServicePackage,
 		dms.ServicePackage,
 		docdb.ServicePackage,
+		drs.ServicePackage,
 		ds.ServicePackage,
 		dynamodb.ServicePackage,
 		ec2.ServicePackage,
@@ -273,11 +308,13 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		emr.ServicePackage,
 		emrcontainers.ServicePackage,
 		emrserverless.ServicePackage,
+		entityresolution.ServicePackage,
 		events.ServicePackage,
 		evidently.ServicePackage,
 		firehose.ServicePackage,
 		fis.ServicePackage,
 		fms.ServicePackage,
+		forecast.ServicePackage,
 		fsx.ServicePackage,
 		gamelift.ServicePackage,
 		glacier.ServicePackage,
@@ -285,7 +322,9 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		glue.ServicePackage,
 		grafana.ServicePackage,
 		greengrass.ServicePackage,
+		greengrassv2.ServicePackage,
 		guardduty.ServicePackage,
+		healthlake.ServicePackage,
 		iam.ServicePackage,
 		identitystore.ServicePackage,
 		imagebuilder.ServicePackage,
@@ -294,8 +333,11 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		iot.ServicePackage,
 		iotanalytics.ServicePackage,
 		iotevents.ServicePackage,
+		iotsitewise.ServicePackage,
+		iottwinmaker.ServicePackage,
 		ivs.ServicePackage,
 		ivschat.ServicePackage,
+		ivsrealtime.ServicePackage,
 		kafka.ServicePackage,
 		kafkaconnect.ServicePackage,
 		kendra.ServicePackage,
@@ -308,31 +350,40 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		lakeformation.ServicePackage,
 		lambda.ServicePackage,
 		lexmodels.ServicePackage,
+		lexv2models.ServicePackage,
 		licensemanager.ServicePackage,
 		lightsail.ServicePackage,
 		location.ServicePackage,
 		logs.ServicePackage,
 		macie.ServicePackage,
 		macie2.ServicePackage,
+		managedblockchain.ServicePackage,
 		mediaconnect.ServicePackage,
 		mediaconvert.ServicePackage,
 		medialive.ServicePackage,
 		mediapackage.ServicePackage,
+		mediapackagev2.ServicePackage,
 		mediastore.ServicePackage,
 		memorydb.ServicePackage,
 		meta.ServicePackage,
+		mgn.ServicePackage,
 		mq.ServicePackage,
 		mwaa.ServicePackage,
 		neptune.ServicePackage,
 		networkfirewall.ServicePackage,
 		networkmanager.ServicePackage,
+		omics.ServicePackage,
 		opensearch.ServicePackage,
 		opensearchserverless.ServicePackage,
 		opsworks.ServicePackage,
 		organizations.ServicePackage,
 		outposts.ServicePackage,
+		paymentcryptography.ServicePackage,
+		personalize.ServicePackage,
 		pinpoint.ServicePackage,
+		polly.ServicePackage,
 		pricing.ServicePackage,
+		qbusiness.ServicePackage,
 		qldb.ServicePackage,
 		quicksight.ServicePackage,
 		ram.ServicePackage,
@@ -340,6 +391,8 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		redshift.ServicePackage,
 		redshiftdata.ServicePackage,
 		redshiftserverless.ServicePackage,
+		rekognition.ServicePackage,
+		resiliencehub.ServicePackage,
 		resourceexplorer2.ServicePackage,
 		resourcegroups.ServicePackage,
 		resourcegroupstaggingapi.ServicePackage,
@@ -368,22 +421,29 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		shield.ServicePackage,
 		signer.ServicePackage,
 		simpledb.ServicePackage,
+		simspaceweaver.ServicePackage,
 		sns.ServicePackage,
 		sqs.ServicePackage,
 		ssm.ServicePackage,
+		ssmcontacts.ServicePackage,
+		ssmincidents.ServicePackage,
 		ssoadmin.ServicePackage,
 		storagegateway.ServicePackage,
 		sts.ServicePackage,
 		swf.ServicePackage,
 		synthetics.ServicePackage,
+		textract.ServicePackage,
+		timestreaminfluxdb.ServicePackage,
 		timestreamwrite.ServicePackage,
 		transcribe.ServicePackage,
 		transfer.ServicePackage,
+		verifiedpermissions.ServicePackage,
 		waf.ServicePackage,
 		wafregional.ServicePackage,
 		wafv2.ServicePackage,
 		worklink.ServicePackage,
 		workspaces.ServicePackage,
+		workspacesweb.ServicePackage,
 		xray.ServicePackage,
 	}
 
@@ -38,3 +38,59 @@ func FindAlternateContactByAccountIDAndContactType(ctx context.Context, conn *ac
 
 	return output.AlternateContact, nil
 }
+
+func FindRegionOptStatusByAccountIDAndRegionName(ctx context.Context, conn *account.Account, accountID, regionName string) (*account.GetRegionOptStatusOutput, error) { // nosemgrep:ci.account-in-func-name
+	input := &account.GetRegionOptStatusInput{
+		RegionName: aws.String(regionName),
+	}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetRegionOptStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, account.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindContactInformationByAccountID(ctx context.Context, conn *account.Account, accountID string) (*account.ContactInformation, error) { // nosemgrep:ci.account-in-func-name
+	input := &account.GetContactInformationInput{}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetContactInformationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, account.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContactInformation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContactInformation, nil
+}
@@ -0,0 +1,195 @@
+package account
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePrimaryContact() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePrimaryContactPut,
+		ReadWithoutTimeout:   resourcePrimaryContactRead,
+		UpdateWithoutTimeout: resourcePrimaryContactPut,
+		DeleteWithoutTimeout: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"address_line_1": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"address_line_2": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"address_line_3": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			"city": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"company_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"country_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(2, 2),
+			},
+			"district_or_county": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"full_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"phone_number": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[+][\s0-9()-]+$`), "must be a valid phone number"),
+			},
+			"postal_code": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"state_or_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"website_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourcePrimaryContactPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn()
+
+	input := &account.PutContactInformationInput{
+		ContactInformation: &account.ContactInformation{
+			AddressLine1: aws.String(d.Get("address_line_1").(string)),
+			City:         aws.String(d.Get("city").(string)),
+			CountryCode:  aws.String(d.Get("country_code").(string)),
+			FullName:     aws.String(d.Get("full_name").(string)),
+			PhoneNumber:  aws.String(d.Get("phone_number").(string)),
+			PostalCode:   aws.String(d.Get("postal_code").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("address_line_2"); ok {
+		input.ContactInformation.AddressLine2 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("address_line_3"); ok {
+		input.ContactInformation.AddressLine3 = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("company_name"); ok {
+		input.ContactInformation.CompanyName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("district_or_county"); ok {
+		input.ContactInformation.DistrictOrCounty = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("state_or_region"); ok {
+		input.ContactInformation.StateOrRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("website_url"); ok {
+		input.ContactInformation.WebsiteUrl = aws.String(v.(string))
+	}
+
+	// The account ID may only be specified for member accounts of an organization.
+	callerAccountID := meta.(*conns.AWSClient).AccountID
+	id := callerAccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		id = v.(string)
+	}
+	if id != callerAccountID {
+		input.AccountId = aws.String(id)
+	}
+
+	log.Printf("[DEBUG] Putting Account Primary Contact: %s", input)
+	_, err := conn.PutContactInformationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting Account Primary Contact (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourcePrimaryContactRead(ctx, d, meta)
+}
+
+func resourcePrimaryContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn()
+
+	accountID := d.Id()
+	if accountID == meta.(*conns.AWSClient).AccountID {
+		accountID = ""
+	}
+
+	output, err := FindContactInformationByAccountID(ctx, conn, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Account Primary Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Account Primary Contact (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", d.Id())
+	d.Set("address_line_1", output.AddressLine1)
+	d.Set("address_line_2", output.AddressLine2)
+	d.Set("address_line_3", output.AddressLine3)
+	d.Set("city", output.City)
+	d.Set("company_name", output.CompanyName)
+	d.Set("country_code", output.CountryCode)
+	d.Set("district_or_county", output.DistrictOrCounty)
+	d.Set("full_name", output.FullName)
+	d.Set("phone_number", output.PhoneNumber)
+	d.Set("postal_code", output.PostalCode)
+	d.Set("state_or_region", output.StateOrRegion)
+	d.Set("website_url", output.WebsiteUrl)
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRegion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRegionUpdate,
+		ReadWithoutTimeout:   resourceRegionRead,
+		UpdateWithoutTimeout: resourceRegionUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(regionUpdateTimeout),
+			Update: schema.DefaultTimeout(regionUpdateTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"opt_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+		},
+	}
+}
+
+func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn()
+
+	accountID := d.Get("account_id").(string)
+	regionName := d.Get("region_name").(string)
+	id := RegionCreateResourceID(accountID, regionName)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if d.Get("enabled").(bool) {
+		input := &account.EnableRegionInput{
+			RegionName: aws.String(regionName),
+		}
+
+		if accountID != "" {
+			input.AccountId = aws.String(accountID)
+		}
+
+		log.Printf("[DEBUG] Enabling Account Region: %s", input)
+		_, err := conn.EnableRegionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error enabling Account Region (%s): %s", id, err)
+		}
+
+		d.SetId(id)
+
+		if _, err := waitRegionEnabled(ctx, conn, accountID, regionName, timeout); err != nil {
+			return diag.Errorf("error waiting for Account Region (%s) enable: %s", d.Id(), err)
+		}
+	} else {
+		input := &account.DisableRegionInput{
+			RegionName: aws.String(regionName),
+		}
+
+		if accountID != "" {
+			input.AccountId = aws.String(accountID)
+		}
+
+		log.Printf("[DEBUG] Disabling Account Region: %s", input)
+		_, err := conn.DisableRegionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error disabling Account Region (%s): %s", id, err)
+		}
+
+		d.SetId(id)
+
+		if _, err := waitRegionDisabled(ctx, conn, accountID, regionName, timeout); err != nil {
+			return diag.Errorf("error waiting for Account Region (%s) disable: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRegionRead(ctx, d, meta)
+}
+
+func resourceRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn()
+
+	accountID, regionName, err := RegionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindRegionOptStatusByAccountIDAndRegionName(ctx, conn, accountID, regionName)
+
+	if err != nil {
+		return diag.Errorf("error reading Account Region (%s): %s", d.Id(), err)
+	}
+
+	status := aws.StringValue(output.RegionOptStatus)
+
+	d.Set("account_id", accountID)
+	d.Set("enabled", status == account.RegionOptStatusEnabled || status == account.RegionOptStatusEnabledByDefault)
+	d.Set("opt_status", status)
+	d.Set("region_name", output.RegionName)
+
+	return nil
+}
+
+const regionResourceIDSeparator = "/"
+
+func RegionCreateResourceID(accountID, regionName string) string {
+	if accountID == "" {
+		return regionName
+	}
+
+	parts := []string{accountID, regionName}
+	id := strings.Join(parts, regionResourceIDSeparator)
+
+	return id
+}
+
+func RegionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, regionResourceIDSeparator)
+
+	switch len(parts) {
+	case 1:
+		return "", parts[0], nil
+	case 2:
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected RegionName or AccountID%[2]sRegionName", id, regionResourceIDSeparator)
+	}
+}
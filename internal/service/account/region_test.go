@@ -0,0 +1,107 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
+)
+
+// Enabling and disabling opt-in Regions can take a long time and affects the whole account,
+// so these tests only run when an opt-in Region is explicitly supplied.
+func testAccRegionPreCheck(t *testing.T) string {
+	regionName := os.Getenv("ACCOUNT_OPT_IN_REGION_NAME")
+
+	if regionName == "" {
+		t.Skip("Environment variable ACCOUNT_OPT_IN_REGION_NAME is not set")
+	}
+
+	return regionName
+}
+
+func TestAccAccountRegion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_account_region.test"
+	regionName := testAccRegionPreCheck(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, account.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionConfig_basic(regionName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRegionOptStatus(ctx, resourceName, account.RegionOptStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "region_name", regionName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRegionConfig_basic(regionName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRegionOptStatus(ctx, resourceName, account.RegionOptStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRegionOptStatus(ctx context.Context, n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Account Region ID is set")
+		}
+
+		accountID, regionName, err := tfaccount.RegionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn()
+
+		output, err := tfaccount.FindRegionOptStatusByAccountIDAndRegionName(ctx, conn, accountID, regionName)
+
+		if err != nil {
+			return err
+		}
+
+		if got := *output.RegionOptStatus; got != expected {
+			return fmt.Errorf("Account Region (%s) opt status is %s, expected %s", rs.Primary.ID, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccRegionConfig_basic(regionName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_account_region" "test" {
+  region_name = %[1]q
+  enabled     = %[2]t
+}
+`, regionName, enabled)
+}
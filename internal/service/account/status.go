@@ -53,3 +53,19 @@ func statusAlternateContactUpdate(ctx context.Context, conn *account.Account, ac
 		return output, statusNotUpdated, nil
 	}
 }
+
+func statusRegionOptStatus(ctx context.Context, conn *account.Account, accountID, regionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRegionOptStatusByAccountIDAndRegionName(ctx, conn, accountID, regionName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.RegionOptStatus), nil
+	}
+}
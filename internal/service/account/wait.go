@@ -12,6 +12,9 @@ const (
 	alternateContactCreateTimeout = 5 * time.Minute
 	alternateContactUpdateTimeout = 5 * time.Minute
 	alternateContactDeleteTimeout = 5 * time.Minute
+
+	// Enabling or disabling an opt-in Region can take several minutes, and occasionally hours.
+	regionUpdateTimeout = 60 * time.Minute
 )
 
 func waitAlternateContactCreated(ctx context.Context, conn *account.Account, accountID, contactType string, timeout time.Duration) (*account.AlternateContact, error) {
@@ -60,3 +63,41 @@ func waitAlternateContactDeleted(ctx context.Context, conn *account.Account, acc
 
 	return err
 }
+
+func waitRegionEnabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{account.RegionOptStatusEnabling, account.RegionOptStatusDisabled},
+		Target:     []string{account.RegionOptStatusEnabled, account.RegionOptStatusEnabledByDefault},
+		Refresh:    statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRegionDisabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{account.RegionOptStatusDisabling, account.RegionOptStatusEnabled},
+		Target:     []string{account.RegionOptStatusDisabled},
+		Refresh:    statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
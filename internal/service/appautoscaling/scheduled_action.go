@@ -2,8 +2,10 @@ package appautoscaling
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const scheduledActionDefaultTimezone = "UTC"
+
 func ResourceScheduledAction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceScheduledActionPut,
@@ -26,6 +30,8 @@ func ResourceScheduledAction() *schema.Resource {
 		UpdateWithoutTimeout: resourceScheduledActionPut,
 		DeleteWithoutTimeout: resourceScheduledActionDelete,
 
+		CustomizeDiff: resourceScheduledActionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,9 +39,10 @@ func ResourceScheduledAction() *schema.Resource {
 				ForceNew: true,
 			},
 			"service_namespace": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(applicationautoscaling.ServiceNamespace_Values(), false),
 			},
 			"resource_id": {
 				Type:     schema.TypeString,
@@ -43,9 +50,10 @@ func ResourceScheduledAction() *schema.Resource {
 				ForceNew: true,
 			},
 			"scalable_dimension": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(applicationautoscaling.ScalableDimension_Values(), false),
 			},
 			"scalable_target_action": {
 				Type:     schema.TypeList,
@@ -93,9 +101,10 @@ func ResourceScheduledAction() *schema.Resource {
 				DiffSuppressFunc: suppressEquivalentTime,
 			},
 			"timezone": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "UTC",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      scheduledActionDefaultTimezone,
+				ValidateFunc: validation.StringLenBetween(1, 1600),
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -117,14 +126,18 @@ func resourceScheduledActionPut(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	needsPut := true
+	var err error
 	if d.IsNewResource() {
-		scheduledActionPopulateInputForCreate(input, d)
+		err = scheduledActionPopulateInputForCreate(input, d)
 	} else {
-		needsPut = scheduledActionPopulateInputForUpdate(input, d)
+		needsPut, err = scheduledActionPopulateInputForUpdate(input, d)
+	}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Application Auto Scaling scheduled action: %s", err)
 	}
 
 	if needsPut {
-		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			_, err := conn.PutScheduledActionWithContext(ctx, input)
 			if err != nil {
 				if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
@@ -149,22 +162,30 @@ func resourceScheduledActionPut(ctx context.Context, d *schema.ResourceData, met
 	return append(diags, resourceScheduledActionRead(ctx, d, meta)...)
 }
 
-func scheduledActionPopulateInputForCreate(input *applicationautoscaling.PutScheduledActionInput, d *schema.ResourceData) {
+func scheduledActionPopulateInputForCreate(input *applicationautoscaling.PutScheduledActionInput, d *schema.ResourceData) error {
 	input.Schedule = aws.String(d.Get("schedule").(string))
 	input.ScalableTargetAction = expandScalableTargetAction(d.Get("scalable_target_action").([]interface{}))
 	input.Timezone = aws.String(d.Get("timezone").(string))
 
 	if v, ok := d.GetOk("start_time"); ok {
-		t, _ := time.Parse(time.RFC3339, v.(string))
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("parsing start_time: %w", err)
+		}
 		input.StartTime = aws.Time(t)
 	}
 	if v, ok := d.GetOk("end_time"); ok {
-		t, _ := time.Parse(time.RFC3339, v.(string))
+		t, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("parsing end_time: %w", err)
+		}
 		input.EndTime = aws.Time(t)
 	}
+
+	return nil
 }
 
-func scheduledActionPopulateInputForUpdate(input *applicationautoscaling.PutScheduledActionInput, d *schema.ResourceData) bool {
+func scheduledActionPopulateInputForUpdate(input *applicationautoscaling.PutScheduledActionInput, d *schema.ResourceData) (bool, error) {
 	hasChange := false
 
 	if d.HasChange("schedule") {
@@ -184,20 +205,43 @@ func scheduledActionPopulateInputForUpdate(input *applicationautoscaling.PutSche
 
 	if d.HasChange("start_time") {
 		if v, ok := d.GetOk("start_time"); ok {
-			t, _ := time.Parse(time.RFC3339, v.(string))
+			t, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return false, fmt.Errorf("parsing start_time: %w", err)
+			}
 			input.StartTime = aws.Time(t)
 			hasChange = true
 		}
 	}
 	if d.HasChange("end_time") {
 		if v, ok := d.GetOk("end_time"); ok {
-			t, _ := time.Parse(time.RFC3339, v.(string))
+			t, err := time.Parse(time.RFC3339, v.(string))
+			if err != nil {
+				return false, fmt.Errorf("parsing end_time: %w", err)
+			}
 			input.EndTime = aws.Time(t)
 			hasChange = true
 		}
 	}
 
-	return hasChange
+	return hasChange, nil
+}
+
+// resourceScheduledActionCustomizeDiff validates that scalable_dimension belongs to service_namespace.
+// Scalable dimensions are of the form "<service-namespace>:<resource-type>:<property>".
+func resourceScheduledActionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("service_namespace") || !diff.NewValueKnown("scalable_dimension") {
+		return nil
+	}
+
+	namespace := diff.Get("service_namespace").(string)
+	dimension := diff.Get("scalable_dimension").(string)
+
+	if prefix, _, _ := strings.Cut(dimension, ":"); prefix != namespace {
+		return fmt.Errorf("scalable_dimension (%s) is not valid for service_namespace (%s)", dimension, namespace)
+	}
+
+	return nil
 }
 
 func resourceScheduledActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -225,7 +269,11 @@ func resourceScheduledActionRead(ctx context.Context, d *schema.ResourceData, me
 	if scheduledAction.EndTime != nil {
 		d.Set("end_time", scheduledAction.EndTime.Format(time.RFC3339))
 	}
-	d.Set("timezone", scheduledAction.Timezone)
+	if v := aws.StringValue(scheduledAction.Timezone); v != "" {
+		d.Set("timezone", v)
+	} else {
+		d.Set("timezone", scheduledActionDefaultTimezone)
+	}
 	d.Set("arn", scheduledAction.ScheduledActionARN)
 
 	return diags
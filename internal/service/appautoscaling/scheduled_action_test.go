@@ -564,6 +564,24 @@ func TestAccAppAutoScalingScheduledAction_maxCapacity(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingScheduledAction_scalableDimensionNamespaceMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduledActionConfig_scalableDimensionNamespaceMismatch(rName),
+				ExpectError: regexp.MustCompile(`scalable_dimension \(dynamodb:table:ReadCapacityUnits\) is not valid for service_namespace \(ecs\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckScheduledActionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn()
@@ -1389,3 +1407,20 @@ resource "aws_dynamodb_table" "test" {
 }
 `, rName, ts, maxCapacity)
 }
+
+func testAccScheduledActionConfig_scalableDimensionNamespaceMismatch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appautoscaling_scheduled_action" "test" {
+  name               = %[1]q
+  service_namespace  = "ecs"
+  resource_id        = "table/%[1]s"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+
+  schedule = "rate(1 hour)"
+
+  scalable_target_action {
+    min_capacity = 1
+  }
+}
+`, rName)
+}
@@ -7,17 +7,19 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/appconfig"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -31,6 +33,10 @@ func ResourceDeployment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:         schema.TypeString,
@@ -70,12 +76,46 @@ func ResourceDeployment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			"dynamic_extension_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"environment_id": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[a-z0-9]{4,7}`), ""),
 			},
+			"event_log": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"occurred_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"triggered_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"percentage_complete": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,6 +143,10 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:                   Tags(tags.IgnoreAWS()),
 	}
 
+	if v, ok := d.GetOk("dynamic_extension_parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.DynamicExtensionParameters = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
 	output, err := conn.StartDeploymentWithContext(ctx, input)
 
 	if err != nil {
@@ -119,6 +163,10 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%s/%s/%d", appID, envID, deployNum))
 
+	if _, err := waitDeploymentCompleted(ctx, conn, appID, envID, int(deployNum), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for AppConfig Deployment (%s) complete: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
 
@@ -134,15 +182,9 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	input := &appconfig.GetDeploymentInput{
-		ApplicationId:    aws.String(appID),
-		DeploymentNumber: aws.Int64(int64(deploymentNum)),
-		EnvironmentId:    aws.String(envID),
-	}
+	output, err := FindDeploymentByThreePartKey(ctx, conn, appID, envID, deploymentNum)
 
-	output, err := conn.GetDeploymentWithContext(ctx, input)
-
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Appconfig Deployment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -152,10 +194,6 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	if output == nil {
-		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): empty response", d.Id())
-	}
-
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -172,6 +210,10 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("deployment_strategy_id", output.DeploymentStrategyId)
 	d.Set("description", output.Description)
 	d.Set("environment_id", output.EnvironmentId)
+	if err := d.Set("event_log", flattenDeploymentEvents(output.EventLog)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting event_log: %s", err)
+	}
+	d.Set("percentage_complete", output.PercentageComplete)
 	d.Set("state", output.State)
 
 	tags, err := ListTags(ctx, conn, arn)
@@ -229,3 +271,31 @@ func DeploymentParseID(id string) (string, string, int, error) {
 
 	return parts[0], parts[1], num, nil
 }
+
+func flattenDeploymentEvents(apiObjects []*appconfig.DeploymentEvent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description":  aws.StringValue(apiObject.Description),
+			"event_type":   aws.StringValue(apiObject.EventType),
+			"triggered_by": aws.StringValue(apiObject.TriggeredBy),
+		}
+
+		if v := apiObject.OccurredAt; v != nil {
+			tfMap["occurred_at"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
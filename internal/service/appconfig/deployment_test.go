@@ -46,7 +46,9 @@ func TestAccAppConfigDeployment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "deployment_strategy_id", depStrategyResourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "description", rName),
 					resource.TestCheckResourceAttrPair(resourceName, "environment_id", envResourceName, "environment_id"),
-					resource.TestCheckResourceAttrSet(resourceName, "state"),
+					resource.TestCheckResourceAttrSet(resourceName, "event_log.#"),
+					resource.TestCheckResourceAttr(resourceName, "percentage_complete", "100"),
+					resource.TestCheckResourceAttr(resourceName, "state", appconfig.DeploymentStateComplete),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -78,17 +80,13 @@ func TestAccAppConfigDeployment_predefinedStrategy(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDeploymentExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "deployment_strategy_id", strategy),
+					resource.TestCheckResourceAttr(resourceName, "state", appconfig.DeploymentStateComplete),
 				),
 			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// Since AppConfig Deployments can vary in completion times
-				// depending on the predefined deployment strategy,
-				// a waiter is not implemented for the resource;
-				// thus, we cannot guarantee the "state" value during import.
-				ImportStateVerifyIgnore: []string{"state"},
 			},
 		},
 	})
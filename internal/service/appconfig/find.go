@@ -53,3 +53,29 @@ func FindExtensionAssociationById(ctx context.Context, conn *appconfig.AppConfig
 
 	return out, nil
 }
+
+func FindDeploymentByThreePartKey(ctx context.Context, conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int) (*appconfig.GetDeploymentOutput, error) {
+	in := &appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(applicationID),
+		DeploymentNumber: aws.Int64(int64(deploymentNumber)),
+		EnvironmentId:    aws.String(environmentID),
+	}
+	out, err := conn.GetDeploymentWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, appconfig.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,26 @@
+package appconfig
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDeployment(ctx context.Context, conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDeploymentByThreePartKey(ctx, conn, applicationID, environmentID, deploymentNumber)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
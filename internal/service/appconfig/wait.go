@@ -0,0 +1,49 @@
+package appconfig
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitDeploymentCompleted(ctx context.Context, conn *appconfig.AppConfig, applicationID, environmentID string, deploymentNumber int, timeout time.Duration) (*appconfig.GetDeploymentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appconfig.DeploymentStateBaking, appconfig.DeploymentStateDeploying, appconfig.DeploymentStateValidating},
+		Target:  []string{appconfig.DeploymentStateComplete},
+		Refresh: statusDeployment(ctx, conn, applicationID, environmentID, deploymentNumber),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appconfig.GetDeploymentOutput); ok {
+		if state := aws.StringValue(output.State); state == appconfig.DeploymentStateRollingBack || state == appconfig.DeploymentStateRolledBack {
+			tfresource.SetLastError(err, errors.New(deploymentRollbackReason(output.EventLog)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// deploymentRollbackReason returns the description of the most recent rollback event.
+func deploymentRollbackReason(events []*appconfig.DeploymentEvent) string {
+	for _, v := range events {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.EventType) == appconfig.DeploymentEventTypeRollbackStarted || aws.StringValue(v.EventType) == appconfig.DeploymentEventTypeRollbackCompleted {
+			return aws.StringValue(v.Description)
+		}
+	}
+
+	return "deployment rolled back"
+}
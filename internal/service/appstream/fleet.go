@@ -2,14 +2,17 @@ package appstream
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appstream"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -56,8 +59,16 @@ func ResourceFleet() *schema.Resource {
 							Computed: true,
 						},
 						"desired_instances": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ExactlyOneOf: []string{"compute_capacity.0.desired_instances", "compute_capacity.0.desired_sessions"},
+						},
+						"desired_sessions": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ExactlyOneOf: []string{"compute_capacity.0.desired_instances", "compute_capacity.0.desired_sessions"},
 						},
 						"in_use": {
 							Type:     schema.TypeInt,
@@ -150,6 +161,11 @@ func ResourceFleet() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"max_sessions_per_instance": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"max_user_duration_in_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -161,6 +177,25 @@ func ResourceFleet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"session_script_s3_location": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"s3_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
 			"stream_view": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -204,7 +239,7 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	input := &appstream.CreateFleetInput{
 		Name:            aws.String(d.Get("name").(string)),
 		InstanceType:    aws.String(d.Get("instance_type").(string)),
-		ComputeCapacity: expandComputeCapacity(d.Get("compute_capacity").([]interface{})),
+		ComputeCapacity: expandComputeCapacity(d.Get("compute_capacity").([]interface{}), d.GetRawConfig().GetAttr("compute_capacity")),
 	}
 
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -250,10 +285,18 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.IamRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("max_sessions_per_instance"); ok {
+		input.MaxSessionsPerInstance = aws.Int64(int64(v.(int)))
+	}
+
 	if v, ok := d.GetOk("max_user_duration_in_seconds"); ok {
 		input.MaxUserDurationInSeconds = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("session_script_s3_location"); ok {
+		input.SessionScriptS3Location = expandS3Location(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("stream_view"); ok {
 		input.StreamView = aws.String(v.(string))
 	}
@@ -368,8 +411,18 @@ func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("image_name", fleet.ImageName)
 	d.Set("image_arn", fleet.ImageArn)
 	d.Set("instance_type", fleet.InstanceType)
+	d.Set("max_sessions_per_instance", fleet.MaxSessionsPerInstance)
 	d.Set("max_user_duration_in_seconds", fleet.MaxUserDurationInSeconds)
 	d.Set("name", fleet.Name)
+
+	if fleet.SessionScriptS3Location != nil {
+		if err = d.Set("session_script_s3_location", []interface{}{flattenS3Location(fleet.SessionScriptS3Location)}); err != nil {
+			return create.DiagSettingError(names.AppStream, "Fleet", d.Id(), "session_script_s3_location", err)
+		}
+	} else {
+		d.Set("session_script_s3_location", nil)
+	}
+
 	d.Set("state", fleet.State)
 	d.Set("stream_view", fleet.StreamView)
 
@@ -412,9 +465,10 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	input := &appstream.UpdateFleetInput{
 		Name: aws.String(d.Id()),
 	}
+	var attributesToDelete []string
 	shouldStop := false
 
-	if d.HasChanges("description", "domain_join_info", "enable_default_internet_access", "iam_role_arn", "instance_type", "max_user_duration_in_seconds", "stream_view", "vpc_config") {
+	if d.HasChanges("description", "domain_join_info", "enable_default_internet_access", "iam_role_arn", "instance_type", "max_sessions_per_instance", "max_user_duration_in_seconds", "stream_view", "vpc_config") {
 		shouldStop = true
 	}
 
@@ -432,7 +486,7 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if d.HasChange("compute_capacity") {
-		input.ComputeCapacity = expandComputeCapacity(d.Get("compute_capacity").([]interface{}))
+		input.ComputeCapacity = expandComputeCapacity(d.Get("compute_capacity").([]interface{}), d.GetRawConfig().GetAttr("compute_capacity"))
 	}
 
 	if d.HasChange("description") {
@@ -479,14 +533,34 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.InstanceType = aws.String(d.Get("instance_type").(string))
 	}
 
+	if d.HasChange("max_sessions_per_instance") {
+		if v, ok := d.GetOk("max_sessions_per_instance"); ok && v.(int) > 0 {
+			input.MaxSessionsPerInstance = aws.Int64(int64(v.(int)))
+		} else {
+			attributesToDelete = append(attributesToDelete, appstream.FleetAttributeMaxSessionsPerInstance)
+		}
+	}
+
 	if d.HasChange("max_user_duration_in_seconds") {
 		input.MaxUserDurationInSeconds = aws.Int64(int64(d.Get("max_user_duration_in_seconds").(int)))
 	}
 
+	if d.HasChange("session_script_s3_location") {
+		if v, ok := d.GetOk("session_script_s3_location"); ok {
+			input.SessionScriptS3Location = expandS3Location(v.([]interface{}))
+		} else {
+			attributesToDelete = append(attributesToDelete, appstream.FleetAttributeSessionScriptS3Location)
+		}
+	}
+
 	if d.HasChange("vpc_config") {
 		input.VpcConfig = expandVPCConfig(d.Get("vpc_config").([]interface{}))
 	}
 
+	if len(attributesToDelete) > 0 {
+		input.AttributesToDelete = aws.StringSlice(attributesToDelete)
+	}
+
 	resp, err := conn.UpdateFleetWithContext(ctx, input)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Appstream Fleet (%s): %w", d.Id(), err))
@@ -550,7 +624,32 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+// multiSessionInstanceFamilies are the instance families that support multi-session fleets.
+var multiSessionInstanceFamilies = []string{
+	"stream.compute.",
+	"stream.graphics.g4dn.",
+	"stream.graphics.g5.",
+	"stream.memory.",
+	"stream.standard.",
+}
+
 func resourceFleetCustDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("max_sessions_per_instance"); ok && v.(int) > 1 {
+		if fleetType := diff.Get("fleet_type").(string); fleetType == appstream.FleetTypeElastic {
+			return fmt.Errorf("max_sessions_per_instance is not supported for %s fleets", fleetType)
+		}
+
+		if instanceType := diff.Get("instance_type").(string); instanceType != "" && !multiSessionInstanceTypeSupported(instanceType) {
+			return fmt.Errorf("instance_type %q does not support multi-session fleets", instanceType)
+		}
+
+		if raw := diff.GetRawConfig().GetAttr("compute_capacity"); raw.IsKnown() && !raw.IsNull() && raw.LengthInt() > 0 {
+			if v := raw.Index(cty.NumberIntVal(0)).GetAttr("desired_sessions"); v.IsNull() {
+				return errors.New("compute_capacity.desired_sessions must be set when max_sessions_per_instance is greater than 1")
+			}
+		}
+	}
+
 	if diff.HasChange("domain_join_info") {
 		o, n := diff.GetChange("domain_join_info")
 
@@ -561,7 +660,19 @@ func resourceFleetCustDiff(_ context.Context, diff *schema.ResourceDiff, meta in
 	return nil
 }
 
-func expandComputeCapacity(tfList []interface{}) *appstream.ComputeCapacity {
+func multiSessionInstanceTypeSupported(instanceType string) bool {
+	for _, family := range multiSessionInstanceFamilies {
+		if strings.HasPrefix(instanceType, family) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandComputeCapacity uses the raw configuration to choose between desired_sessions and
+// desired_instances, as both are Computed and keep their prior values in the planned state.
+func expandComputeCapacity(tfList []interface{}, rawConfig cty.Value) *appstream.ComputeCapacity {
 	if len(tfList) == 0 {
 		return nil
 	}
@@ -569,7 +680,9 @@ func expandComputeCapacity(tfList []interface{}) *appstream.ComputeCapacity {
 	apiObject := &appstream.ComputeCapacity{}
 
 	attr := tfList[0].(map[string]interface{})
-	if v, ok := attr["desired_instances"]; ok {
+	if computeCapacityAttributeConfigured(rawConfig, "desired_sessions") {
+		apiObject.DesiredSessions = aws.Int64(int64(attr["desired_sessions"].(int)))
+	} else if v, ok := attr["desired_instances"]; ok {
 		apiObject.DesiredInstances = aws.Int64(int64(v.(int)))
 	}
 
@@ -580,6 +693,14 @@ func expandComputeCapacity(tfList []interface{}) *appstream.ComputeCapacity {
 	return apiObject
 }
 
+func computeCapacityAttributeConfigured(rawConfig cty.Value, name string) bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() || rawConfig.LengthInt() == 0 {
+		return false
+	}
+
+	return !rawConfig.Index(cty.NumberIntVal(0)).GetAttr(name).IsNull()
+}
+
 func flattenComputeCapacity(apiObject *appstream.ComputeCapacityStatus) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -591,6 +712,10 @@ func flattenComputeCapacity(apiObject *appstream.ComputeCapacityStatus) map[stri
 		tfMap["desired_instances"] = aws.Int64Value(v)
 	}
 
+	if v := apiObject.DesiredUserSessions; v != nil {
+		tfMap["desired_sessions"] = aws.Int64Value(v)
+	}
+
 	if v := apiObject.Available; v != nil {
 		tfMap["available"] = aws.Int64Value(v)
 	}
@@ -660,6 +785,35 @@ func flattenDomainInfo(apiObject *appstream.DomainJoinInfo) map[string]interface
 	return tfMap
 }
 
+func expandS3Location(tfList []interface{}) *appstream.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appstream.S3Location{
+		S3Bucket: aws.String(tfMap["s3_bucket"].(string)),
+	}
+
+	if v, ok := tfMap["s3_key"]; ok && v != "" {
+		apiObject.S3Key = aws.String(v.(string))
+	}
+
+	return apiObject
+}
+
+func flattenS3Location(apiObject *appstream.S3Location) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"s3_bucket": aws.StringValue(apiObject.S3Bucket),
+		"s3_key":    aws.StringValue(apiObject.S3Key),
+	}
+}
+
 func expandVPCConfig(tfList []interface{}) *appstream.VpcConfig {
 	if len(tfList) == 0 {
 		return nil
@@ -3,6 +3,8 @@ package appstream_test
 import (
 	"context"
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -305,6 +307,86 @@ func TestAccAppStreamFleet_emptyDomainJoin(t *testing.T) {
 	})
 }
 
+func TestAccAppStreamFleet_multiSession(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Multi-session fleets require a recent image with multi-session support.
+	imageName := os.Getenv("APPSTREAM_MULTI_SESSION_IMAGE_NAME")
+	if imageName == "" {
+		t.Skip("Environment variable APPSTREAM_MULTI_SESSION_IMAGE_NAME is not set")
+	}
+
+	var fleetOutput appstream.Fleet
+	resourceName := "aws_appstream_fleet.test"
+	instanceType := "stream.standard.large"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckHasIAMRole(t, "AmazonAppStreamServiceAccess")
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_multiSession(rName, imageName, instanceType, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_sessions", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_sessions_per_instance", "2"),
+					resource.TestCheckResourceAttr(resourceName, "session_script_s3_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "session_script_s3_location.0.s3_bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "session_script_s3_location.0.s3_key", "session-script.zip"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFleetConfig_multiSession(rName, imageName, instanceType, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_sessions", "4"),
+					resource.TestCheckResourceAttr(resourceName, "max_sessions_per_instance", "4"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+			{
+				Config: testAccFleetConfig_singleSession(rName, imageName, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleetOutput),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_instances", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_capacity.0.desired_sessions", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_sessions_per_instance", "0"),
+					resource.TestCheckResourceAttr(resourceName, "state", appstream.FleetStateRunning),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppStreamFleet_multiSessionValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFleetConfig_multiSessionInstanceType(rName, "stream.graphics-pro.4xlarge"),
+				ExpectError: regexp.MustCompile(`does not support multi-session fleets`),
+			},
+		},
+	})
+}
+
 func testAccCheckFleetExists(ctx context.Context, resourceName string, appStreamFleet *appstream.Fleet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -512,3 +594,65 @@ resource "aws_appstream_fleet" "test" {
 }
 `, name, instanceType, empty)
 }
+
+func testAccFleetConfig_multiSession(name, imageName, instanceType string, sessions int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_appstream_fleet" "test" {
+  name                      = %[1]q
+  image_name                = %[2]q
+  instance_type             = %[3]q
+  max_sessions_per_instance = %[4]d
+
+  compute_capacity {
+    desired_sessions = %[4]d
+  }
+
+  session_script_s3_location {
+    s3_bucket = aws_s3_bucket.test.bucket
+    s3_key    = "session-script.zip"
+  }
+}
+`, name, imageName, instanceType, sessions)
+}
+
+func testAccFleetConfig_singleSession(name, imageName, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_appstream_fleet" "test" {
+  name          = %[1]q
+  image_name    = %[2]q
+  instance_type = %[3]q
+
+  compute_capacity {
+    desired_instances = 1
+  }
+
+  session_script_s3_location {
+    s3_bucket = aws_s3_bucket.test.bucket
+    s3_key    = "session-script.zip"
+  }
+}
+`, name, imageName, instanceType)
+}
+
+func testAccFleetConfig_multiSessionInstanceType(name, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_fleet" "test" {
+  name                      = %[1]q
+  image_name                = "Amazon-AppStream2-Sample-Image-02-04-2019"
+  instance_type             = %[2]q
+  max_sessions_per_instance = 2
+
+  compute_capacity {
+    desired_sessions = 2
+  }
+}
+`, name, instanceType)
+}
@@ -154,6 +154,22 @@ func ResourceStack() *schema.Resource {
 				},
 				Set: storageConnectorsHash,
 			},
+			"streaming_experience_settings": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preferred_protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(appstream.PreferredProtocol_Values(), false),
+						},
+					},
+				},
+			},
 			"user_settings": {
 				Type:             schema.TypeSet,
 				Optional:         true,
@@ -270,6 +286,10 @@ func resourceStackCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.StorageConnectors = expandStorageConnectors(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("streaming_experience_settings"); ok {
+		input.StreamingExperienceSettings = expandStreamingExperienceSettings(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("user_settings"); ok {
 		input.UserSettings = expandUserSettings(v.(*schema.Set).List())
 	}
@@ -342,6 +362,9 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		if err = d.Set("storage_connectors", flattenStorageConnectors(v.StorageConnectors)); err != nil {
 			return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Stack (%s): %w", "storage_connectors", d.Id(), err))
 		}
+		if err = d.Set("streaming_experience_settings", flattenStreamingExperienceSettings(v.StreamingExperienceSettings)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Stack (%s): %w", "streaming_experience_settings", d.Id(), err))
+		}
 		if err = d.Set("user_settings", flattenUserSettings(v.UserSettings)); err != nil {
 			return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream Stack (%s): %w", "user_settings", d.Id(), err))
 		}
@@ -397,6 +420,10 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.RedirectURL = aws.String(d.Get("redirect_url").(string))
 	}
 
+	if d.HasChange("streaming_experience_settings") {
+		input.StreamingExperienceSettings = expandStreamingExperienceSettings(d.Get("streaming_experience_settings").([]interface{}))
+	}
+
 	if d.HasChange("user_settings") {
 		input.UserSettings = expandUserSettings(d.Get("user_settings").(*schema.Set).List())
 	}
@@ -634,6 +661,33 @@ func flattenStorageConnectors(apiObjects []*appstream.StorageConnector) []map[st
 	return tfList
 }
 
+func expandStreamingExperienceSettings(tfList []interface{}) *appstream.StreamingExperienceSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &appstream.StreamingExperienceSettings{}
+	if v, ok := tfMap["preferred_protocol"]; ok && v.(string) != "" {
+		apiObject.PreferredProtocol = aws.String(v.(string))
+	}
+
+	return apiObject
+}
+
+func flattenStreamingExperienceSettings(apiObject *appstream.StreamingExperienceSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"preferred_protocol": aws.StringValue(apiObject.PreferredProtocol),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandUserSetting(tfMap map[string]interface{}) *appstream.UserSetting {
 	if tfMap == nil {
 		return nil
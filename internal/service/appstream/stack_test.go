@@ -269,6 +269,43 @@ func TestAccAppStreamStack_applicationSettings_removeFromDisabled(t *testing.T)
 	})
 }
 
+func TestAccAppStreamStack_streamingExperienceSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackOutput appstream.Stack
+	resourceName := "aws_appstream_stack.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_streamingExperienceSettings(rName, appstream.PreferredProtocolUdp),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stackOutput),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.0.preferred_protocol", appstream.PreferredProtocolUdp),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStackConfig_streamingExperienceSettings(rName, appstream.PreferredProtocolTcp),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stackOutput),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "streaming_experience_settings.0.preferred_protocol", appstream.PreferredProtocolTcp),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAppStreamStack_withTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stackOutput appstream.Stack
@@ -506,3 +543,15 @@ resource "aws_appstream_stack" "test" {
 }
 `, name, description)
 }
+
+func testAccStackConfig_streamingExperienceSettings(name, preferredProtocol string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_stack" "test" {
+  name = %[1]q
+
+  streaming_experience_settings {
+    preferred_protocol = %[2]q
+  }
+}
+`, name, preferredProtocol)
+}
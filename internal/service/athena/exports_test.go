@@ -0,0 +1,6 @@
+package athena
+
+// Exports for use in tests only.
+var (
+	PreparedStatementParameterCount = preparedStatementParameterCount
+)
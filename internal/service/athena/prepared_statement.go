@@ -0,0 +1,265 @@
+package athena
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePreparedStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePreparedStatementCreate,
+		ReadWithoutTimeout:   resourcePreparedStatementRead,
+		UpdateWithoutTimeout: resourcePreparedStatementUpdate,
+		DeleteWithoutTimeout: resourcePreparedStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"parameter_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"query_statement": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 262144),
+					validPreparedStatementQuery,
+				),
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePreparedStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName := d.Get("workgroup").(string), d.Get("name").(string)
+	id := PreparedStatementCreateResourceID(workGroupName, statementName)
+	input := &athena.CreatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Athena Prepared Statement: %s", input)
+	_, err := conn.CreatePreparedStatementWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Athena Prepared Statement (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourcePreparedStatementRead(ctx, d, meta)
+}
+
+func resourcePreparedStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statement, err := FindPreparedStatementByTwoPartKey(ctx, conn, workGroupName, statementName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Prepared Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", statement.Description)
+	if statement.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(statement.LastModifiedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	d.Set("name", statement.StatementName)
+	d.Set("query_statement", statement.QueryStatement)
+	d.Set("workgroup", workGroupName)
+
+	if n, err := preparedStatementParameterCount(aws.StringValue(statement.QueryStatement)); err == nil {
+		d.Set("parameter_count", n)
+	} else {
+		d.Set("parameter_count", nil)
+	}
+
+	return nil
+}
+
+func resourcePreparedStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &athena.UpdatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Athena Prepared Statement: %s", input)
+	_, err = conn.UpdatePreparedStatementWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return resourcePreparedStatementRead(ctx, d, meta)
+}
+
+func resourcePreparedStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Prepared Statement: %s", d.Id())
+	_, err = conn.DeletePreparedStatementWithContext(ctx, &athena.DeletePreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) || tfawserr.ErrMessageContains(err, athena.ErrCodeInvalidRequestException, "not found") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const preparedStatementResourceIDSeparator = "/"
+
+func PreparedStatementCreateResourceID(workGroupName, statementName string) string {
+	parts := []string{workGroupName, statementName}
+	id := strings.Join(parts, preparedStatementResourceIDSeparator)
+
+	return id
+}
+
+func PreparedStatementParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, preparedStatementResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WorkGroupName%[2]sStatementName", id, preparedStatementResourceIDSeparator)
+}
+
+// preparedStatementParameterCount returns the number of `?` execution parameter
+// placeholders in a query statement. Question marks inside string literals, quoted
+// identifiers and comments are not placeholders and are skipped.
+func preparedStatementParameterCount(query string) (int, error) {
+	n := 0
+
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; c {
+		case '?':
+			n++
+		case '\'', '"':
+			// A doubled quote character is an escaped quote inside the literal.
+			for i++; ; i++ {
+				if i >= len(query) {
+					return 0, fmt.Errorf("unterminated %c-quoted literal", c)
+				}
+				if query[i] == c {
+					if i+1 < len(query) && query[i+1] == c {
+						i++
+						continue
+					}
+					break
+				}
+			}
+		case '-':
+			if i+1 < len(query) && query[i+1] == '-' {
+				for i < len(query) && query[i] != '\n' {
+					i++
+				}
+			}
+		case '/':
+			if i+1 < len(query) && query[i+1] == '*' {
+				end := strings.Index(query[i+2:], "*/")
+				if end < 0 {
+					return 0, fmt.Errorf("unterminated block comment")
+				}
+				i += end + 3
+			}
+		}
+	}
+
+	return n, nil
+}
+
+func validPreparedStatementQuery(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := preparedStatementParameterCount(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q: cannot determine execution parameter placeholders: %w", k, err))
+	}
+
+	return
+}
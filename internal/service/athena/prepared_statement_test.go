@@ -0,0 +1,229 @@
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestPreparedStatementParameterCount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		query         string
+		expected      int
+		expectedError bool
+	}{
+		{
+			query:    "SELECT * FROM t",
+			expected: 0,
+		},
+		{
+			query:    "SELECT * FROM t WHERE a = ? AND b = ?",
+			expected: 2,
+		},
+		{
+			query:    "SELECT '?' AS q, \"col?\" FROM t WHERE a = ?",
+			expected: 1,
+		},
+		{
+			query:    "SELECT 'it''s ?' FROM t WHERE a = ?",
+			expected: 1,
+		},
+		{
+			query:    "SELECT * FROM t -- b = ?\nWHERE a = ?",
+			expected: 1,
+		},
+		{
+			query:    "SELECT * FROM t /* b = ? */ WHERE a = ?",
+			expected: 1,
+		},
+		{
+			query:         "SELECT 'unterminated FROM t WHERE a = ?",
+			expectedError: true,
+		},
+		{
+			query:         "SELECT * FROM t /* WHERE a = ?",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		got, err := tfathena.PreparedStatementParameterCount(testCase.query)
+
+		if err == nil && testCase.expectedError {
+			t.Errorf("%q: expected error, got none", testCase.query)
+			continue
+		}
+
+		if err != nil && !testCase.expectedError {
+			t.Errorf("%q: unexpected error: %s", testCase.query, err)
+			continue
+		}
+
+		if got != testCase.expected {
+			t.Errorf("%q: got %d, expected %d", testCase.query, got, testCase.expected)
+		}
+	}
+}
+
+func TestAccAthenaPreparedStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreparedStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, "SELECT * FROM information_schema.tables WHERE table_schema = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter_count", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", "aws_athena_workgroup.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, "SELECT * FROM information_schema.tables WHERE table_schema = ? AND table_name = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaPreparedStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreparedStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_basic(rName, "SELECT 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfathena.ResourcePreparedStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaPreparedStatement_invalidQueryStatement(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, athena.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPreparedStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPreparedStatementConfig_basic(rName, "SELECT 'unterminated WHERE a = ?"),
+				ExpectError: regexp.MustCompile(`cannot determine execution parameter placeholders`),
+			},
+		},
+	})
+}
+
+func testAccCheckPreparedStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Prepared Statement ID is set")
+		}
+
+		workGroupName, statementName, err := tfathena.PreparedStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+		_, err = tfathena.FindPreparedStatementByTwoPartKey(ctx, conn, workGroupName, statementName)
+
+		return err
+	}
+}
+
+func testAccCheckPreparedStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_athena_prepared_statement" {
+				continue
+			}
+
+			workGroupName, statementName, err := tfathena.PreparedStatementParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfathena.FindPreparedStatementByTwoPartKey(ctx, conn, workGroupName, statementName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Athena Prepared Statement (%s) still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPreparedStatementConfig_basic(rName, queryStatement string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_prepared_statement" "test" {
+  name            = %[1]q
+  workgroup       = aws_athena_workgroup.test.name
+  query_statement = %[2]q
+}
+`, rName, queryStatement)
+}
@@ -16,6 +16,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_athena_capacity_reservation", &resource.Sweeper{
+		Name: "aws_athena_capacity_reservation",
+		F:    sweepCapacityReservations,
+	})
+
 	resource.AddTestSweepers("aws_athena_database", &resource.Sweeper{
 		Name: "aws_athena_database",
 		F:    sweepDatabases,
@@ -77,3 +82,51 @@ func sweepDatabases(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepCapacityReservations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).AthenaConn()
+	input := &athena.ListCapacityReservationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCapacityReservationsPagesWithContext(ctx, input, func(page *athena.ListCapacityReservationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservations {
+			if aws.StringValue(v.Status) == athena.CapacityReservationStatusCancelled {
+				continue
+			}
+
+			r := ResourceCapacityReservation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Athena Capacity Reservation sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Athena Capacity Reservations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Athena Capacity Reservations (%s): %w", region, err)
+	}
+
+	return nil
+}
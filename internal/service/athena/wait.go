@@ -0,0 +1,52 @@
+package athena
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitCapacityReservationActive(ctx context.Context, conn *athena.Athena, name string, timeout time.Duration) (*athena.CapacityReservation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{athena.CapacityReservationStatusPending, athena.CapacityReservationStatusUpdatePending},
+		Target:  []string{athena.CapacityReservationStatusActive},
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*athena.CapacityReservation); ok {
+		if output.LastAllocation != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.LastAllocation.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCapacityReservationCancelled(ctx context.Context, conn *athena.Athena, name string, timeout time.Duration) (*athena.CapacityReservation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{athena.CapacityReservationStatusCancelling},
+		Target:  []string{},
+		Refresh: statusCapacityReservation(ctx, conn, name),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*athena.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,661 @@
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomModelCreate,
+		ReadWithoutTimeout:   resourceCustomModelRead,
+		UpdateWithoutTimeout: resourceCustomModelUpdate,
+		DeleteWithoutTimeout: resourceCustomModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"base_model_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// The API returns the base model ARN, which may include a version suffix.
+					return regexp.MustCompile(`/` + regexp.QuoteMeta(new) + `(:.+)?$`).MatchString(old)
+				},
+			},
+			"custom_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_model_kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"custom_model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"customization_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(bedrock.CustomizationType_Values(), false),
+			},
+			"hyperparameters": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"job_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9\+\-\.])*$`), "must contain only alphanumeric characters, hyphens, plus signs and periods"),
+				),
+			},
+			"job_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"training_data_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"training_metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"training_loss": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"validation_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validator": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"validation_metrics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validation_loss": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCustomModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("custom_model_name").(string)
+	jobName := d.Get("job_name").(string)
+	input := &bedrock.CreateModelCustomizationJobInput{
+		BaseModelIdentifier: aws.String(d.Get("base_model_identifier").(string)),
+		ClientRequestToken:  aws.String(resource.UniqueId()),
+		CustomModelName:     aws.String(name),
+		HyperParameters:     flex.ExpandStringMap(d.Get("hyperparameters").(map[string]interface{})),
+		JobName:             aws.String(jobName),
+		OutputDataConfig:    expandCustomModelOutputDataConfig(d.Get("output_data_config").([]interface{})),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+		TrainingDataConfig:  expandCustomModelTrainingDataConfig(d.Get("training_data_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("custom_model_kms_key_id"); ok {
+		input.CustomModelKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customization_type"); ok {
+		input.CustomizationType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("validation_data_config"); ok {
+		input.ValidationDataConfig = expandCustomModelValidationDataConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandCustomModelVPCConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.CustomModelTags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateModelCustomizationJobWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Bedrock Custom Model (%s) customization job (%s): %s", name, jobName, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobArn))
+
+	if _, err := waitModelCustomizationJobCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Custom Model customization job (%s) complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCustomModelRead(ctx, d, meta)...)
+}
+
+func resourceCustomModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	job, err := FindModelCustomizationJobByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Custom Model customization job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Custom Model customization job (%s): %s", d.Id(), err)
+	}
+
+	status := aws.StringValue(job.Status)
+	modelARN := aws.StringValue(job.OutputModelArn)
+
+	// A completed job whose custom model has since been deleted is gone.
+	if status == bedrock.ModelCustomizationJobStatusCompleted {
+		if _, err := FindCustomModelByID(ctx, conn, modelARN); tfresource.NotFound(err) {
+			if !d.IsNewResource() {
+				log.Printf("[WARN] Bedrock Custom Model (%s) not found, removing from state", modelARN)
+				d.SetId("")
+				return diags
+			}
+		} else if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Bedrock Custom Model (%s): %s", modelARN, err)
+		}
+	}
+
+	d.Set("base_model_identifier", job.BaseModelArn)
+	d.Set("custom_model_arn", modelARN)
+	d.Set("custom_model_kms_key_id", job.OutputModelKmsKeyArn)
+	d.Set("custom_model_name", job.OutputModelName)
+	d.Set("customization_type", job.CustomizationType)
+	d.Set("hyperparameters", aws.StringValueMap(job.HyperParameters))
+	d.Set("job_arn", job.JobArn)
+	d.Set("job_name", job.JobName)
+	d.Set("job_status", status)
+	if err := d.Set("output_data_config", flattenCustomModelOutputDataConfig(job.OutputDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_data_config: %s", err)
+	}
+	d.Set("role_arn", job.RoleArn)
+	if err := d.Set("training_data_config", flattenCustomModelTrainingDataConfig(job.TrainingDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting training_data_config: %s", err)
+	}
+	if err := d.Set("training_metrics", flattenCustomModelTrainingMetrics(job.TrainingMetrics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting training_metrics: %s", err)
+	}
+	if err := d.Set("validation_data_config", flattenCustomModelValidationDataConfig(job.ValidationDataConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting validation_data_config: %s", err)
+	}
+	if err := d.Set("validation_metrics", flattenCustomModelValidationMetrics(job.ValidationMetrics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting validation_metrics: %s", err)
+	}
+	if err := d.Set("vpc_config", flattenCustomModelVPCConfig(job.VpcConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
+	}
+
+	if modelARN == "" {
+		return diags
+	}
+
+	tags, err := ListTags(ctx, conn, modelARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Bedrock Custom Model (%s): %s", modelARN, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceCustomModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("custom_model_arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Bedrock Custom Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCustomModelRead(ctx, d, meta)...)
+}
+
+func resourceCustomModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+
+	if d.Get("job_status").(string) == bedrock.ModelCustomizationJobStatusInProgress {
+		log.Printf("[DEBUG] Stopping Bedrock Custom Model customization job: %s", d.Id())
+		_, err := conn.StopModelCustomizationJobWithContext(ctx, &bedrock.StopModelCustomizationJobInput{
+			JobIdentifier: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "stopping Bedrock Custom Model customization job (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitModelCustomizationJobStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Custom Model customization job (%s) stop: %s", d.Id(), err)
+		}
+	}
+
+	modelARN := d.Get("custom_model_arn").(string)
+
+	if modelARN == "" {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Deleting Bedrock Custom Model: %s", modelARN)
+	_, err := conn.DeleteCustomModelWithContext(ctx, &bedrock.DeleteCustomModelInput{
+		ModelIdentifier: aws.String(modelARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Custom Model (%s): %s", modelARN, err)
+	}
+
+	return diags
+}
+
+func FindModelCustomizationJobByID(ctx context.Context, conn *bedrock.Bedrock, id string) (*bedrock.GetModelCustomizationJobOutput, error) {
+	input := &bedrock.GetModelCustomizationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetModelCustomizationJobWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindCustomModelByID(ctx context.Context, conn *bedrock.Bedrock, id string) (*bedrock.GetCustomModelOutput, error) {
+	input := &bedrock.GetCustomModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetCustomModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelCustomizationJob(ctx context.Context, conn *bedrock.Bedrock, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindModelCustomizationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitModelCustomizationJobCompleted(ctx context.Context, conn *bedrock.Bedrock, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ModelCustomizationJobStatusInProgress},
+		Target:  []string{bedrock.ModelCustomizationJobStatusCompleted},
+		Refresh: statusModelCustomizationJob(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelCustomizationJobStopped(ctx context.Context, conn *bedrock.Bedrock, id string, timeout time.Duration) (*bedrock.GetModelCustomizationJobOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ModelCustomizationJobStatusInProgress, bedrock.ModelCustomizationJobStatusStopping},
+		Target:  []string{bedrock.ModelCustomizationJobStatusStopped},
+		Refresh: statusModelCustomizationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelCustomizationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCustomModelOutputDataConfig(tfList []interface{}) *bedrock.OutputDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &bedrock.OutputDataConfig{
+		S3Uri: aws.String(tfMap["s3_uri"].(string)),
+	}
+}
+
+func expandCustomModelTrainingDataConfig(tfList []interface{}) *bedrock.TrainingDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &bedrock.TrainingDataConfig{
+		S3Uri: aws.String(tfMap["s3_uri"].(string)),
+	}
+}
+
+func expandCustomModelValidationDataConfig(tfList []interface{}) *bedrock.ValidationDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &bedrock.ValidationDataConfig{}
+
+	for _, v := range tfMap["validator"].([]interface{}) {
+		validator, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Validators = append(apiObject.Validators, &bedrock.Validator{
+			S3Uri: aws.String(validator["s3_uri"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func expandCustomModelVPCConfig(tfList []interface{}) *bedrock.VpcConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &bedrock.VpcConfig{
+		SecurityGroupIds: flex.ExpandStringSet(tfMap["security_group_ids"].(*schema.Set)),
+		SubnetIds:        flex.ExpandStringSet(tfMap["subnet_ids"].(*schema.Set)),
+	}
+}
+
+func flattenCustomModelOutputDataConfig(apiObject *bedrock.OutputDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"s3_uri": aws.StringValue(apiObject.S3Uri),
+	}}
+}
+
+func flattenCustomModelTrainingDataConfig(apiObject *bedrock.TrainingDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"s3_uri": aws.StringValue(apiObject.S3Uri),
+	}}
+}
+
+func flattenCustomModelTrainingMetrics(apiObject *bedrock.TrainingMetrics) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"training_loss": aws.Float64Value(apiObject.TrainingLoss),
+	}}
+}
+
+func flattenCustomModelValidationDataConfig(apiObject *bedrock.ValidationDataConfig) []interface{} {
+	if apiObject == nil || len(apiObject.Validators) == 0 {
+		return nil
+	}
+
+	var validators []interface{}
+
+	for _, v := range apiObject.Validators {
+		if v == nil {
+			continue
+		}
+
+		validators = append(validators, map[string]interface{}{
+			"s3_uri": aws.StringValue(v.S3Uri),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"validator": validators,
+	}}
+}
+
+func flattenCustomModelValidationMetrics(apiObjects []*bedrock.ValidatorMetric) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"validation_loss": aws.Float64Value(apiObject.ValidationLoss),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCustomModelVPCConfig(apiObject *bedrock.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"security_group_ids": aws.StringValueSlice(apiObject.SecurityGroupIds),
+		"subnet_ids":         aws.StringValueSlice(apiObject.SubnetIds),
+	}}
+}
@@ -0,0 +1,268 @@
+package bedrock
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceModelInvocationLoggingConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelInvocationLoggingConfigurationPut,
+		ReadWithoutTimeout:   resourceModelInvocationLoggingConfigurationRead,
+		UpdateWithoutTimeout: resourceModelInvocationLoggingConfigurationPut,
+		DeleteWithoutTimeout: resourceModelInvocationLoggingConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"logging_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"large_data_delivery_s3_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem:     s3ConfigSchema(),
+									},
+									"log_group_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"embedding_data_delivery_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"image_data_delivery_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"s3_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     s3ConfigSchema(),
+						},
+						"text_data_delivery_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func s3ConfigSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+		},
+	}
+}
+
+func resourceModelInvocationLoggingConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+
+	input := &bedrock.PutModelInvocationLoggingConfigurationInput{
+		LoggingConfig: expandLoggingConfig(d.Get("logging_config").([]interface{})),
+	}
+
+	_, err := conn.PutModelInvocationLoggingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Bedrock Model Invocation Logging Configuration: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return append(diags, resourceModelInvocationLoggingConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceModelInvocationLoggingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+
+	loggingConfig, err := FindModelInvocationLoggingConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Model Invocation Logging Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Model Invocation Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("logging_config", flattenLoggingConfig(loggingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting logging_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceModelInvocationLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+
+	log.Printf("[DEBUG] Deleting Bedrock Model Invocation Logging Configuration: %s", d.Id())
+	_, err := conn.DeleteModelInvocationLoggingConfigurationWithContext(ctx, &bedrock.DeleteModelInvocationLoggingConfigurationInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Model Invocation Logging Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindModelInvocationLoggingConfiguration(ctx context.Context, conn *bedrock.Bedrock) (*bedrock.LoggingConfig, error) {
+	input := &bedrock.GetModelInvocationLoggingConfigurationInput{}
+
+	output, err := conn.GetModelInvocationLoggingConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LoggingConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LoggingConfig, nil
+}
+
+func expandLoggingConfig(tfList []interface{}) *bedrock.LoggingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &bedrock.LoggingConfig{
+		EmbeddingDataDeliveryEnabled: aws.Bool(tfMap["embedding_data_delivery_enabled"].(bool)),
+		ImageDataDeliveryEnabled:     aws.Bool(tfMap["image_data_delivery_enabled"].(bool)),
+		TextDataDeliveryEnabled:      aws.Bool(tfMap["text_data_delivery_enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["cloudwatch_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.CloudWatchConfig = &bedrock.CloudWatchConfig{
+			LogGroupName: aws.String(tfMap["log_group_name"].(string)),
+			RoleArn:      aws.String(tfMap["role_arn"].(string)),
+		}
+
+		if v, ok := tfMap["large_data_delivery_s3_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CloudWatchConfig.LargeDataDeliveryS3Config = expandS3Config(v[0].(map[string]interface{}))
+		}
+	}
+
+	if v, ok := tfMap["s3_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Config = expandS3Config(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3Config(tfMap map[string]interface{}) *bedrock.S3Config {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &bedrock.S3Config{
+		BucketName: aws.String(tfMap["bucket_name"].(string)),
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLoggingConfig(apiObject *bedrock.LoggingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"embedding_data_delivery_enabled": aws.BoolValue(apiObject.EmbeddingDataDeliveryEnabled),
+		"image_data_delivery_enabled":     aws.BoolValue(apiObject.ImageDataDeliveryEnabled),
+		"text_data_delivery_enabled":      aws.BoolValue(apiObject.TextDataDeliveryEnabled),
+	}
+
+	if v := apiObject.CloudWatchConfig; v != nil {
+		cloudWatchConfig := map[string]interface{}{
+			"log_group_name": aws.StringValue(v.LogGroupName),
+			"role_arn":       aws.StringValue(v.RoleArn),
+		}
+
+		if v := v.LargeDataDeliveryS3Config; v != nil {
+			cloudWatchConfig["large_data_delivery_s3_config"] = flattenS3Config(v)
+		}
+
+		tfMap["cloudwatch_config"] = []interface{}{cloudWatchConfig}
+	}
+
+	if v := apiObject.S3Config; v != nil {
+		tfMap["s3_config"] = flattenS3Config(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3Config(apiObject *bedrock.S3Config) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"bucket_name": aws.StringValue(apiObject.BucketName),
+		"key_prefix":  aws.StringValue(apiObject.KeyPrefix),
+	}}
+}
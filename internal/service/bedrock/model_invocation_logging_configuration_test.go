@@ -0,0 +1,284 @@
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockModelInvocationLoggingConfiguration_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		"basic":      testAccModelInvocationLoggingConfiguration_basic,
+		"disappears": testAccModelInvocationLoggingConfiguration_disappears,
+		"cloudWatch": testAccModelInvocationLoggingConfiguration_cloudWatch,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccModelInvocationLoggingConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_bedrock_model_invocation_logging_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrock.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationLoggingConfigurationConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.cloudwatch_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.embedding_data_delivery_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.image_data_delivery_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.s3_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.s3_config.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.s3_config.0.key_prefix", "bedrock"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.text_data_delivery_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccModelInvocationLoggingConfigurationConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.embedding_data_delivery_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.image_data_delivery_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.text_data_delivery_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelInvocationLoggingConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_bedrock_model_invocation_logging_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrock.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationLoggingConfigurationConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationLoggingConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourceModelInvocationLoggingConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccModelInvocationLoggingConfiguration_cloudWatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_bedrock_model_invocation_logging_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrock.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelInvocationLoggingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelInvocationLoggingConfigurationConfig_cloudWatch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelInvocationLoggingConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.cloudwatch_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.cloudwatch_config.0.large_data_delivery_s3_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.cloudwatch_config.0.large_data_delivery_s3_config.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.cloudwatch_config.0.large_data_delivery_s3_config.0.key_prefix", "large-data"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.cloudwatch_config.0.log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.cloudwatch_config.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.s3_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckModelInvocationLoggingConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Model Invocation Logging Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn()
+
+		_, err := tfbedrock.FindModelInvocationLoggingConfiguration(ctx, conn)
+
+		return err
+	}
+}
+
+func testAccCheckModelInvocationLoggingConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_model_invocation_logging_configuration" {
+				continue
+			}
+
+			_, err := tfbedrock.FindModelInvocationLoggingConfiguration(ctx, conn)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Model Invocation Logging Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccModelInvocationLoggingConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "bedrock.${data.aws_partition.current.dns_suffix}"
+      }
+      Action   = ["s3:*"]
+      Resource = ["${aws_s3_bucket.test.arn}/*"]
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnLike = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:*"
+        }
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccModelInvocationLoggingConfigurationConfig_basic(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccModelInvocationLoggingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_bedrock_model_invocation_logging_configuration" "test" {
+  logging_config {
+    embedding_data_delivery_enabled = %[1]t
+    image_data_delivery_enabled     = %[1]t
+    text_data_delivery_enabled      = true
+
+    s3_config {
+      bucket_name = aws_s3_bucket.test.bucket
+      key_prefix  = "bedrock"
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, enabled))
+}
+
+func testAccModelInvocationLoggingConfigurationConfig_cloudWatch(rName string) string {
+	return acctest.ConfigCompose(testAccModelInvocationLoggingConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "bedrock.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["logs:CreateLogStream", "logs:PutLogEvents"]
+      Effect   = "Allow"
+      Resource = "${aws_cloudwatch_log_group.test.arn}:log-stream:*"
+    }]
+  })
+}
+
+resource "aws_bedrock_model_invocation_logging_configuration" "test" {
+  logging_config {
+    cloudwatch_config {
+      log_group_name = aws_cloudwatch_log_group.test.name
+      role_arn       = aws_iam_role.test.arn
+
+      large_data_delivery_s3_config {
+        bucket_name = aws_s3_bucket.test.bucket
+        key_prefix  = "large-data"
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test, aws_s3_bucket_policy.test]
+}
+`, rName))
+}
@@ -0,0 +1,276 @@
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProvisionedModelThroughput() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProvisionedModelThroughputCreate,
+		ReadWithoutTimeout:   resourceProvisionedModelThroughputRead,
+		UpdateWithoutTimeout: resourceProvisionedModelThroughputUpdate,
+		DeleteWithoutTimeout: resourceProvisionedModelThroughputDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"commitment_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(bedrock.CommitmentDuration_Values(), false),
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"model_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"provisioned_model_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provisioned_model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-zA-Z][_-]?)+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceProvisionedModelThroughputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("provisioned_model_name").(string)
+	input := &bedrock.CreateProvisionedModelThroughputInput{
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ModelId:              aws.String(d.Get("model_arn").(string)),
+		ModelUnits:           aws.Int64(int64(d.Get("model_units").(int))),
+		ProvisionedModelName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("commitment_duration"); ok {
+		input.CommitmentDuration = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateProvisionedModelThroughputWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Bedrock Provisioned Model Throughput (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ProvisionedModelArn))
+
+	if _, err := waitProvisionedModelThroughputInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Provisioned Model Throughput (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProvisionedModelThroughputRead(ctx, d, meta)...)
+}
+
+func resourceProvisionedModelThroughputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindProvisionedModelThroughputByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Bedrock Provisioned Model Throughput (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Bedrock Provisioned Model Throughput (%s): %s", d.Id(), err)
+	}
+
+	d.Set("commitment_duration", output.CommitmentDuration)
+	d.Set("model_arn", output.ModelArn)
+	d.Set("model_units", output.ModelUnits)
+	d.Set("provisioned_model_arn", output.ProvisionedModelArn)
+	d.Set("provisioned_model_name", output.ProvisionedModelName)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Bedrock Provisioned Model Throughput (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceProvisionedModelThroughputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+
+	if d.HasChanges("model_arn", "provisioned_model_name") {
+		input := &bedrock.UpdateProvisionedModelThroughputInput{
+			ProvisionedModelId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("model_arn") {
+			input.DesiredModelId = aws.String(d.Get("model_arn").(string))
+		}
+
+		if d.HasChange("provisioned_model_name") {
+			input.DesiredProvisionedModelName = aws.String(d.Get("provisioned_model_name").(string))
+		}
+
+		_, err := conn.UpdateProvisionedModelThroughputWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Bedrock Provisioned Model Throughput (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitProvisionedModelThroughputInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Bedrock Provisioned Model Throughput (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Bedrock Provisioned Model Throughput (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProvisionedModelThroughputRead(ctx, d, meta)...)
+}
+
+func resourceProvisionedModelThroughputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BedrockConn()
+
+	log.Printf("[DEBUG] Deleting Bedrock Provisioned Model Throughput: %s", d.Id())
+	_, err := conn.DeleteProvisionedModelThroughputWithContext(ctx, &bedrock.DeleteProvisionedModelThroughputInput{
+		ProvisionedModelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Bedrock Provisioned Model Throughput (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindProvisionedModelThroughputByID(ctx context.Context, conn *bedrock.Bedrock, id string) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	input := &bedrock.GetProvisionedModelThroughputInput{
+		ProvisionedModelId: aws.String(id),
+	}
+
+	output, err := conn.GetProvisionedModelThroughputWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, bedrock.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusProvisionedModelThroughput(ctx context.Context, conn *bedrock.Bedrock, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProvisionedModelThroughputByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitProvisionedModelThroughputInService(ctx context.Context, conn *bedrock.Bedrock, id string, timeout time.Duration) (*bedrock.GetProvisionedModelThroughputOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{bedrock.ProvisionedModelStatusCreating, bedrock.ProvisionedModelStatusUpdating},
+		Target:  []string{bedrock.ProvisionedModelStatusInService},
+		Refresh: statusProvisionedModelThroughput(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetProvisionedModelThroughputOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
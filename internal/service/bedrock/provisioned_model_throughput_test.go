@@ -0,0 +1,220 @@
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBedrockProvisionedModelThroughput_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetProvisionedModelThroughputOutput
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	modelARN := testAccPreCheckProvisionedModelThroughputModel(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrock.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedModelThroughputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName, modelARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "commitment_duration", ""),
+					resource.TestCheckResourceAttr(resourceName, "model_arn", modelARN),
+					resource.TestCheckResourceAttr(resourceName, "model_units", "1"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "provisioned_model_arn", "bedrock", regexp.MustCompile(`provisioned-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockProvisionedModelThroughput_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetProvisionedModelThroughputOutput
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	modelARN := testAccPreCheckProvisionedModelThroughputModel(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrock.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedModelThroughputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig_basic(rName, modelARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourceProvisionedModelThroughput(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockProvisionedModelThroughput_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v bedrock.GetProvisionedModelThroughputOutput
+	resourceName := "aws_bedrock_provisioned_model_throughput.test"
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	modelARN := testAccPreCheckProvisionedModelThroughputModel(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, bedrock.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedModelThroughputDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedModelThroughputConfig_tags1(rName1, modelARN, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisionedModelThroughputConfig_tags2(rName2, modelARN, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedModelThroughputExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_model_name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckProvisionedModelThroughputModel returns the ARN of a model
+// that supports no-commitment Provisioned Throughput in the test region.
+func testAccPreCheckProvisionedModelThroughputModel(t *testing.T) string {
+	modelARN := os.Getenv("BEDROCK_PROVISIONED_MODEL_ARN")
+	if modelARN == "" {
+		t.Skip("Environment variable BEDROCK_PROVISIONED_MODEL_ARN is not set")
+	}
+
+	return modelARN
+}
+
+func testAccCheckProvisionedModelThroughputExists(ctx context.Context, n string, v *bedrock.GetProvisionedModelThroughputOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Bedrock Provisioned Model Throughput ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn()
+
+		output, err := tfbedrock.FindProvisionedModelThroughputByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckProvisionedModelThroughputDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_provisioned_model_throughput" {
+				continue
+			}
+
+			_, err := tfbedrock.FindProvisionedModelThroughputByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Provisioned Model Throughput %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProvisionedModelThroughputConfig_basic(rName, modelARN string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_provisioned_model_throughput" "test" {
+  provisioned_model_name = %[1]q
+  model_arn              = %[2]q
+  model_units            = 1
+}
+`, rName, modelARN)
+}
+
+func testAccProvisionedModelThroughputConfig_tags1(rName, modelARN, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_provisioned_model_throughput" "test" {
+  provisioned_model_name = %[1]q
+  model_arn              = %[2]q
+  model_units            = 1
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, modelARN, tagKey1, tagValue1)
+}
+
+func testAccProvisionedModelThroughputConfig_tags2(rName, modelARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_provisioned_model_throughput" "test" {
+  provisioned_model_name = %[1]q
+  model_arn              = %[2]q
+  model_units            = 1
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, modelARN, tagKey1, tagValue1, tagKey2, tagValue2)
+}
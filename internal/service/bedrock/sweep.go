@@ -0,0 +1,116 @@
+//go:build sweep
+// +build sweep
+
+package bedrock
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_bedrock_custom_model", &resource.Sweeper{
+		Name: "aws_bedrock_custom_model",
+		F:    sweepCustomModels,
+	})
+
+	resource.AddTestSweepers("aws_bedrock_provisioned_model_throughput", &resource.Sweeper{
+		Name: "aws_bedrock_provisioned_model_throughput",
+		F:    sweepProvisionedModelThroughputs,
+	})
+}
+
+func sweepProvisionedModelThroughputs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).BedrockConn()
+	input := &bedrock.ListProvisionedModelThroughputsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListProvisionedModelThroughputsPagesWithContext(ctx, input, func(page *bedrock.ListProvisionedModelThroughputsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProvisionedModelSummaries {
+			r := ResourceProvisionedModelThroughput()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ProvisionedModelArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Bedrock Provisioned Model Throughput sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Bedrock Provisioned Model Throughputs (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Bedrock Provisioned Model Throughputs (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepCustomModels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).BedrockConn()
+	input := &bedrock.ListCustomModelsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCustomModelsPagesWithContext(ctx, input, func(page *bedrock.ListCustomModelsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ModelSummaries {
+			r := ResourceCustomModel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ModelArn))
+			d.Set("custom_model_arn", v.ModelArn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Bedrock Custom Model sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Bedrock Custom Models (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Bedrock Custom Models (%s): %w", region, err)
+	}
+
+	return nil
+}
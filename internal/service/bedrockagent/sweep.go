@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package bedrockagent
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/bedrockagent"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_bedrockagent_flow", &resource.Sweeper{
+		Name: "aws_bedrockagent_flow",
+		F:    sweepFlows,
+	})
+}
+
+func sweepFlows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).BedrockAgentConn()
+	input := &bedrockagent.ListFlowsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFlowsPagesWithContext(ctx, input, func(page *bedrockagent.ListFlowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FlowSummaries {
+			r := ResourceFlow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Bedrock Agent Flow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Bedrock Agent Flows (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Bedrock Agent Flows (%s): %w", region, err)
+	}
+
+	return nil
+}
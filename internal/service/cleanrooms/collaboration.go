@@ -0,0 +1,367 @@
+package cleanrooms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceCollaboration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCollaborationCreate,
+		ReadWithoutTimeout:   resourceCollaborationRead,
+		UpdateWithoutTimeout: resourceCollaborationUpdate,
+		DeleteWithoutTimeout: resourceCollaborationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"creator_member_abilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(cleanrooms.MemberAbility_Values(), false),
+				},
+			},
+			"data_encryption_metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_clear_text": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_duplicates": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allow_joins_on_columns_with_different_names": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"preserve_nulls": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"member_abilities": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(cleanrooms.MemberAbility_Values(), false),
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_log_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cleanrooms.CollaborationQueryLogStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameCollaboration = "Collaboration"
+)
+
+func resourceCollaborationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn()
+
+	name := d.Get("name").(string)
+	in := &cleanrooms.CreateCollaborationInput{
+		CreatorDisplayName:     aws.String(d.Get("creator_display_name").(string)),
+		CreatorMemberAbilities: flex.ExpandStringSet(d.Get("creator_member_abilities").(*schema.Set)),
+		Description:            aws.String(d.Get("description").(string)),
+		Members:                expandMemberSpecifications(d.Get("member").(*schema.Set).List()),
+		Name:                   aws.String(name),
+		QueryLogStatus:         aws.String(d.Get("query_log_status").(string)),
+	}
+
+	if v, ok := d.GetOk("data_encryption_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.DataEncryptionMetadata = expandDataEncryptionMetadata(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateCollaborationWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionCreating, ResNameCollaboration, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.Collaboration.Id))
+
+	return resourceCollaborationRead(ctx, d, meta)
+}
+
+func resourceCollaborationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn()
+
+	out, err := FindCollaborationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Clean Rooms Collaboration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameCollaboration, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("create_time", aws.TimeValue(out.CreateTime).Format(time.RFC3339))
+	d.Set("creator_display_name", out.CreatorDisplayName)
+
+	if out.DataEncryptionMetadata != nil {
+		if err := d.Set("data_encryption_metadata", []interface{}{flattenDataEncryptionMetadata(out.DataEncryptionMetadata)}); err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameCollaboration, d.Id(), err)
+		}
+	} else {
+		d.Set("data_encryption_metadata", nil)
+	}
+
+	d.Set("description", out.Description)
+	d.Set("name", out.Name)
+	d.Set("query_log_status", out.QueryLogStatus)
+	d.Set("update_time", aws.TimeValue(out.UpdateTime).Format(time.RFC3339))
+
+	members, err := FindMembersByCollaborationID(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameCollaboration, d.Id(), err)
+	}
+
+	// The collaboration creator is returned as a member too, but is configured
+	// through the creator_* arguments.
+	creatorAccountID := aws.StringValue(out.CreatorAccountId)
+	var memberList []interface{}
+	for _, v := range members {
+		if aws.StringValue(v.AccountId) == creatorAccountID {
+			d.Set("creator_member_abilities", aws.StringValueSlice(v.Abilities))
+			continue
+		}
+
+		memberList = append(memberList, flattenMemberSummary(v))
+	}
+
+	if err := d.Set("member", memberList); err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameCollaboration, d.Id(), err)
+	}
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionReading, ResNameCollaboration, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameCollaboration, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionSetting, ResNameCollaboration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCollaborationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn()
+
+	if d.HasChanges("description", "name") {
+		in := &cleanrooms.UpdateCollaborationInput{
+			CollaborationIdentifier: aws.String(d.Id()),
+			Description:             aws.String(d.Get("description").(string)),
+			Name:                    aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Clean Rooms Collaboration (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateCollaborationWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameCollaboration, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.CleanRooms, create.ErrActionUpdating, ResNameCollaboration, d.Id(), err)
+		}
+	}
+
+	return resourceCollaborationRead(ctx, d, meta)
+}
+
+func resourceCollaborationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CleanRoomsConn()
+
+	log.Printf("[INFO] Deleting Clean Rooms Collaboration %s", d.Id())
+
+	_, err := conn.DeleteCollaborationWithContext(ctx, &cleanrooms.DeleteCollaborationInput{
+		CollaborationIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.CleanRooms, create.ErrActionDeleting, ResNameCollaboration, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataEncryptionMetadata(tfMap map[string]interface{}) *cleanrooms.DataEncryptionMetadata {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &cleanrooms.DataEncryptionMetadata{
+		AllowCleartext:                        aws.Bool(tfMap["allow_clear_text"].(bool)),
+		AllowDuplicates:                       aws.Bool(tfMap["allow_duplicates"].(bool)),
+		AllowJoinsOnColumnsWithDifferentNames: aws.Bool(tfMap["allow_joins_on_columns_with_different_names"].(bool)),
+		PreserveNulls:                         aws.Bool(tfMap["preserve_nulls"].(bool)),
+	}
+}
+
+func expandMemberSpecifications(tfList []interface{}) []*cleanrooms.MemberSpecification {
+	apiObjects := []*cleanrooms.MemberSpecification{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &cleanrooms.MemberSpecification{
+			AccountId:       aws.String(tfMap["account_id"].(string)),
+			DisplayName:     aws.String(tfMap["display_name"].(string)),
+			MemberAbilities: flex.ExpandStringSet(tfMap["member_abilities"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDataEncryptionMetadata(apiObject *cleanrooms.DataEncryptionMetadata) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"allow_clear_text": aws.BoolValue(apiObject.AllowCleartext),
+		"allow_duplicates": aws.BoolValue(apiObject.AllowDuplicates),
+		"allow_joins_on_columns_with_different_names": aws.BoolValue(apiObject.AllowJoinsOnColumnsWithDifferentNames),
+		"preserve_nulls": aws.BoolValue(apiObject.PreserveNulls),
+	}
+}
+
+func flattenMemberSummary(apiObject *cleanrooms.MemberSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"account_id":       aws.StringValue(apiObject.AccountId),
+		"display_name":     aws.StringValue(apiObject.DisplayName),
+		"member_abilities": aws.StringValueSlice(apiObject.Abilities),
+		"status":           aws.StringValue(apiObject.Status),
+	}
+}
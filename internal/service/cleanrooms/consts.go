@@ -0,0 +1,9 @@
+package cleanrooms
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
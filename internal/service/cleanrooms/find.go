@@ -0,0 +1,145 @@
+package cleanrooms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCollaborationByID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Collaboration, error) {
+	in := &cleanrooms.GetCollaborationInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+	out, err := conn.GetCollaborationWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Collaboration == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Collaboration, nil
+}
+
+func FindMembersByCollaborationID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) ([]*cleanrooms.MemberSummary, error) {
+	in := &cleanrooms.ListMembersInput{
+		CollaborationIdentifier: aws.String(id),
+	}
+	var out []*cleanrooms.MemberSummary
+
+	err := conn.ListMembersPagesWithContext(ctx, in, func(page *cleanrooms.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.MemberSummaries {
+			if v != nil {
+				out = append(out, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func FindConfiguredTableByID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) (*cleanrooms.ConfiguredTable, error) {
+	in := &cleanrooms.GetConfiguredTableInput{
+		ConfiguredTableIdentifier: aws.String(id),
+	}
+	out, err := conn.GetConfiguredTableWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredTable == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.ConfiguredTable, nil
+}
+
+func FindMembershipByID(ctx context.Context, conn *cleanrooms.CleanRooms, id string) (*cleanrooms.Membership, error) {
+	in := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+	out, err := conn.GetMembershipWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := aws.StringValue(out.Membership.Status); status == cleanrooms.MembershipStatusRemoved || status == cleanrooms.MembershipStatusCollaborationDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: in,
+		}
+	}
+
+	return out.Membership, nil
+}
+
+func FindConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.CleanRooms, membershipID, associationID string) (*cleanrooms.ConfiguredTableAssociation, error) {
+	in := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(associationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+	out, err := conn.GetConfiguredTableAssociationWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, cleanrooms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.ConfiguredTableAssociation, nil
+}
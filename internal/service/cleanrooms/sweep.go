@@ -0,0 +1,124 @@
+//go:build sweep
+// +build sweep
+
+package cleanrooms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cleanrooms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cleanrooms_collaboration", &resource.Sweeper{
+		Name: "aws_cleanrooms_collaboration",
+		F:    sweepCollaborations,
+	})
+
+	resource.AddTestSweepers("aws_cleanrooms_configured_table", &resource.Sweeper{
+		Name: "aws_cleanrooms_configured_table",
+		F:    sweepConfiguredTables,
+		Dependencies: []string{
+			"aws_cleanrooms_collaboration",
+		},
+	})
+}
+
+func sweepCollaborations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).CleanRoomsConn()
+	accountID := client.(*conns.AWSClient).AccountID
+	input := &cleanrooms.ListCollaborationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCollaborationsPagesWithContext(ctx, input, func(page *cleanrooms.ListCollaborationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CollaborationList {
+			// Only the creator can delete a collaboration.
+			if aws.StringValue(v.CreatorAccountId) != accountID {
+				continue
+			}
+
+			r := ResourceCollaboration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Clean Rooms Collaboration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Clean Rooms Collaborations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Clean Rooms Collaborations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepConfiguredTables(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).CleanRoomsConn()
+	input := &cleanrooms.ListConfiguredTablesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListConfiguredTablesPagesWithContext(ctx, input, func(page *cleanrooms.ListConfiguredTablesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConfiguredTableSummaries {
+			r := ResourceConfiguredTable()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Clean Rooms Configured Table sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Clean Rooms Configured Tables (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Clean Rooms Configured Tables (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ func TestAccCloudHSMV2_serial(t *testing.T) {
 		"Cluster": {
 			"basic":      testAccCluster_basic,
 			"disappears": testAccCluster_disappears,
+			"mode":       testAccCluster_mode,
 			"tags":       testAccCluster_Tags,
 		},
 		"Hsm": {
@@ -44,7 +44,15 @@ func ResourceCluster() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"hsm1.medium", "hsm2m.medium"}, false),
+			},
+
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cloudhsmv2.ClusterMode_Values(), false),
 			},
 
 			"subnet_ids": {
@@ -104,6 +112,35 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"hsms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hsm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hsm_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -127,6 +164,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.TagList = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("mode"); ok {
+		input.Mode = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("source_backup_identifier"); ok {
 		input.SourceBackupId = aws.String(v.(string))
 	}
@@ -144,7 +185,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	log.Println("[INFO] Waiting for CloudHSMv2 Cluster to be available")
 
 	if input.SourceBackupId != nil {
-		if _, err := waitClusterActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitClusterRestored(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for CloudHSMv2 Cluster (%s) creation: %s", d.Id(), err)
 		}
 	} else {
@@ -196,6 +237,10 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("vpc_id", cluster.VpcId)
 	d.Set("source_backup_identifier", cluster.SourceBackupId)
 	d.Set("hsm_type", cluster.HsmType)
+	if err := d.Set("hsms", flattenHSMs(cluster.Hsms)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hsms: %s", err)
+	}
+	d.Set("mode", cluster.Mode)
 	if err := d.Set("cluster_certificates", readClusterCertificates(cluster)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
@@ -273,3 +318,27 @@ func readClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string]interface
 	}
 	return []map[string]interface{}{}
 }
+
+func flattenHSMs(apiObjects []*cloudhsmv2.Hsm) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone": aws.StringValue(apiObject.AvailabilityZone),
+			"hsm_id":            aws.StringValue(apiObject.HsmId),
+			"hsm_state":         aws.StringValue(apiObject.State),
+			"ip_address":        aws.StringValue(apiObject.EniIp),
+			"subnet_id":         aws.StringValue(apiObject.SubnetId),
+		})
+	}
+
+	return tfList
+}
@@ -66,6 +66,42 @@ func DataSourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"hsm_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hsms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hsm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hsm_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"subnet_ids": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -117,6 +153,11 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("vpc_id", cluster.VpcId)
 	d.Set("security_group_id", cluster.SecurityGroup)
 	d.Set("cluster_state", cluster.State)
+	d.Set("hsm_type", cluster.HsmType)
+	if err := d.Set("hsms", flattenHSMs(cluster.Hsms)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hsms: %s", err)
+	}
+	d.Set("mode", cluster.Mode)
 	if err := d.Set("cluster_certificates", readClusterCertificates(cluster)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cluster_certificates: %s", err)
 	}
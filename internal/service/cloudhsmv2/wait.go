@@ -30,6 +30,33 @@ func waitClusterActive(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id stri
 	return nil, err
 }
 
+// waitClusterRestored waits for a cluster created from a backup. A restored
+// cluster with no HSMs settles in INITIALIZED rather than ACTIVE.
+func waitClusterRestored(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudhsmv2.ClusterStateCreateInProgress,
+			cloudhsmv2.ClusterStateInitializeInProgress,
+		},
+		Target: []string{
+			cloudhsmv2.ClusterStateActive,
+			cloudhsmv2.ClusterStateInitialized,
+		},
+		Refresh:    statusClusterState(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*cloudhsmv2.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
 func waitClusterDeleted(ctx context.Context, conn *cloudhsmv2.CloudHSMV2, id string, timeout time.Duration) (*cloudhsmv2.Cluster, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{cloudhsmv2.ClusterStateDeleteInProgress},
@@ -65,6 +65,10 @@ func DataSourceUserPoolClient() *schema.Resource {
 					},
 				},
 			},
+			"auth_session_validity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"callback_urls": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -207,6 +211,7 @@ func dataSourceUserPoolClientRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("prevent_user_existence_errors", userPoolClient.PreventUserExistenceErrors)
 	d.Set("supported_identity_providers", flex.FlattenStringSet(userPoolClient.SupportedIdentityProviders))
 	d.Set("enable_token_revocation", userPoolClient.EnableTokenRevocation)
+	d.Set("auth_session_validity", userPoolClient.AuthSessionValidity)
 	d.Set("enable_propagate_additional_user_context_data", userPoolClient.EnablePropagateAdditionalUserContextData)
 
 	if err := d.Set("analytics_configuration", flattenUserPoolClientAnalyticsConfig(userPoolClient.AnalyticsConfiguration)); err != nil {
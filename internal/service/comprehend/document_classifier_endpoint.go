@@ -0,0 +1,324 @@
+package comprehend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend"
+	"github.com/aws/aws-sdk-go-v2/service/comprehend/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDocumentClassifierEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDocumentClassifierEndpointCreate,
+		ReadWithoutTimeout:   resourceDocumentClassifierEndpointRead,
+		UpdateWithoutTimeout: resourceDocumentClassifierEndpointUpdate,
+		DeleteWithoutTimeout: resourceDocumentClassifierEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"current_inference_units": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"data_access_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"desired_inference_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"model_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validIdentifier,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDocumentClassifierEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	in := &comprehend.CreateEndpointInput{
+		ClientRequestToken:    aws.String(resource.UniqueId()),
+		DesiredInferenceUnits: aws.Int32(int32(d.Get("desired_inference_units").(int))),
+		EndpointName:          aws.String(name),
+		ModelArn:              aws.String(d.Get("model_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("data_access_role_arn"); ok {
+		in.DataAccessRoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateEndpoint(ctx, in)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Comprehend Document Classifier Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(out.EndpointArn))
+
+	if _, err := waitDocumentClassifierEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Document Classifier Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDocumentClassifierEndpointRead(ctx, d, meta)...)
+}
+
+func resourceDocumentClassifierEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient()
+
+	out, err := FindDocumentClassifierEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Comprehend Document Classifier Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Document Classifier Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", out.EndpointArn)
+	d.Set("current_inference_units", out.CurrentInferenceUnits)
+	d.Set("data_access_role_arn", out.DataAccessRoleArn)
+	d.Set("desired_inference_units", out.DesiredInferenceUnits)
+	d.Set("model_arn", out.ModelArn)
+
+	// DescribeEndpoint() doesn't return the endpoint name
+	name, err := DocumentClassifierEndpointParseARN(aws.ToString(out.EndpointArn))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Comprehend Document Classifier Endpoint (%s): %s", d.Id(), err)
+	}
+	d.Set("name", name)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Comprehend Document Classifier Endpoint (%s): %s", d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDocumentClassifierEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient()
+
+	if d.HasChanges("data_access_role_arn", "desired_inference_units", "model_arn") {
+		in := &comprehend.UpdateEndpointInput{
+			EndpointArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_access_role_arn") {
+			in.DesiredDataAccessRoleArn = aws.String(d.Get("data_access_role_arn").(string))
+		}
+
+		if d.HasChange("desired_inference_units") {
+			in.DesiredInferenceUnits = aws.Int32(int32(d.Get("desired_inference_units").(int)))
+		}
+
+		if d.HasChange("model_arn") {
+			in.DesiredModelArn = aws.String(d.Get("model_arn").(string))
+		}
+
+		_, err := conn.UpdateEndpoint(ctx, in)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Comprehend Document Classifier Endpoint (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDocumentClassifierEndpointInService(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Document Classifier Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags for Comprehend Document Classifier Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDocumentClassifierEndpointRead(ctx, d, meta)...)
+}
+
+func resourceDocumentClassifierEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ComprehendClient()
+
+	log.Printf("[INFO] Deleting Comprehend Document Classifier Endpoint (%s)", d.Id())
+	_, err := conn.DeleteEndpoint(ctx, &comprehend.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Id()),
+	})
+
+	var nfe *types.ResourceNotFoundException
+	if errors.As(err, &nfe) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Comprehend Document Classifier Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDocumentClassifierEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Comprehend Document Classifier Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDocumentClassifierEndpointByID(ctx context.Context, conn *comprehend.Client, id string) (*types.EndpointProperties, error) {
+	in := &comprehend.DescribeEndpointInput{
+		EndpointArn: aws.String(id),
+	}
+
+	out, err := conn.DescribeEndpoint(ctx, in)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil || out.EndpointProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.EndpointProperties, nil
+}
+
+func waitDocumentClassifierEndpointInService(ctx context.Context, conn *comprehend.Client, id string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.EndpointStatusCreating, types.EndpointStatusUpdating),
+		Target:  enum.Slice(types.EndpointStatusInService),
+		Refresh: statusDocumentClassifierEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if output, ok := outputRaw.(*types.EndpointProperties); ok {
+		if output.Status == types.EndpointStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDocumentClassifierEndpointDeleted(ctx context.Context, conn *comprehend.Client, id string, timeout time.Duration) (*types.EndpointProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.EndpointStatusDeleting),
+		Target:  []string{},
+		Refresh: statusDocumentClassifierEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if output, ok := outputRaw.(*types.EndpointProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusDocumentClassifierEndpoint(ctx context.Context, conn *comprehend.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindDocumentClassifierEndpointByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func DocumentClassifierEndpointParseARN(arnString string) (string, error) {
+	arn, err := arn.Parse(arnString)
+	if err != nil {
+		return "", err
+	}
+	re := regexp.MustCompile(`^document-classifier-endpoint/([[:alnum:]-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if len(matches) != 2 {
+		return "", fmt.Errorf("unable to parse %q", arnString)
+	}
+	name := matches[1]
+
+	return name, nil
+}
@@ -0,0 +1,195 @@
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceFarm() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFarmCreate,
+		ReadWithoutTimeout:   resourceFarmRead,
+		UpdateWithoutTimeout: resourceFarmUpdate,
+		DeleteWithoutTimeout: resourceFarmDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameFarm = "Farm"
+)
+
+func resourceFarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	name := d.Get("display_name").(string)
+	in := &deadline.CreateFarmInput{
+		DisplayName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		in.KmsKeyArn = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateFarmWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionCreating, ResNameFarm, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.FarmId))
+
+	return resourceFarmRead(ctx, d, meta)
+}
+
+func resourceFarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	out, err := FindFarmByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Farm (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameFarm, d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   deadline.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("farm/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("description", out.Description)
+	d.Set("display_name", out.DisplayName)
+	d.Set("kms_key_arn", out.KmsKeyArn)
+
+	tags, err := ListTags(ctx, conn, arn)
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameFarm, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameFarm, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameFarm, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	if d.HasChanges("description", "display_name") {
+		in := &deadline.UpdateFarmInput{
+			Description: aws.String(d.Get("description").(string)),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			FarmId:      aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating Deadline Cloud Farm (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateFarmWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameFarm, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameFarm, d.Id(), err)
+		}
+	}
+
+	return resourceFarmRead(ctx, d, meta)
+}
+
+func resourceFarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	log.Printf("[INFO] Deleting Deadline Cloud Farm %s", d.Id())
+
+	_, err := conn.DeleteFarmWithContext(ctx, &deadline.DeleteFarmInput{
+		FarmId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameFarm, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,279 @@
+package deadline_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeadlineFarm_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var farm deadline.GetFarmOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_farm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFarmExists(ctx, resourceName, &farm),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "deadline", regexp.MustCompile(`farm/farm-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "kms_key_arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineFarm_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var farm deadline.GetFarmOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_farm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFarmExists(ctx, resourceName, &farm),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceFarm(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineFarm_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var farm deadline.GetFarmOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_farm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFarmExists(ctx, resourceName, &farm),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+				),
+			},
+			{
+				Config: testAccFarmConfig_description(rNameUpdated, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFarmExists(ctx, resourceName, &farm),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeadlineFarm_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var farm deadline.GetFarmOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_farm.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFarmDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFarmConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFarmExists(ctx, resourceName, &farm),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFarmConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFarmExists(ctx, resourceName, &farm),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFarmConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFarmExists(ctx, resourceName, &farm),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFarmDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_farm" {
+				continue
+			}
+
+			_, err := tfdeadline.FindFarmByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Deadline, create.ErrActionCheckingDestroyed, tfdeadline.ResNameFarm, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFarmExists(ctx context.Context, name string, farm *deadline.GetFarmOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameFarm, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameFarm, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		output, err := tfdeadline.FindFarmByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameFarm, rs.Primary.ID, err)
+		}
+
+		*farm = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+	input := &deadline.ListFarmsInput{}
+	_, err := conn.ListFarmsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccFarmConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+}
+`, rName)
+}
+
+func testAccFarmConfig_description(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+  description  = %[2]q
+}
+`, rName, description)
+}
+
+func testAccFarmConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccFarmConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
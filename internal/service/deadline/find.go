@@ -0,0 +1,107 @@
+package deadline
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFarmByID(ctx context.Context, conn *deadline.Deadline, id string) (*deadline.GetFarmOutput, error) {
+	in := &deadline.GetFarmInput{
+		FarmId: aws.String(id),
+	}
+	out, err := conn.GetFarmWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindFleetByTwoPartKey(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string) (*deadline.GetFleetOutput, error) {
+	in := &deadline.GetFleetInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+	}
+	out, err := conn.GetFleetWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindQueueByTwoPartKey(ctx context.Context, conn *deadline.Deadline, farmID, queueID string) (*deadline.GetQueueOutput, error) {
+	in := &deadline.GetQueueInput{
+		FarmId:  aws.String(farmID),
+		QueueId: aws.String(queueID),
+	}
+	out, err := conn.GetQueueWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindQueueFleetAssociationByThreePartKey(ctx context.Context, conn *deadline.Deadline, farmID, queueID, fleetID string) (*deadline.GetQueueFleetAssociationOutput, error) {
+	in := &deadline.GetQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+	}
+	out, err := conn.GetQueueFleetAssociationWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
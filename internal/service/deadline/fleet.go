@@ -0,0 +1,876 @@
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFleetCreate,
+		ReadWithoutTimeout:   resourceFleetRead,
+		UpdateWithoutTimeout: resourceFleetUpdate,
+		DeleteWithoutTimeout: resourceFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.customer_managed", "configuration.0.service_managed_ec2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(deadline.AutoScalingMode_Values(), false),
+									},
+									"storage_profile_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"worker_capabilities": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"accelerator_count":            rangeSchema(0),
+												"accelerator_total_memory_mib": rangeSchema(0),
+												"accelerator_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(deadline.AcceleratorType_Values(), false),
+													},
+												},
+												"cpu_architecture_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CpuArchitectureType_Values(), false),
+												},
+												"custom_amounts":    customAmountsSchema(),
+												"custom_attributes": customAttributesSchema(),
+												"memory_mib":        requiredRangeSchema(512),
+												"os_family": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CustomerManagedFleetOperatingSystemFamily_Values(), false),
+												},
+												"vcpu_count": requiredRangeSchema(1),
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_managed_ec2": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.customer_managed", "configuration.0.service_managed_ec2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_capabilities": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"allowed_instance_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"cpu_architecture_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.CpuArchitectureType_Values(), false),
+												},
+												"custom_amounts":    customAmountsSchema(),
+												"custom_attributes": customAttributesSchema(),
+												"excluded_instance_types": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"memory_mib": requiredRangeSchema(512),
+												"os_family": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.ServiceManagedFleetOperatingSystemFamily_Values(), false),
+												},
+												"root_ebs_volume": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"iops": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(3000, 16000),
+															},
+															"size_gib": {
+																Type:     schema.TypeInt,
+																Optional: true,
+																Computed: true,
+															},
+															"throughput_mib": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(125, 1000),
+															},
+														},
+													},
+												},
+												"vcpu_count": requiredRangeSchema(1),
+											},
+										},
+									},
+									"instance_market_options": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(deadline.Ec2MarketType_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_worker_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_worker_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"worker_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameFleet = "Fleet"
+)
+
+func rangeSchema(minimum int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(minimum),
+				},
+				"min": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(minimum),
+				},
+			},
+		},
+	}
+}
+
+func requiredRangeSchema(minimum int) *schema.Schema {
+	s := rangeSchema(minimum)
+	s.Optional = false
+	s.Required = true
+
+	return s
+}
+
+func customAmountsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+				},
+				"min": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func customAttributesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 100),
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID := d.Get("farm_id").(string)
+	name := d.Get("display_name").(string)
+	in := &deadline.CreateFleetInput{
+		Configuration:  expandFleetConfiguration(d.Get("configuration").([]interface{})),
+		DisplayName:    aws.String(name),
+		FarmId:         aws.String(farmID),
+		MaxWorkerCount: aws.Int64(int64(d.Get("max_worker_count").(int))),
+		MinWorkerCount: aws.Int64(int64(d.Get("min_worker_count").(int))),
+		RoleArn:        aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateFleetWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionCreating, ResNameFleet, name, err)
+	}
+
+	fleetID := aws.StringValue(out.FleetId)
+	d.SetId(FleetCreateResourceID(farmID, fleetID))
+
+	if _, err := waitFleetCreated(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionWaitingForCreation, ResNameFleet, d.Id(), err)
+	}
+
+	return resourceFleetRead(ctx, d, meta)
+}
+
+func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, fleetID, err := FleetParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameFleet, d.Id(), err)
+	}
+
+	out, err := FindFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameFleet, d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   deadline.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("farm/%s/fleet/%s", farmID, fleetID),
+	}.String()
+	d.Set("arn", arn)
+
+	if err := d.Set("configuration", flattenFleetConfiguration(out.Configuration)); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+
+	d.Set("description", out.Description)
+	d.Set("display_name", out.DisplayName)
+	d.Set("farm_id", out.FarmId)
+	d.Set("fleet_id", out.FleetId)
+	d.Set("max_worker_count", out.MaxWorkerCount)
+	d.Set("min_worker_count", out.MinWorkerCount)
+	d.Set("role_arn", out.RoleArn)
+	d.Set("status", out.Status)
+	d.Set("worker_count", out.WorkerCount)
+
+	tags, err := ListTags(ctx, conn, arn)
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameFleet, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameFleet, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, fleetID, err := FleetParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameFleet, d.Id(), err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &deadline.UpdateFleetInput{
+			FarmId:  aws.String(farmID),
+			FleetId: aws.String(fleetID),
+		}
+
+		if d.HasChange("configuration") {
+			in.Configuration = expandFleetConfiguration(d.Get("configuration").([]interface{}))
+		}
+
+		if d.HasChange("description") {
+			in.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			in.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("max_worker_count") {
+			in.MaxWorkerCount = aws.Int64(int64(d.Get("max_worker_count").(int)))
+		}
+
+		if d.HasChange("min_worker_count") {
+			in.MinWorkerCount = aws.Int64(int64(d.Get("min_worker_count").(int)))
+		}
+
+		if d.HasChange("role_arn") {
+			in.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Deadline Cloud Fleet (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateFleetWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameFleet, d.Id(), err)
+		}
+
+		if _, err := waitFleetUpdated(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.Deadline, create.ErrActionWaitingForUpdate, ResNameFleet, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameFleet, d.Id(), err)
+		}
+	}
+
+	return resourceFleetRead(ctx, d, meta)
+}
+
+func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, fleetID, err := FleetParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameFleet, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Deadline Cloud Fleet %s", d.Id())
+
+	_, err = conn.DeleteFleetWithContext(ctx, &deadline.DeleteFleetInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameFleet, d.Id(), err)
+	}
+
+	if _, err := waitFleetDeleted(ctx, conn, farmID, fleetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionWaitingForDeletion, ResNameFleet, d.Id(), err)
+	}
+
+	return nil
+}
+
+const fleetIDSeparator = ","
+
+func FleetCreateResourceID(farmID, fleetID string) string {
+	parts := []string{farmID, fleetID}
+	id := strings.Join(parts, fleetIDSeparator)
+
+	return id
+}
+
+func FleetParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, fleetIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FARM-ID%[2]sFLEET-ID", id, fleetIDSeparator)
+}
+
+func expandFleetConfiguration(tfList []interface{}) *deadline.FleetConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &deadline.FleetConfiguration{}
+
+	if v, ok := tfMap["customer_managed"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomerManaged = expandCustomerManagedFleetConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["service_managed_ec2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ServiceManagedEc2 = expandServiceManagedEc2FleetConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCustomerManagedFleetConfiguration(tfMap map[string]interface{}) *deadline.CustomerManagedFleetConfiguration {
+	apiObject := &deadline.CustomerManagedFleetConfiguration{
+		Mode: aws.String(tfMap["mode"].(string)),
+	}
+
+	if v, ok := tfMap["storage_profile_id"].(string); ok && v != "" {
+		apiObject.StorageProfileId = aws.String(v)
+	}
+
+	if v, ok := tfMap["worker_capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WorkerCapabilities = expandCustomerManagedWorkerCapabilities(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandCustomerManagedWorkerCapabilities(tfMap map[string]interface{}) *deadline.CustomerManagedWorkerCapabilities {
+	apiObject := &deadline.CustomerManagedWorkerCapabilities{
+		CpuArchitectureType: aws.String(tfMap["cpu_architecture_type"].(string)),
+		OsFamily:            aws.String(tfMap["os_family"].(string)),
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["accelerator_count"].([]interface{})); ok {
+		apiObject.AcceleratorCount = &deadline.AcceleratorCountRange{Max: maxValue, Min: minValue}
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["accelerator_total_memory_mib"].([]interface{})); ok {
+		apiObject.AcceleratorTotalMemoryMiB = &deadline.AcceleratorTotalMemoryMiBRange{Max: maxValue, Min: minValue}
+	}
+
+	if v, ok := tfMap["accelerator_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AcceleratorTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["custom_amounts"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomAmounts = expandFleetAmountCapabilities(v.List())
+	}
+
+	if v, ok := tfMap["custom_attributes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomAttributes = expandFleetAttributeCapabilities(v.List())
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["memory_mib"].([]interface{})); ok {
+		apiObject.MemoryMiB = &deadline.MemoryMiBRange{Max: maxValue, Min: minValue}
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["vcpu_count"].([]interface{})); ok {
+		apiObject.VCpuCount = &deadline.VCpuCountRange{Max: maxValue, Min: minValue}
+	}
+
+	return apiObject
+}
+
+func expandServiceManagedEc2FleetConfiguration(tfMap map[string]interface{}) *deadline.ServiceManagedEc2FleetConfiguration {
+	apiObject := &deadline.ServiceManagedEc2FleetConfiguration{}
+
+	if v, ok := tfMap["instance_capabilities"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InstanceCapabilities = expandServiceManagedEc2InstanceCapabilities(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["instance_market_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.InstanceMarketOptions = &deadline.ServiceManagedEc2InstanceMarketOptions{
+			Type: aws.String(v[0].(map[string]interface{})["type"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandServiceManagedEc2InstanceCapabilities(tfMap map[string]interface{}) *deadline.ServiceManagedEc2InstanceCapabilities {
+	apiObject := &deadline.ServiceManagedEc2InstanceCapabilities{
+		CpuArchitectureType: aws.String(tfMap["cpu_architecture_type"].(string)),
+		OsFamily:            aws.String(tfMap["os_family"].(string)),
+	}
+
+	if v, ok := tfMap["allowed_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["custom_amounts"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomAmounts = expandFleetAmountCapabilities(v.List())
+	}
+
+	if v, ok := tfMap["custom_attributes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.CustomAttributes = expandFleetAttributeCapabilities(v.List())
+	}
+
+	if v, ok := tfMap["excluded_instance_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludedInstanceTypes = flex.ExpandStringSet(v)
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["memory_mib"].([]interface{})); ok {
+		apiObject.MemoryMiB = &deadline.MemoryMiBRange{Max: maxValue, Min: minValue}
+	}
+
+	if v, ok := tfMap["root_ebs_volume"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RootEbsVolume = expandEc2EbsVolume(v[0].(map[string]interface{}))
+	}
+
+	if minValue, maxValue, ok := expandRange(tfMap["vcpu_count"].([]interface{})); ok {
+		apiObject.VCpuCount = &deadline.VCpuCountRange{Max: maxValue, Min: minValue}
+	}
+
+	return apiObject
+}
+
+func expandEc2EbsVolume(tfMap map[string]interface{}) *deadline.Ec2EbsVolume {
+	apiObject := &deadline.Ec2EbsVolume{}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["size_gib"].(int); ok && v != 0 {
+		apiObject.SizeGiB = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["throughput_mib"].(int); ok && v != 0 {
+		apiObject.ThroughputMiB = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandRange(tfList []interface{}) (*int64, *int64, bool) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil, false
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	minValue := aws.Int64(int64(tfMap["min"].(int)))
+	var maxValue *int64
+
+	if v, ok := tfMap["max"].(int); ok && v != 0 {
+		maxValue = aws.Int64(int64(v))
+	}
+
+	return minValue, maxValue, true
+}
+
+func expandFleetAmountCapabilities(tfList []interface{}) []*deadline.FleetAmountCapability {
+	var apiObjects []*deadline.FleetAmountCapability
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &deadline.FleetAmountCapability{
+			Min:  aws.Float64(tfMap["min"].(float64)),
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["max"].(float64); ok && v != 0 {
+			apiObject.Max = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandFleetAttributeCapabilities(tfList []interface{}) []*deadline.FleetAttributeCapability {
+	var apiObjects []*deadline.FleetAttributeCapability
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &deadline.FleetAttributeCapability{
+			Name:   aws.String(tfMap["name"].(string)),
+			Values: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenFleetConfiguration(apiObject *deadline.FleetConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManaged; v != nil {
+		tfMap["customer_managed"] = []interface{}{flattenCustomerManagedFleetConfiguration(v)}
+	}
+
+	if v := apiObject.ServiceManagedEc2; v != nil {
+		tfMap["service_managed_ec2"] = []interface{}{flattenServiceManagedEc2FleetConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCustomerManagedFleetConfiguration(apiObject *deadline.CustomerManagedFleetConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"mode":               aws.StringValue(apiObject.Mode),
+		"storage_profile_id": aws.StringValue(apiObject.StorageProfileId),
+	}
+
+	if v := apiObject.WorkerCapabilities; v != nil {
+		m := map[string]interface{}{
+			"accelerator_types":     aws.StringValueSlice(v.AcceleratorTypes),
+			"cpu_architecture_type": aws.StringValue(v.CpuArchitectureType),
+			"custom_amounts":        flattenFleetAmountCapabilities(v.CustomAmounts),
+			"custom_attributes":     flattenFleetAttributeCapabilities(v.CustomAttributes),
+			"os_family":             aws.StringValue(v.OsFamily),
+		}
+
+		if r := v.AcceleratorCount; r != nil {
+			m["accelerator_count"] = flattenRange(r.Min, r.Max)
+		}
+
+		if r := v.AcceleratorTotalMemoryMiB; r != nil {
+			m["accelerator_total_memory_mib"] = flattenRange(r.Min, r.Max)
+		}
+
+		if r := v.MemoryMiB; r != nil {
+			m["memory_mib"] = flattenRange(r.Min, r.Max)
+		}
+
+		if r := v.VCpuCount; r != nil {
+			m["vcpu_count"] = flattenRange(r.Min, r.Max)
+		}
+
+		tfMap["worker_capabilities"] = []interface{}{m}
+	}
+
+	return tfMap
+}
+
+func flattenServiceManagedEc2FleetConfiguration(apiObject *deadline.ServiceManagedEc2FleetConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.InstanceCapabilities; v != nil {
+		m := map[string]interface{}{
+			"allowed_instance_types":  aws.StringValueSlice(v.AllowedInstanceTypes),
+			"cpu_architecture_type":   aws.StringValue(v.CpuArchitectureType),
+			"custom_amounts":          flattenFleetAmountCapabilities(v.CustomAmounts),
+			"custom_attributes":       flattenFleetAttributeCapabilities(v.CustomAttributes),
+			"excluded_instance_types": aws.StringValueSlice(v.ExcludedInstanceTypes),
+			"os_family":               aws.StringValue(v.OsFamily),
+		}
+
+		if r := v.MemoryMiB; r != nil {
+			m["memory_mib"] = flattenRange(r.Min, r.Max)
+		}
+
+		if r := v.RootEbsVolume; r != nil {
+			m["root_ebs_volume"] = []interface{}{map[string]interface{}{
+				"iops":           aws.Int64Value(r.Iops),
+				"size_gib":       aws.Int64Value(r.SizeGiB),
+				"throughput_mib": aws.Int64Value(r.ThroughputMiB),
+			}}
+		}
+
+		if r := v.VCpuCount; r != nil {
+			m["vcpu_count"] = flattenRange(r.Min, r.Max)
+		}
+
+		tfMap["instance_capabilities"] = []interface{}{m}
+	}
+
+	if v := apiObject.InstanceMarketOptions; v != nil {
+		tfMap["instance_market_options"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v.Type),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenRange(minValue, maxValue *int64) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"max": aws.Int64Value(maxValue),
+		"min": aws.Int64Value(minValue),
+	}}
+}
+
+func flattenFleetAmountCapabilities(apiObjects []*deadline.FleetAmountCapability) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"max":  aws.Float64Value(apiObject.Max),
+			"min":  aws.Float64Value(apiObject.Min),
+			"name": aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenFleetAttributeCapabilities(apiObjects []*deadline.FleetAttributeCapability) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"values": aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
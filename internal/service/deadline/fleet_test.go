@@ -0,0 +1,249 @@
+package deadline_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeadlineFleet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet deadline.GetFleetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "deadline", regexp.MustCompile(`farm/farm-.+/fleet/fleet-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.mode", deadline.AutoScalingModeNoScaling),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.cpu_architecture_type", deadline.CpuArchitectureTypeX8664),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.memory_mib.0.min", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.os_family", deadline.CustomerManagedFleetOperatingSystemFamilyLinux),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.customer_managed.0.worker_capabilities.0.vcpu_count.0.min", "2"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.service_managed_ec2.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "farm_id", "aws_deadline_farm.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "fleet_id"),
+					resource.TestCheckResourceAttr(resourceName, "max_worker_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_worker_count", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", deadline.FleetStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineFleet_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet deadline.GetFleetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineFleet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fleet deadline.GetFleetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFleetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "max_worker_count", "1"),
+				),
+			},
+			{
+				Config: testAccFleetConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFleetExists(ctx, resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "max_worker_count", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFleetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_fleet" {
+				continue
+			}
+
+			farmID, fleetID, err := tfdeadline.FleetParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfdeadline.FindFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Deadline, create.ErrActionCheckingDestroyed, tfdeadline.ResNameFleet, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFleetExists(ctx context.Context, name string, fleet *deadline.GetFleetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameFleet, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameFleet, name, errors.New("not set"))
+		}
+
+		farmID, fleetID, err := tfdeadline.FleetParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		output, err := tfdeadline.FindFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+		if err != nil {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameFleet, rs.Primary.ID, err)
+		}
+
+		*fleet = *output
+
+		return nil
+	}
+}
+
+func testAccFleetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "credentials.deadline.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccFleetConfig_basic(rName string, maxWorkerCount int) string {
+	return acctest.ConfigCompose(testAccFleetConfig_base(rName), fmt.Sprintf(`
+resource "aws_deadline_fleet" "test" {
+  farm_id          = aws_deadline_farm.test.id
+  display_name     = %[1]q
+  max_worker_count = %[2]d
+  role_arn         = aws_iam_role.test.arn
+
+  configuration {
+    customer_managed {
+      mode = "NO_SCALING"
+
+      worker_capabilities {
+        cpu_architecture_type = "x86_64"
+        os_family             = "LINUX"
+
+        memory_mib {
+          min = 1024
+        }
+
+        vcpu_count {
+          min = 2
+        }
+      }
+    }
+  }
+}
+`, rName, maxWorkerCount))
+}
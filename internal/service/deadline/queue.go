@@ -0,0 +1,492 @@
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueueCreate,
+		ReadWithoutTimeout:   resourceQueueRead,
+		UpdateWithoutTimeout: resourceQueueUpdate,
+		DeleteWithoutTimeout: resourceQueueDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_storage_profile_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_budget_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      deadline.DefaultQueueBudgetActionNone,
+				ValidateFunc: validation.StringInSlice(deadline.DefaultQueueBudgetAction_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_attachment_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"root_prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 63),
+						},
+						"s3_bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+					},
+				},
+			},
+			"job_run_as_user": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"posix": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"user": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"run_as": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(deadline.RunAs_Values(), false),
+						},
+						"windows": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"user": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"queue_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"required_file_system_location_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameQueue = "Queue"
+)
+
+func resourceQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID := d.Get("farm_id").(string)
+	name := d.Get("display_name").(string)
+	in := &deadline.CreateQueueInput{
+		DefaultBudgetAction: aws.String(d.Get("default_budget_action").(string)),
+		DisplayName:         aws.String(name),
+		FarmId:              aws.String(farmID),
+	}
+
+	if v, ok := d.GetOk("allowed_storage_profile_ids"); ok && v.(*schema.Set).Len() > 0 {
+		in.AllowedStorageProfileIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("job_attachment_settings"); ok {
+		in.JobAttachmentSettings = expandJobAttachmentSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("job_run_as_user"); ok {
+		in.JobRunAsUser = expandJobRunAsUser(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("required_file_system_location_names"); ok && v.(*schema.Set).Len() > 0 {
+		in.RequiredFileSystemLocationNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		in.RoleArn = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateQueueWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionCreating, ResNameQueue, name, err)
+	}
+
+	d.SetId(QueueCreateResourceID(farmID, aws.StringValue(out.QueueId)))
+
+	return resourceQueueRead(ctx, d, meta)
+}
+
+func resourceQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, queueID, err := QueueParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameQueue, d.Id(), err)
+	}
+
+	out, err := FindQueueByTwoPartKey(ctx, conn, farmID, queueID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Queue (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameQueue, d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   deadline.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("farm/%s/queue/%s", farmID, queueID),
+	}.String()
+	d.Set("allowed_storage_profile_ids", aws.StringValueSlice(out.AllowedStorageProfileIds))
+	d.Set("arn", arn)
+	d.Set("default_budget_action", out.DefaultBudgetAction)
+	d.Set("description", out.Description)
+	d.Set("display_name", out.DisplayName)
+	d.Set("farm_id", out.FarmId)
+
+	if err := d.Set("job_attachment_settings", flattenJobAttachmentSettings(out.JobAttachmentSettings)); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameQueue, d.Id(), err)
+	}
+
+	if err := d.Set("job_run_as_user", flattenJobRunAsUser(out.JobRunAsUser)); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameQueue, d.Id(), err)
+	}
+
+	d.Set("queue_id", out.QueueId)
+	d.Set("required_file_system_location_names", aws.StringValueSlice(out.RequiredFileSystemLocationNames))
+	d.Set("role_arn", out.RoleArn)
+	d.Set("status", out.Status)
+
+	tags, err := ListTags(ctx, conn, arn)
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameQueue, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameQueue, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionSetting, ResNameQueue, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceQueueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, queueID, err := QueueParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameQueue, d.Id(), err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &deadline.UpdateQueueInput{
+			FarmId:  aws.String(farmID),
+			QueueId: aws.String(queueID),
+		}
+
+		if d.HasChange("allowed_storage_profile_ids") {
+			o, n := d.GetChange("allowed_storage_profile_ids")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				in.AllowedStorageProfileIdsToAdd = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				in.AllowedStorageProfileIdsToRemove = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange("default_budget_action") {
+			in.DefaultBudgetAction = aws.String(d.Get("default_budget_action").(string))
+		}
+
+		if d.HasChange("description") {
+			in.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("display_name") {
+			in.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("job_attachment_settings") {
+			in.JobAttachmentSettings = expandJobAttachmentSettings(d.Get("job_attachment_settings").([]interface{}))
+		}
+
+		if d.HasChange("job_run_as_user") {
+			in.JobRunAsUser = expandJobRunAsUser(d.Get("job_run_as_user").([]interface{}))
+		}
+
+		if d.HasChange("required_file_system_location_names") {
+			o, n := d.GetChange("required_file_system_location_names")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				in.RequiredFileSystemLocationNamesToAdd = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				in.RequiredFileSystemLocationNamesToRemove = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange("role_arn") {
+			in.RoleArn = aws.String(d.Get("role_arn").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Deadline Cloud Queue (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateQueueWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameQueue, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Deadline, create.ErrActionUpdating, ResNameQueue, d.Id(), err)
+		}
+	}
+
+	return resourceQueueRead(ctx, d, meta)
+}
+
+func resourceQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, queueID, err := QueueParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameQueue, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Deadline Cloud Queue %s", d.Id())
+
+	_, err = conn.DeleteQueueWithContext(ctx, &deadline.DeleteQueueInput{
+		FarmId:  aws.String(farmID),
+		QueueId: aws.String(queueID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameQueue, d.Id(), err)
+	}
+
+	return nil
+}
+
+const queueIDSeparator = ","
+
+func QueueCreateResourceID(farmID, queueID string) string {
+	parts := []string{farmID, queueID}
+	id := strings.Join(parts, queueIDSeparator)
+
+	return id
+}
+
+func QueueParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, queueIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FARM-ID%[2]sQUEUE-ID", id, queueIDSeparator)
+}
+
+func expandJobAttachmentSettings(tfList []interface{}) *deadline.JobAttachmentSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &deadline.JobAttachmentSettings{
+		RootPrefix:   aws.String(tfMap["root_prefix"].(string)),
+		S3BucketName: aws.String(tfMap["s3_bucket_name"].(string)),
+	}
+}
+
+func expandJobRunAsUser(tfList []interface{}) *deadline.JobRunAsUser {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &deadline.JobRunAsUser{
+		RunAs: aws.String(tfMap["run_as"].(string)),
+	}
+
+	if v, ok := tfMap["posix"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.Posix = &deadline.PosixUser{
+			Group: aws.String(m["group"].(string)),
+			User:  aws.String(m["user"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["windows"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.Windows = &deadline.WindowsUser{
+			PasswordArn: aws.String(m["password_arn"].(string)),
+			User:        aws.String(m["user"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenJobAttachmentSettings(apiObject *deadline.JobAttachmentSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"root_prefix":    aws.StringValue(apiObject.RootPrefix),
+			"s3_bucket_name": aws.StringValue(apiObject.S3BucketName),
+		},
+	}
+}
+
+func flattenJobRunAsUser(apiObject *deadline.JobRunAsUser) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"run_as": aws.StringValue(apiObject.RunAs),
+	}
+
+	if v := apiObject.Posix; v != nil {
+		tfMap["posix"] = []interface{}{map[string]interface{}{
+			"group": aws.StringValue(v.Group),
+			"user":  aws.StringValue(v.User),
+		}}
+	}
+
+	if v := apiObject.Windows; v != nil {
+		tfMap["windows"] = []interface{}{map[string]interface{}{
+			"password_arn": aws.StringValue(v.PasswordArn),
+			"user":         aws.StringValue(v.User),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
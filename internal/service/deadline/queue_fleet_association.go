@@ -0,0 +1,183 @@
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceQueueFleetAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueueFleetAssociationCreate,
+		ReadWithoutTimeout:   resourceQueueFleetAssociationRead,
+		DeleteWithoutTimeout: resourceQueueFleetAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"farm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"queue_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameQueueFleetAssociation = "Queue Fleet Association"
+)
+
+func resourceQueueFleetAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID := d.Get("farm_id").(string)
+	queueID := d.Get("queue_id").(string)
+	fleetID := d.Get("fleet_id").(string)
+	id := QueueFleetAssociationCreateResourceID(farmID, queueID, fleetID)
+	in := &deadline.CreateQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+	}
+
+	_, err := conn.CreateQueueFleetAssociationWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionCreating, ResNameQueueFleetAssociation, id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitQueueFleetAssociationActive(ctx, conn, farmID, queueID, fleetID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionWaitingForCreation, ResNameQueueFleetAssociation, d.Id(), err)
+	}
+
+	return resourceQueueFleetAssociationRead(ctx, d, meta)
+}
+
+func resourceQueueFleetAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, queueID, fleetID, err := QueueFleetAssociationParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameQueueFleetAssociation, d.Id(), err)
+	}
+
+	out, err := FindQueueFleetAssociationByThreePartKey(ctx, conn, farmID, queueID, fleetID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Deadline Cloud Queue Fleet Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionReading, ResNameQueueFleetAssociation, d.Id(), err)
+	}
+
+	d.Set("farm_id", farmID)
+	d.Set("fleet_id", out.FleetId)
+	d.Set("queue_id", out.QueueId)
+	d.Set("status", out.Status)
+
+	return nil
+}
+
+func resourceQueueFleetAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DeadlineConn()
+
+	farmID, queueID, fleetID, err := QueueFleetAssociationParseResourceID(d.Id())
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameQueueFleetAssociation, d.Id(), err)
+	}
+
+	// An association must be stopped before it can be deleted.
+	_, err = conn.UpdateQueueFleetAssociationWithContext(ctx, &deadline.UpdateQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+		Status:  aws.String(deadline.UpdateQueueFleetAssociationStatusStopSchedulingAndCancelTasks),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameQueueFleetAssociation, d.Id(), err)
+	}
+
+	if _, err := waitQueueFleetAssociationStopped(ctx, conn, farmID, queueID, fleetID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionWaitingForDeletion, ResNameQueueFleetAssociation, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Deadline Cloud Queue Fleet Association %s", d.Id())
+
+	_, err = conn.DeleteQueueFleetAssociationWithContext(ctx, &deadline.DeleteQueueFleetAssociationInput{
+		FarmId:  aws.String(farmID),
+		FleetId: aws.String(fleetID),
+		QueueId: aws.String(queueID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, deadline.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Deadline, create.ErrActionDeleting, ResNameQueueFleetAssociation, d.Id(), err)
+	}
+
+	return nil
+}
+
+const queueFleetAssociationIDSeparator = ","
+
+func QueueFleetAssociationCreateResourceID(farmID, queueID, fleetID string) string {
+	parts := []string{farmID, queueID, fleetID}
+	id := strings.Join(parts, queueFleetAssociationIDSeparator)
+
+	return id
+}
+
+func QueueFleetAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, queueFleetAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FARM-ID%[2]sQUEUE-ID%[2]sFLEET-ID", id, queueFleetAssociationIDSeparator)
+}
@@ -0,0 +1,160 @@
+package deadline_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeadlineQueueFleetAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association deadline.GetQueueFleetAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue_fleet_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueFleetAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueFleetAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrPair(resourceName, "farm_id", "aws_deadline_farm.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", "aws_deadline_fleet.test", "fleet_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_id", "aws_deadline_queue.test", "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", deadline.QueueFleetAssociationStatusActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineQueueFleetAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var association deadline.GetQueueFleetAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue_fleet_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueFleetAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueFleetAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueFleetAssociationExists(ctx, resourceName, &association),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceQueueFleetAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckQueueFleetAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_queue_fleet_association" {
+				continue
+			}
+
+			farmID, queueID, fleetID, err := tfdeadline.QueueFleetAssociationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfdeadline.FindQueueFleetAssociationByThreePartKey(ctx, conn, farmID, queueID, fleetID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Deadline, create.ErrActionCheckingDestroyed, tfdeadline.ResNameQueueFleetAssociation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQueueFleetAssociationExists(ctx context.Context, name string, association *deadline.GetQueueFleetAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameQueueFleetAssociation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameQueueFleetAssociation, name, errors.New("not set"))
+		}
+
+		farmID, queueID, fleetID, err := tfdeadline.QueueFleetAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		output, err := tfdeadline.FindQueueFleetAssociationByThreePartKey(ctx, conn, farmID, queueID, fleetID)
+
+		if err != nil {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameQueueFleetAssociation, rs.Primary.ID, err)
+		}
+
+		*association = *output
+
+		return nil
+	}
+}
+
+func testAccQueueFleetAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_basic(rName, 1), `
+resource "aws_deadline_queue" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = aws_deadline_farm.test.display_name
+}
+
+resource "aws_deadline_queue_fleet_association" "test" {
+  farm_id  = aws_deadline_farm.test.id
+  fleet_id = aws_deadline_fleet.test.fleet_id
+  queue_id = aws_deadline_queue.test.queue_id
+}
+`)
+}
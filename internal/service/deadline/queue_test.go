@@ -0,0 +1,249 @@
+package deadline_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/deadline"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfdeadline "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeadlineQueue_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queue deadline.GetQueueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queue),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "deadline", regexp.MustCompile(`farm/farm-.+/queue/queue-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_budget_action", deadline.DefaultQueueBudgetActionNone),
+					resource.TestCheckResourceAttr(resourceName, "display_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "farm_id", "aws_deadline_farm.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "job_attachment_settings.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "queue_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineQueue_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queue deadline.GetQueueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queue),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdeadline.ResourceQueue(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDeadlineQueue_jobAttachmentSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	var queue deadline.GetQueueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_deadline_queue.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Deadline, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, deadline.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_jobAttachmentSettings(rName, deadline.DefaultQueueBudgetActionStopSchedulingAndCompleteTasks),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "default_budget_action", deadline.DefaultQueueBudgetActionStopSchedulingAndCompleteTasks),
+					resource.TestCheckResourceAttr(resourceName, "job_attachment_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_attachment_settings.0.root_prefix", "jobs"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_attachment_settings.0.s3_bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueConfig_jobAttachmentSettings(rName, deadline.DefaultQueueBudgetActionStopSchedulingAndCancelTasks),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(ctx, resourceName, &queue),
+					resource.TestCheckResourceAttr(resourceName, "default_budget_action", deadline.DefaultQueueBudgetActionStopSchedulingAndCancelTasks),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQueueDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_deadline_queue" {
+				continue
+			}
+
+			farmID, queueID, err := tfdeadline.QueueParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfdeadline.FindQueueByTwoPartKey(ctx, conn, farmID, queueID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Deadline, create.ErrActionCheckingDestroyed, tfdeadline.ResNameQueue, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckQueueExists(ctx context.Context, name string, queue *deadline.GetQueueOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameQueue, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameQueue, name, errors.New("not set"))
+		}
+
+		farmID, queueID, err := tfdeadline.QueueParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeadlineConn()
+
+		output, err := tfdeadline.FindQueueByTwoPartKey(ctx, conn, farmID, queueID)
+
+		if err != nil {
+			return create.Error(names.Deadline, create.ErrActionCheckingExistence, tfdeadline.ResNameQueue, rs.Primary.ID, err)
+		}
+
+		*queue = *output
+
+		return nil
+	}
+}
+
+func testAccQueueConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+}
+
+resource "aws_deadline_queue" "test" {
+  farm_id      = aws_deadline_farm.test.id
+  display_name = %[1]q
+}
+`, rName)
+}
+
+func testAccQueueConfig_jobAttachmentSettings(rName, defaultBudgetAction string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_deadline_farm" "test" {
+  display_name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "deadline.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_deadline_queue" "test" {
+  farm_id               = aws_deadline_farm.test.id
+  display_name          = %[1]q
+  default_budget_action = %[2]q
+  role_arn              = aws_iam_role.test.arn
+
+  job_attachment_settings {
+    root_prefix    = "jobs"
+    s3_bucket_name = aws_s3_bucket.test.bucket
+  }
+}
+`, rName, defaultBudgetAction)
+}
@@ -0,0 +1,42 @@
+package deadline
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusFleet(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindFleetByTwoPartKey(ctx, conn, farmID, fleetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
+
+func statusQueueFleetAssociation(ctx context.Context, conn *deadline.Deadline, farmID, queueID, fleetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindQueueFleetAssociationByThreePartKey(ctx, conn, farmID, queueID, fleetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
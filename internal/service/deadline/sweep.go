@@ -0,0 +1,284 @@
+//go:build sweep
+// +build sweep
+
+package deadline
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_deadline_farm", &resource.Sweeper{
+		Name: "aws_deadline_farm",
+		F:    sweepFarms,
+		Dependencies: []string{
+			"aws_deadline_fleet",
+			"aws_deadline_queue",
+		},
+	})
+
+	resource.AddTestSweepers("aws_deadline_fleet", &resource.Sweeper{
+		Name: "aws_deadline_fleet",
+		F:    sweepFleets,
+		Dependencies: []string{
+			"aws_deadline_queue_fleet_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_deadline_queue", &resource.Sweeper{
+		Name: "aws_deadline_queue",
+		F:    sweepQueues,
+		Dependencies: []string{
+			"aws_deadline_queue_fleet_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_deadline_queue_fleet_association", &resource.Sweeper{
+		Name: "aws_deadline_queue_fleet_association",
+		F:    sweepQueueFleetAssociations,
+	})
+}
+
+func sweepFarms(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).DeadlineConn()
+	input := &deadline.ListFarmsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFarmsPagesWithContext(ctx, input, func(page *deadline.ListFarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Farms {
+			r := ResourceFarm()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.FarmId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Deadline Cloud Farm sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Deadline Cloud Farms (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Deadline Cloud Farms (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepFleets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).DeadlineConn()
+	input := &deadline.ListFarmsInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFarmsPagesWithContext(ctx, input, func(page *deadline.ListFarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Farms {
+			farmID := aws.StringValue(v.FarmId)
+			input := &deadline.ListFleetsInput{
+				FarmId: aws.String(farmID),
+			}
+
+			err := conn.ListFleetsPagesWithContext(ctx, input, func(page *deadline.ListFleetsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Fleets {
+					r := ResourceFleet()
+					d := r.Data(nil)
+					d.SetId(FleetCreateResourceID(farmID, aws.StringValue(v.FleetId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Deadline Cloud Fleets (%s): %w", farmID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Deadline Cloud Fleet sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Deadline Cloud Farms (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Deadline Cloud Fleets (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func sweepQueues(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).DeadlineConn()
+	input := &deadline.ListFarmsInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFarmsPagesWithContext(ctx, input, func(page *deadline.ListFarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Farms {
+			farmID := aws.StringValue(v.FarmId)
+			input := &deadline.ListQueuesInput{
+				FarmId: aws.String(farmID),
+			}
+
+			err := conn.ListQueuesPagesWithContext(ctx, input, func(page *deadline.ListQueuesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Queues {
+					r := ResourceQueue()
+					d := r.Data(nil)
+					d.SetId(QueueCreateResourceID(farmID, aws.StringValue(v.QueueId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Deadline Cloud Queues (%s): %w", farmID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Deadline Cloud Queue sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Deadline Cloud Farms (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Deadline Cloud Queues (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func sweepQueueFleetAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).DeadlineConn()
+	input := &deadline.ListFarmsInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFarmsPagesWithContext(ctx, input, func(page *deadline.ListFarmsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Farms {
+			farmID := aws.StringValue(v.FarmId)
+			input := &deadline.ListQueueFleetAssociationsInput{
+				FarmId: aws.String(farmID),
+			}
+
+			err := conn.ListQueueFleetAssociationsPagesWithContext(ctx, input, func(page *deadline.ListQueueFleetAssociationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.QueueFleetAssociations {
+					r := ResourceQueueFleetAssociation()
+					d := r.Data(nil)
+					d.SetId(QueueFleetAssociationCreateResourceID(farmID, aws.StringValue(v.QueueId), aws.StringValue(v.FleetId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Deadline Cloud Queue Fleet Associations (%s): %w", farmID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Deadline Cloud Queue Fleet Association sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Deadline Cloud Farms (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Deadline Cloud Queue Fleet Associations (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
@@ -0,0 +1,98 @@
+package deadline
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/deadline"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitFleetCreated(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deadline.FleetStatusCreateInProgress},
+		Target:  []string{deadline.FleetStatusActive},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitFleetUpdated(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deadline.FleetStatusUpdateInProgress},
+		Target:  []string{deadline.FleetStatusActive},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeleted(ctx context.Context, conn *deadline.Deadline, farmID, fleetID string, timeout time.Duration) (*deadline.GetFleetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: deadline.FleetStatus_Values(),
+		Target:  []string{},
+		Refresh: statusFleet(ctx, conn, farmID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*deadline.GetFleetOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitQueueFleetAssociationActive(ctx context.Context, conn *deadline.Deadline, farmID, queueID, fleetID string, timeout time.Duration) (*deadline.GetQueueFleetAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{deadline.QueueFleetAssociationStatusActive},
+		Refresh: statusQueueFleetAssociation(ctx, conn, farmID, queueID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*deadline.GetQueueFleetAssociationOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitQueueFleetAssociationStopped(ctx context.Context, conn *deadline.Deadline, farmID, queueID, fleetID string, timeout time.Duration) (*deadline.GetQueueFleetAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			deadline.QueueFleetAssociationStatusActive,
+			deadline.QueueFleetAssociationStatusStopSchedulingAndCancelTasks,
+			deadline.QueueFleetAssociationStatusStopSchedulingAndCompleteTasks,
+		},
+		Target:  []string{deadline.QueueFleetAssociationStatusStopped},
+		Refresh: statusQueueFleetAssociation(ctx, conn, farmID, queueID, fleetID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*deadline.GetQueueFleetAssociationOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
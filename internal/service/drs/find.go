@@ -0,0 +1,53 @@
+package drs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/drs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindReplicationConfigurationTemplateByID(ctx context.Context, conn *drs.Drs, id string) (*drs.ReplicationConfigurationTemplate, error) {
+	in := &drs.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+	var out []*drs.ReplicationConfigurationTemplate
+
+	err := conn.DescribeReplicationConfigurationTemplatesPagesWithContext(ctx, in, func(page *drs.DescribeReplicationConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				out = append(out, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, drs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if count := len(out); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+
+	return out[0], nil
+}
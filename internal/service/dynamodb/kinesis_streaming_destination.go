@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -19,6 +20,7 @@ func ResourceKinesisStreamingDestination() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceKinesisStreamingDestinationCreate,
 		ReadWithoutTimeout:   resourceKinesisStreamingDestinationRead,
+		UpdateWithoutTimeout: resourceKinesisStreamingDestinationUpdate,
 		DeleteWithoutTimeout: resourceKinesisStreamingDestinationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -26,6 +28,12 @@ func ResourceKinesisStreamingDestination() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"approximate_creation_date_time_precision": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dynamodb.ApproximateCreationDateTimePrecision_Values(), false),
+			},
 			"stream_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -52,6 +60,12 @@ func resourceKinesisStreamingDestinationCreate(ctx context.Context, d *schema.Re
 		TableName: aws.String(tableName),
 	}
 
+	if v, ok := d.GetOk("approximate_creation_date_time_precision"); ok {
+		input.EnableKinesisStreamingConfiguration = &dynamodb.EnableKinesisStreamingConfiguration{
+			ApproximateCreationDateTimePrecision: aws.String(v.(string)),
+		}
+	}
+
 	output, err := conn.EnableKinesisStreamingDestinationWithContext(ctx, input)
 
 	if err != nil {
@@ -101,12 +115,45 @@ func resourceKinesisStreamingDestinationRead(ctx context.Context, d *schema.Reso
 		return nil
 	}
 
+	d.Set("approximate_creation_date_time_precision", output.ApproximateCreationDateTimePrecision)
 	d.Set("stream_arn", output.StreamArn)
 	d.Set("table_name", tableName)
 
 	return nil
 }
 
+func resourceKinesisStreamingDestinationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
+
+	tableName, streamArn, err := KinesisStreamingDestinationParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("approximate_creation_date_time_precision") {
+		input := &dynamodb.UpdateKinesisStreamingDestinationInput{
+			StreamArn: aws.String(streamArn),
+			TableName: aws.String(tableName),
+			UpdateKinesisStreamingConfiguration: &dynamodb.UpdateKinesisStreamingConfiguration{
+				ApproximateCreationDateTimePrecision: aws.String(d.Get("approximate_creation_date_time_precision").(string)),
+			},
+		}
+
+		_, err := conn.UpdateKinesisStreamingDestinationWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating DynamoDB Kinesis streaming destination (stream: %s, table: %s): %w", streamArn, tableName, err))
+		}
+
+		if err := waitKinesisStreamingDestinationActive(ctx, conn, streamArn, tableName); err != nil {
+			return diag.FromErr(fmt.Errorf("error waiting for DynamoDB Kinesis streaming destination (stream: %s, table: %s) update: %w", streamArn, tableName, err))
+		}
+	}
+
+	return resourceKinesisStreamingDestinationRead(ctx, d, meta)
+}
+
 func resourceKinesisStreamingDestinationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
@@ -33,7 +33,7 @@ func maxDuration(a, b time.Duration) time.Duration {
 
 func waitKinesisStreamingDestinationActive(ctx context.Context, conn *dynamodb.DynamoDB, streamArn, tableName string) error {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{dynamodb.DestinationStatusDisabled, dynamodb.DestinationStatusEnabling},
+		Pending: []string{dynamodb.DestinationStatusDisabled, dynamodb.DestinationStatusEnabling, dynamodb.DestinationStatusUpdating},
 		Target:  []string{dynamodb.DestinationStatusActive},
 		Timeout: kinesisStreamingDestinationActiveTimeout,
 		Refresh: statusKinesisStreamingDestination(ctx, conn, streamArn, tableName),
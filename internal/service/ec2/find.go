@@ -1278,6 +1278,83 @@ func FindLocalGatewayRouteTable(ctx context.Context, conn *ec2.EC2, input *ec2.D
 	return output[0], nil
 }
 
+func FindLocalGatewayRouteTableVPCAssociations(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTableVpcAssociationsInput) ([]*ec2.LocalGatewayRouteTableVpcAssociation, error) {
+	var output []*ec2.LocalGatewayRouteTableVpcAssociation
+
+	err := conn.DescribeLocalGatewayRouteTableVpcAssociationsPagesWithContext(ctx, input, func(page *ec2.DescribeLocalGatewayRouteTableVpcAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LocalGatewayRouteTableVpcAssociations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableVPCAssociationIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindLocalGatewayRouteTableVPCAssociation(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayRouteTableVpcAssociationsInput) (*ec2.LocalGatewayRouteTableVpcAssociation, error) {
+	output, err := FindLocalGatewayRouteTableVPCAssociations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindLocalGatewayRouteTableVPCAssociationByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.LocalGatewayRouteTableVpcAssociation, error) {
+	input := &ec2.DescribeLocalGatewayRouteTableVpcAssociationsInput{
+		LocalGatewayRouteTableVpcAssociationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindLocalGatewayRouteTableVPCAssociation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.RouteTableAssociationStateCodeDisassociated {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.LocalGatewayRouteTableVpcAssociationId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindLocalGatewayVirtualInterfaceGroups(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput) ([]*ec2.LocalGatewayVirtualInterfaceGroup, error) {
 	var output []*ec2.LocalGatewayVirtualInterfaceGroup
 
@@ -4735,6 +4812,83 @@ func FindTransitGatewayPolicyTableByID(ctx context.Context, conn *ec2.EC2, id st
 	return output, nil
 }
 
+func FindTransitGatewayRouteTableAnnouncement(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayRouteTableAnnouncementsInput) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	output, err := FindTransitGatewayRouteTableAnnouncements(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindTransitGatewayRouteTableAnnouncements(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayRouteTableAnnouncementsInput) ([]*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	var output []*ec2.TransitGatewayRouteTableAnnouncement
+
+	err := conn.DescribeTransitGatewayRouteTableAnnouncementsPagesWithContext(ctx, input, func(page *ec2.DescribeTransitGatewayRouteTableAnnouncementsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayRouteTableAnnouncements {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayRouteTableAnnouncementIdNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindTransitGatewayRouteTableAnnouncementByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	input := &ec2.DescribeTransitGatewayRouteTableAnnouncementsInput{
+		TransitGatewayRouteTableAnnouncementIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindTransitGatewayRouteTableAnnouncement(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := aws.StringValue(output.State); state == ec2.TransitGatewayRouteTableAnnouncementStateDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.TransitGatewayRouteTableAnnouncementId) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindTransitGatewayRouteTableByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTable, error) {
 	input := &ec2.DescribeTransitGatewayRouteTablesInput{
 		TransitGatewayRouteTableIds: aws.StringSlice([]string{id}),
@@ -143,10 +143,11 @@ func ResourceIPAMPoolCreate(ctx context.Context, d *schema.ResourceData, meta in
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	addressFamily := d.Get("address_family").(string)
+	scopeID := d.Get("ipam_scope_id").(string)
 	input := &ec2.CreateIpamPoolInput{
 		AddressFamily:     aws.String(addressFamily),
 		ClientToken:       aws.String(resource.UniqueId()),
-		IpamScopeId:       aws.String(d.Get("ipam_scope_id").(string)),
+		IpamScopeId:       aws.String(scopeID),
 		TagSpecifications: tagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeIpamPool),
 	}
 
@@ -198,6 +199,19 @@ func ResourceIPAMPoolCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.SourceIpamPoolId = aws.String(v.(string))
 	}
 
+	// aws_service and public_ip_source are only valid for pools in a public scope.
+	if input.AwsService != nil || input.PublicIpSource != nil {
+		scope, err := FindIPAMScopeByID(ctx, conn, scopeID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IPAM Scope (%s): %s", scopeID, err)
+		}
+
+		if scopeType := aws.StringValue(scope.IpamScopeType); scopeType != ec2.IpamScopeTypePublic {
+			return sdkdiag.AppendErrorf(diags, "creating IPAM Pool: aws_service and public_ip_source can only be set in a public scope, IPAM Scope (%s) is %s", scopeID, scopeType)
+		}
+	}
+
 	output, err := conn.CreateIpamPoolWithContext(ctx, input)
 
 	if err != nil {
@@ -3,6 +3,7 @@ package ec2_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -155,6 +156,23 @@ func TestAccIPAMPool_ipv6Contiguous(t *testing.T) {
 	})
 }
 
+func TestAccIPAMPool_publicIPSourcePrivateScope(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIPAMPoolConfig_publicIPSourcePrivateScope,
+				ExpectError: regexp.MustCompile(`can only be set in a public scope`),
+			},
+		},
+	})
+}
+
 func TestAccIPAMPool_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pool ec2.IpamPool
@@ -303,6 +321,14 @@ resource "aws_vpc_ipam_pool" "test" {
 }
 `)
 
+var testAccIPAMPoolConfig_publicIPSourcePrivateScope = acctest.ConfigCompose(testAccIPAMPoolConfig_base, `
+resource "aws_vpc_ipam_pool" "test" {
+  address_family   = "ipv4"
+  ipam_scope_id    = aws_vpc_ipam.test.private_default_scope_id
+  public_ip_source = "amazon"
+}
+`)
+
 func testAccIPAMPoolConfig_tags(tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccIPAMPoolConfig_base, fmt.Sprintf(`
 resource "aws_vpc_ipam_pool" "test" {
@@ -2,7 +2,6 @@ package ec2
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -82,20 +82,20 @@ func resourceLocalGatewayRouteTableVPCAssociationRead(ctx context.Context, d *sc
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	association, err := GetLocalGatewayRouteTableVPCAssociation(ctx, conn, d.Id())
+	association, err := FindLocalGatewayRouteTableVPCAssociationByID(ctx, conn, d.Id())
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Local Gateway Route Table VPC Association (%s): %s", d.Id(), err)
-	}
-
-	if association == nil {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 Local Gateway Route Table VPC Association (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
 	}
 
-	if aws.StringValue(association.State) != ec2.RouteTableAssociationStateCodeAssociated {
-		log.Printf("[WARN] EC2 Local Gateway Route Table VPC Association (%s) status (%s), removing from state", d.Id(), aws.StringValue(association.State))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Local Gateway Route Table VPC Association (%s): %s", d.Id(), err)
+	}
+
+	if state := aws.StringValue(association.State); state != ec2.RouteTableAssociationStateCodeAssociated {
+		log.Printf("[WARN] EC2 Local Gateway Route Table VPC Association (%s) status (%s), removing from state", d.Id(), state)
 		d.SetId("")
 		return diags
 	}
@@ -144,7 +144,7 @@ func resourceLocalGatewayRouteTableVPCAssociationDelete(ctx context.Context, d *
 
 	_, err := conn.DeleteLocalGatewayRouteTableVpcAssociationWithContext(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, "InvalidLocalGatewayRouteTableVpcAssociationID.NotFound") {
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidLocalGatewayRouteTableVPCAssociationIDNotFound) {
 		return diags
 	}
 
@@ -158,34 +158,3 @@ func resourceLocalGatewayRouteTableVPCAssociationDelete(ctx context.Context, d *
 
 	return diags
 }
-
-func GetLocalGatewayRouteTableVPCAssociation(ctx context.Context, conn *ec2.EC2, localGatewayRouteTableVpcAssociationID string) (*ec2.LocalGatewayRouteTableVpcAssociation, error) {
-	input := &ec2.DescribeLocalGatewayRouteTableVpcAssociationsInput{
-		LocalGatewayRouteTableVpcAssociationIds: aws.StringSlice([]string{localGatewayRouteTableVpcAssociationID}),
-	}
-
-	output, err := conn.DescribeLocalGatewayRouteTableVpcAssociationsWithContext(ctx, input)
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil {
-		return nil, fmt.Errorf("empty response")
-	}
-
-	var association *ec2.LocalGatewayRouteTableVpcAssociation
-
-	for _, outputAssociation := range output.LocalGatewayRouteTableVpcAssociations {
-		if outputAssociation == nil {
-			continue
-		}
-
-		if aws.StringValue(outputAssociation.LocalGatewayRouteTableVpcAssociationId) == localGatewayRouteTableVpcAssociationID {
-			association = outputAssociation
-			break
-		}
-	}
-
-	return association, nil
-}
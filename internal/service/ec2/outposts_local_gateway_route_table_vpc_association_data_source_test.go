@@ -0,0 +1,46 @@
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2OutpostsLocalGatewayRouteTableVPCAssociationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_local_gateway_route_table_vpc_association.test"
+	resourceName := "aws_ec2_local_gateway_route_table_vpc_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutpostsLocalGatewayRouteTableVPCAssociationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "local_gateway_id", resourceName, "local_gateway_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "local_gateway_route_table_id", resourceName, "local_gateway_route_table_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "associated"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", resourceName, "vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOutpostsLocalGatewayRouteTableVPCAssociationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccOutpostsLocalGatewayRouteTableVPCAssociationConfig_tags1(rName, "Name", rName),
+		`
+data "aws_ec2_local_gateway_route_table_vpc_association" "test" {
+  local_gateway_route_table_id = aws_ec2_local_gateway_route_table_vpc_association.test.local_gateway_route_table_id
+  vpc_id                       = aws_ec2_local_gateway_route_table_vpc_association.test.vpc_id
+}
+`)
+}
@@ -58,37 +58,19 @@ func StatusCarrierGatewayState(ctx context.Context, conn *ec2.EC2, id string) re
 	}
 }
 
-// StatusLocalGatewayRouteTableVPCAssociationState fetches the LocalGatewayRouteTableVpcAssociation and its State
-func StatusLocalGatewayRouteTableVPCAssociationState(ctx context.Context, conn *ec2.EC2, localGatewayRouteTableVpcAssociationID string) resource.StateRefreshFunc {
+func StatusLocalGatewayRouteTableVPCAssociationState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		input := &ec2.DescribeLocalGatewayRouteTableVpcAssociationsInput{
-			LocalGatewayRouteTableVpcAssociationIds: aws.StringSlice([]string{localGatewayRouteTableVpcAssociationID}),
-		}
+		output, err := FindLocalGatewayRouteTableVPCAssociationByID(ctx, conn, id)
 
-		output, err := conn.DescribeLocalGatewayRouteTableVpcAssociationsWithContext(ctx, input)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
 
 		if err != nil {
 			return nil, "", err
 		}
 
-		var association *ec2.LocalGatewayRouteTableVpcAssociation
-
-		for _, outputAssociation := range output.LocalGatewayRouteTableVpcAssociations {
-			if outputAssociation == nil {
-				continue
-			}
-
-			if aws.StringValue(outputAssociation.LocalGatewayRouteTableVpcAssociationId) == localGatewayRouteTableVpcAssociationID {
-				association = outputAssociation
-				break
-			}
-		}
-
-		if association == nil {
-			return association, ec2.RouteTableAssociationStateCodeDisassociated, nil
-		}
-
-		return association, aws.StringValue(association.State), nil
+		return output, aws.StringValue(output.State), nil
 	}
 }
 
@@ -792,6 +774,22 @@ func StatusTransitGatewayPolicyTableAssociationState(ctx context.Context, conn *
 	}
 }
 
+func StatusTransitGatewayRouteTableAnnouncementState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTransitGatewayRouteTableAnnouncementByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusTransitGatewayRouteTableAssociationState(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, transitGatewayAttachmentID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindTransitGatewayRouteTableAssociationByTwoPartKey(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
@@ -116,6 +116,11 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"disappearsTransitGateway": testAccTransitGatewayRouteTable_disappears_TransitGateway,
 			"Tags":                     testAccTransitGatewayRouteTable_Tags,
 		},
+		"RouteTableAnnouncement": {
+			"basic":      testAccTransitGatewayRouteTableAnnouncement_basic,
+			"disappears": testAccTransitGatewayRouteTableAnnouncement_disappears,
+			"Tags":       testAccTransitGatewayRouteTableAnnouncement_Tags,
+		},
 		"RouteTableAssociation": {
 			"basic":      testAccTransitGatewayRouteTableAssociation_basic,
 			"disappears": testAccTransitGatewayRouteTableAssociation_disappears,
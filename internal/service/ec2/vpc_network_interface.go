@@ -62,6 +62,34 @@ func ResourceNetworkInterface() *schema.Resource {
 					},
 				},
 			},
+			"connection_tracking_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tcp_established_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 432000),
+						},
+						"udp_stream_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 180),
+						},
+						"udp_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(30, 60),
+						},
+					},
+				},
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -339,6 +367,10 @@ func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData,
 		SubnetId:    aws.String(d.Get("subnet_id").(string)),
 	}
 
+	if v, ok := d.GetOk("connection_tracking_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -519,6 +551,13 @@ func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, m
 	} else {
 		d.Set("attachment", nil)
 	}
+	if eni.ConnectionTrackingConfiguration != nil {
+		if err := d.Set("connection_tracking_specification", []interface{}{flattenConnectionTrackingConfiguration(eni.ConnectionTrackingConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting connection_tracking_specification: %s", err)
+		}
+	} else {
+		d.Set("connection_tracking_specification", nil)
+	}
 	d.Set("description", eni.Description)
 	d.Set("interface_type", eni.InterfaceType)
 	if err := d.Set("ipv4_prefixes", flattenIPv4PrefixSpecifications(eni.Ipv4Prefixes)); err != nil {
@@ -1001,6 +1040,23 @@ func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("connection_tracking_specification") {
+		input := &ec2.ModifyNetworkInterfaceAttributeInput{
+			ConnectionTrackingSpecification: &ec2.ConnectionTrackingSpecificationRequest{},
+			NetworkInterfaceId:              aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("connection_tracking_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ConnectionTrackingSpecification = expandConnectionTrackingSpecificationRequest(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.ModifyNetworkInterfaceAttributeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying EC2 Network Interface (%s) ConnectionTrackingSpecification: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("source_dest_check") {
 		input := &ec2.ModifyNetworkInterfaceAttributeInput{
 			NetworkInterfaceId: aws.String(d.Id()),
@@ -1198,6 +1254,50 @@ func flattenNetworkInterfaceAttachment(apiObject *ec2.NetworkInterfaceAttachment
 	return tfMap
 }
 
+func expandConnectionTrackingSpecificationRequest(tfMap map[string]interface{}) *ec2.ConnectionTrackingSpecificationRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ec2.ConnectionTrackingSpecificationRequest{}
+
+	if v, ok := tfMap["tcp_established_timeout"].(int); ok && v != 0 {
+		apiObject.TcpEstablishedTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["udp_stream_timeout"].(int); ok && v != 0 {
+		apiObject.UdpStreamTimeout = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["udp_timeout"].(int); ok && v != 0 {
+		apiObject.UdpTimeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenConnectionTrackingConfiguration(apiObject *ec2.ConnectionTrackingConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TcpEstablishedTimeout; v != nil {
+		tfMap["tcp_established_timeout"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.UdpStreamTimeout; v != nil {
+		tfMap["udp_stream_timeout"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.UdpTimeout; v != nil {
+		tfMap["udp_timeout"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
 func expandPrivateIPAddressSpecification(tfString string) *ec2.PrivateIpAddressSpecification {
 	if tfString == "" {
 		return nil
@@ -437,6 +437,48 @@ func TestAccVPCNetworkInterface_sourceDestCheck(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInterface_connectionTrackingSpecification(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf ec2.NetworkInterface
+	resourceName := "aws_network_interface.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckENIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInterfaceConfig_connectionTrackingSpecification(rName, 3600, 120, 45),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "45"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_ip_list_enabled", "ipv6_address_list_enabled"},
+			},
+			{
+				Config: testAccVPCNetworkInterfaceConfig_connectionTrackingSpecification(rName, 7200, 60, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckENIExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.tcp_established_timeout", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_stream_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "connection_tracking_specification.0.udp_timeout", "30"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCNetworkInterface_privateIPsCount(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf ec2.NetworkInterface
@@ -1287,6 +1329,25 @@ resource "aws_network_interface" "test" {
 `, rName, sourceDestCheck))
 }
 
+func testAccVPCNetworkInterfaceConfig_connectionTrackingSpecification(rName string, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout int) string {
+	return acctest.ConfigCompose(testAccENIIPV4BaseConfig(rName), fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  subnet_id       = aws_subnet.test.id
+  security_groups = [aws_security_group.test.id]
+
+  connection_tracking_specification {
+    tcp_established_timeout = %[2]d
+    udp_stream_timeout      = %[3]d
+    udp_timeout             = %[4]d
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, tcpEstablishedTimeout, udpStreamTimeout, udpTimeout))
+}
+
 func testAccVPCNetworkInterfaceConfig_attachment(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -178,10 +178,10 @@ func WaitLocalGatewayRouteTableVPCAssociationAssociated(ctx context.Context, con
 // WaitLocalGatewayRouteTableVPCAssociationDisassociated waits for a LocalGatewayRouteTableVpcAssociation to return Disassociated
 func WaitLocalGatewayRouteTableVPCAssociationDisassociated(ctx context.Context, conn *ec2.EC2, localGatewayRouteTableVpcAssociationID string) (*ec2.LocalGatewayRouteTableVpcAssociation, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{ec2.RouteTableAssociationStateCodeDisassociating},
-		Target:  []string{ec2.RouteTableAssociationStateCodeDisassociated},
+		Pending: []string{ec2.RouteTableAssociationStateCodeAssociated, ec2.RouteTableAssociationStateCodeDisassociating},
+		Target:  []string{},
 		Refresh: StatusLocalGatewayRouteTableVPCAssociationState(ctx, conn, localGatewayRouteTableVpcAssociationID),
-		Timeout: LocalGatewayRouteTableVPCAssociationAssociatedTimeout,
+		Timeout: LocalGatewayRouteTableVPCAssociationDisassociatedTimeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -1375,10 +1375,12 @@ func WaitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.EC2, transitG
 }
 
 const (
-	TransitGatewayRouteTableCreatedTimeout  = 10 * time.Minute
-	TransitGatewayRouteTableDeletedTimeout  = 10 * time.Minute
-	TransitGatewayPolicyTableCreatedTimeout = 10 * time.Minute
-	TransitGatewayPolicyTableDeletedTimeout = 10 * time.Minute
+	TransitGatewayRouteTableCreatedTimeout             = 10 * time.Minute
+	TransitGatewayRouteTableDeletedTimeout             = 10 * time.Minute
+	TransitGatewayPolicyTableCreatedTimeout            = 10 * time.Minute
+	TransitGatewayPolicyTableDeletedTimeout            = 10 * time.Minute
+	TransitGatewayRouteTableAnnouncementCreatedTimeout = 10 * time.Minute
+	TransitGatewayRouteTableAnnouncementDeletedTimeout = 10 * time.Minute
 )
 
 func WaitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPolicyTable, error) {
@@ -1415,6 +1417,23 @@ func WaitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.EC2, id
 	return nil, err
 }
 
+func WaitTransitGatewayRouteTableAnnouncementCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteTableAnnouncementStatePending},
+		Target:  []string{ec2.TransitGatewayRouteTableAnnouncementStateAvailable},
+		Timeout: TransitGatewayRouteTableAnnouncementCreatedTimeout,
+		Refresh: StatusTransitGatewayRouteTableAnnouncementState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAnnouncement); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPolicyTable, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.TransitGatewayPolicyTableStateAvailable, ec2.TransitGatewayPolicyTableStateDeleting},
@@ -1432,6 +1451,23 @@ func WaitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.EC2, id
 	return nil, err
 }
 
+func WaitTransitGatewayRouteTableAnnouncementDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTableAnnouncement, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteTableAnnouncementStateAvailable, ec2.TransitGatewayRouteTableAnnouncementStateDeleting},
+		Target:  []string{},
+		Timeout: TransitGatewayRouteTableAnnouncementDeletedTimeout,
+		Refresh: StatusTransitGatewayRouteTableAnnouncementState(ctx, conn, id),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayRouteTableAnnouncement); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTable, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.TransitGatewayRouteTableStateAvailable, ec2.TransitGatewayRouteTableStateDeleting},
@@ -168,7 +168,6 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 			"primary_replication_group_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validateReplicationGroupID,
 			},
 			"transit_encryption_enabled": {
@@ -187,6 +186,7 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 			customizeDiffGlobalReplicationGroupEngineVersionErrorOnDowngrade,
 			customizeDiffGlobalReplicationGroupParamGroupNameRequiresMajorVersionUpgrade,
 			customdiff.ComputedIf("global_node_groups", diffHasChange("num_node_groups")),
+			customdiff.ForceNewIf("primary_replication_group_id", primaryReplicationGroupIDIsNotMember),
 		),
 	}
 }
@@ -223,6 +223,34 @@ of the Global Replication Group and all Replication Group members. The AWS provi
 Please use the "-replace" option on the terraform plan and apply commands (see https://www.terraform.io/cli/commands/plan#replace-address).`, diff.Id())
 }
 
+// primaryReplicationGroupIDIsNotMember requires replacement when primary_replication_group_id changes
+// to a replication group that is not a member of the Global Replication Group and so cannot be failed over to.
+func primaryReplicationGroupIDIsNotMember(ctx context.Context, diff *schema.ResourceDiff, meta any) bool {
+	if diff.Id() == "" || !diff.HasChange("primary_replication_group_id") {
+		return false
+	}
+
+	if !diff.NewValueKnown("primary_replication_group_id") {
+		return true
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheConn()
+
+	globalReplicationGroup, err := FindGlobalReplicationGroupByID(ctx, conn, diff.Id())
+	if err != nil {
+		return true
+	}
+
+	primaryReplicationGroupID := diff.Get("primary_replication_group_id").(string)
+	for _, v := range globalReplicationGroup.Members {
+		if aws.StringValue(v.ReplicationGroupId) == primaryReplicationGroupID {
+			return false
+		}
+	}
+
+	return true
+}
+
 type changeDiffer interface {
 	Id() string
 	GetChange(key string) (any, any)
@@ -428,6 +456,12 @@ type globalReplicationGroupUpdater func(input *elasticache.ModifyGlobalReplicati
 func resourceGlobalReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
+	if d.HasChange("primary_replication_group_id") {
+		if err := globalReplicationGroupFailover(ctx, conn, d.Id(), d.Get("primary_replication_group_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("updating ElastiCache Global Replication Group (%s) primary replication group: %s", d.Id(), err)
+		}
+	}
+
 	// Only one field can be changed per request
 	if d.HasChange("cache_node_type") {
 		if err := updateGlobalReplicationGroup(ctx, conn, d.Id(), globalReplicationGroupNodeTypeUpdater(d.Get("cache_node_type").(string)), d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -549,6 +583,44 @@ func updateGlobalReplicationGroup(ctx context.Context, conn *elasticache.ElastiC
 	return nil
 }
 
+// globalReplicationGroupFailover promotes the secondary member replication group primaryReplicationGroupID to primary.
+func globalReplicationGroupFailover(ctx context.Context, conn *elasticache.ElastiCache, id, primaryReplicationGroupID string, timeout time.Duration) error {
+	globalReplicationGroup, err := FindGlobalReplicationGroupByID(ctx, conn, id)
+	if err != nil {
+		return err
+	}
+
+	var member *elasticache.GlobalReplicationGroupMember
+	for _, v := range globalReplicationGroup.Members {
+		if aws.StringValue(v.ReplicationGroupId) == primaryReplicationGroupID {
+			member = v
+			break
+		}
+	}
+	if member == nil {
+		return fmt.Errorf("replication group (%s) is not a member of the global replication group", primaryReplicationGroupID)
+	}
+	if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
+		return nil
+	}
+
+	input := &elasticache.FailoverGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:  aws.String(id),
+		PrimaryRegion:             member.ReplicationGroupRegion,
+		PrimaryReplicationGroupId: aws.String(primaryReplicationGroupID),
+	}
+
+	if _, err := conn.FailoverGlobalReplicationGroupWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitGlobalReplicationGroupAvailable(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 func resourceGlobalReplicationGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).ElastiCacheConn()
 
@@ -0,0 +1,57 @@
+package entityresolution
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindMatchingWorkflowByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetMatchingWorkflowOutput, error) {
+	in := &entityresolution.GetMatchingWorkflowInput{
+		WorkflowName: aws.String(name),
+	}
+	out, err := conn.GetMatchingWorkflowWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindSchemaMappingByName(ctx context.Context, conn *entityresolution.EntityResolution, name string) (*entityresolution.GetSchemaMappingOutput, error) {
+	in := &entityresolution.GetSchemaMappingInput{
+		SchemaName: aws.String(name),
+	}
+	out, err := conn.GetSchemaMappingWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,684 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceMatchingWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMatchingWorkflowCreate,
+		ReadWithoutTimeout:   resourceMatchingWorkflowRead,
+		UpdateWithoutTimeout: resourceMatchingWorkflowUpdate,
+		DeleteWithoutTimeout: resourceMatchingWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"incremental_run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"incremental_run_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.IncrementalRunType_Values(), false),
+						},
+					},
+				},
+			},
+			"input_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"input_source_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apply_normalization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"kms_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"output": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 750,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hashed": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"output_s3_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 1024),
+								validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+							),
+						},
+					},
+				},
+			},
+			"resolution_techniques": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"intermediate_source_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"intermediate_s3_path": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"provider_service_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"resolution_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.ResolutionType_Values(), false),
+						},
+						"rule_based_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_matching_model": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.AttributeMatchingModel_Values(), false),
+									},
+									"match_purpose": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(entityresolution.MatchPurpose_Values(), false),
+									},
+									"rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 15,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"matching_keys": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 15,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"rule_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"workflow_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameMatchingWorkflow = "Matching Workflow"
+)
+
+func resourceMatchingWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	name := d.Get("workflow_name").(string)
+	in := &entityresolution.CreateMatchingWorkflowInput{
+		InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+		OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+		ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+		WorkflowName:         aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("incremental_run_config"); ok {
+		in.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	// IAM propagation.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateMatchingWorkflowWithContext(ctx, in)
+	}, entityresolution.ErrCodeAccessDeniedException, "role")
+
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionCreating, ResNameMatchingWorkflow, name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*entityresolution.CreateMatchingWorkflowOutput).WorkflowName))
+
+	return resourceMatchingWorkflowRead(ctx, d, meta)
+}
+
+func resourceMatchingWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	out, err := FindMatchingWorkflowByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Matching Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionReading, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	d.Set("arn", out.WorkflowArn)
+	d.Set("description", out.Description)
+
+	if err := d.Set("incremental_run_config", flattenIncrementalRunConfig(out.IncrementalRunConfig)); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	if err := d.Set("input_source_config", flattenInputSources(out.InputSourceConfig)); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	if err := d.Set("output_source_config", flattenOutputSources(out.OutputSourceConfig)); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	if err := d.Set("resolution_techniques", flattenResolutionTechniques(out.ResolutionTechniques)); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	d.Set("role_arn", out.RoleArn)
+	d.Set("workflow_name", out.WorkflowName)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.WorkflowArn))
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionReading, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceMatchingWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// UpdateMatchingWorkflow replaces the whole workflow definition.
+		in := &entityresolution.UpdateMatchingWorkflowInput{
+			InputSourceConfig:    expandInputSources(d.Get("input_source_config").([]interface{})),
+			OutputSourceConfig:   expandOutputSources(d.Get("output_source_config").([]interface{})),
+			ResolutionTechniques: expandResolutionTechniques(d.Get("resolution_techniques").([]interface{})),
+			RoleArn:              aws.String(d.Get("role_arn").(string)),
+			WorkflowName:         aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			in.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("incremental_run_config"); ok {
+			in.IncrementalRunConfig = expandIncrementalRunConfig(v.([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Entity Resolution Matching Workflow (%s): %#v", d.Id(), in)
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateMatchingWorkflowWithContext(ctx, in)
+		}, entityresolution.ErrCodeAccessDeniedException, "role")
+
+		if err != nil {
+			return create.DiagError(names.EntityResolution, create.ErrActionUpdating, ResNameMatchingWorkflow, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.EntityResolution, create.ErrActionUpdating, ResNameMatchingWorkflow, d.Id(), err)
+		}
+	}
+
+	return resourceMatchingWorkflowRead(ctx, d, meta)
+}
+
+func resourceMatchingWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	log.Printf("[INFO] Deleting Entity Resolution Matching Workflow %s", d.Id())
+
+	_, err := conn.DeleteMatchingWorkflowWithContext(ctx, &entityresolution.DeleteMatchingWorkflowInput{
+		WorkflowName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionDeleting, ResNameMatchingWorkflow, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIncrementalRunConfig(tfList []interface{}) *entityresolution.IncrementalRunConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &entityresolution.IncrementalRunConfig{
+		IncrementalRunType: aws.String(tfMap["incremental_run_type"].(string)),
+	}
+}
+
+func expandInputSources(tfList []interface{}) []*entityresolution.InputSource {
+	var apiObjects []*entityresolution.InputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &entityresolution.InputSource{
+			ApplyNormalization: aws.Bool(tfMap["apply_normalization"].(bool)),
+			InputSourceARN:     aws.String(tfMap["input_source_arn"].(string)),
+			SchemaName:         aws.String(tfMap["schema_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandOutputSources(tfList []interface{}) []*entityresolution.OutputSource {
+	var apiObjects []*entityresolution.OutputSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.OutputSource{
+			ApplyNormalization: aws.Bool(tfMap["apply_normalization"].(bool)),
+			Output:             expandOutputAttributes(tfMap["output"].([]interface{})),
+			OutputS3Path:       aws.String(tfMap["output_s3_path"].(string)),
+		}
+
+		if v, ok := tfMap["kms_arn"].(string); ok && v != "" {
+			apiObject.KMSArn = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputAttributes(tfList []interface{}) []*entityresolution.OutputAttribute {
+	var apiObjects []*entityresolution.OutputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &entityresolution.OutputAttribute{
+			Hashed: aws.Bool(tfMap["hashed"].(bool)),
+			Name:   aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandResolutionTechniques(tfList []interface{}) *entityresolution.ResolutionTechniques {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &entityresolution.ResolutionTechniques{
+		ResolutionType: aws.String(tfMap["resolution_type"].(string)),
+	}
+
+	if v, ok := tfMap["provider_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ProviderProperties = expandProviderProperties(v)
+	}
+
+	if v, ok := tfMap["rule_based_properties"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RuleBasedProperties = expandRuleBasedProperties(v)
+	}
+
+	return apiObject
+}
+
+func expandProviderProperties(tfList []interface{}) *entityresolution.ProviderProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &entityresolution.ProviderProperties{
+		ProviderServiceArn: aws.String(tfMap["provider_service_arn"].(string)),
+	}
+
+	if v, ok := tfMap["intermediate_source_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.IntermediateSourceConfiguration = &entityresolution.IntermediateSourceConfiguration{
+			IntermediateS3Path: aws.String(v[0].(map[string]interface{})["intermediate_s3_path"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandRuleBasedProperties(tfList []interface{}) *entityresolution.RuleBasedProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &entityresolution.RuleBasedProperties{
+		AttributeMatchingModel: aws.String(tfMap["attribute_matching_model"].(string)),
+		Rules:                  expandRules(tfMap["rule"].([]interface{})),
+	}
+
+	if v, ok := tfMap["match_purpose"].(string); ok && v != "" {
+		apiObject.MatchPurpose = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRules(tfList []interface{}) []*entityresolution.Rule {
+	var apiObjects []*entityresolution.Rule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &entityresolution.Rule{
+			MatchingKeys: flex.ExpandStringList(tfMap["matching_keys"].([]interface{})),
+			RuleName:     aws.String(tfMap["rule_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenIncrementalRunConfig(apiObject *entityresolution.IncrementalRunConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"incremental_run_type": aws.StringValue(apiObject.IncrementalRunType),
+		},
+	}
+}
+
+func flattenInputSources(apiObjects []*entityresolution.InputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"input_source_arn":    aws.StringValue(apiObject.InputSourceARN),
+			"schema_name":         aws.StringValue(apiObject.SchemaName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputSources(apiObjects []*entityresolution.OutputSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"apply_normalization": aws.BoolValue(apiObject.ApplyNormalization),
+			"kms_arn":             aws.StringValue(apiObject.KMSArn),
+			"output":              flattenOutputAttributes(apiObject.Output),
+			"output_s3_path":      aws.StringValue(apiObject.OutputS3Path),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputAttributes(apiObjects []*entityresolution.OutputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hashed": aws.BoolValue(apiObject.Hashed),
+			"name":   aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResolutionTechniques(apiObject *entityresolution.ResolutionTechniques) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"resolution_type": aws.StringValue(apiObject.ResolutionType),
+	}
+
+	if v := apiObject.ProviderProperties; v != nil {
+		tfMap["provider_properties"] = flattenProviderProperties(v)
+	}
+
+	if v := apiObject.RuleBasedProperties; v != nil {
+		tfMap["rule_based_properties"] = flattenRuleBasedProperties(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenProviderProperties(apiObject *entityresolution.ProviderProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"provider_service_arn": aws.StringValue(apiObject.ProviderServiceArn),
+	}
+
+	if v := apiObject.IntermediateSourceConfiguration; v != nil {
+		tfMap["intermediate_source_configuration"] = []interface{}{
+			map[string]interface{}{
+				"intermediate_s3_path": aws.StringValue(v.IntermediateS3Path),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRuleBasedProperties(apiObject *entityresolution.RuleBasedProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"attribute_matching_model": aws.StringValue(apiObject.AttributeMatchingModel),
+			"match_purpose":            aws.StringValue(apiObject.MatchPurpose),
+			"rule":                     flattenRules(apiObject.Rules),
+		},
+	}
+}
+
+func flattenRules(apiObjects []*entityresolution.Rule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"matching_keys": aws.StringValueSlice(apiObject.MatchingKeys),
+			"rule_name":     aws.StringValue(apiObject.RuleName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,285 @@
+package entityresolution
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceSchemaMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaMappingCreate,
+		ReadWithoutTimeout:   resourceSchemaMappingRead,
+		UpdateWithoutTimeout: resourceSchemaMappingUpdate,
+		DeleteWithoutTimeout: resourceSchemaMappingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"has_workflows": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"mapped_input_fields": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hashed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"match_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sub_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(entityresolution.SchemaAttributeType_Values(), false),
+						},
+					},
+				},
+			},
+			"schema_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9-]*$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameSchemaMapping = "Schema Mapping"
+)
+
+func resourceSchemaMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	name := d.Get("schema_name").(string)
+	in := &entityresolution.CreateSchemaMappingInput{
+		MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_fields").([]interface{})),
+		SchemaName:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateSchemaMappingWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionCreating, ResNameSchemaMapping, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.SchemaName))
+
+	return resourceSchemaMappingRead(ctx, d, meta)
+}
+
+func resourceSchemaMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	out, err := FindSchemaMappingByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Entity Resolution Schema Mapping (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionReading, ResNameSchemaMapping, d.Id(), err)
+	}
+
+	d.Set("arn", out.SchemaArn)
+	d.Set("description", out.Description)
+	d.Set("has_workflows", out.HasWorkflows)
+
+	if err := d.Set("mapped_input_fields", flattenSchemaInputAttributes(out.MappedInputFields)); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameSchemaMapping, d.Id(), err)
+	}
+
+	d.Set("schema_name", out.SchemaName)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.SchemaArn))
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionReading, ResNameSchemaMapping, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameSchemaMapping, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionSetting, ResNameSchemaMapping, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSchemaMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	if d.HasChanges("description", "mapped_input_fields") {
+		in := &entityresolution.UpdateSchemaMappingInput{
+			MappedInputFields: expandSchemaInputAttributes(d.Get("mapped_input_fields").([]interface{})),
+			SchemaName:        aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			in.Description = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Entity Resolution Schema Mapping (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateSchemaMappingWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.EntityResolution, create.ErrActionUpdating, ResNameSchemaMapping, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.EntityResolution, create.ErrActionUpdating, ResNameSchemaMapping, d.Id(), err)
+		}
+	}
+
+	return resourceSchemaMappingRead(ctx, d, meta)
+}
+
+func resourceSchemaMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EntityResolutionConn()
+
+	log.Printf("[INFO] Deleting Entity Resolution Schema Mapping %s", d.Id())
+
+	_, err := conn.DeleteSchemaMappingWithContext(ctx, &entityresolution.DeleteSchemaMappingInput{
+		SchemaName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, entityresolution.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.EntityResolution, create.ErrActionDeleting, ResNameSchemaMapping, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSchemaInputAttributes(tfList []interface{}) []*entityresolution.SchemaInputAttribute {
+	var apiObjects []*entityresolution.SchemaInputAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &entityresolution.SchemaInputAttribute{
+			FieldName: aws.String(tfMap["field_name"].(string)),
+			Type:      aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["group_name"].(string); ok && v != "" {
+			apiObject.GroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["hashed"].(bool); ok && v {
+			apiObject.Hashed = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["match_key"].(string); ok && v != "" {
+			apiObject.MatchKey = aws.String(v)
+		}
+
+		if v, ok := tfMap["sub_type"].(string); ok && v != "" {
+			apiObject.SubType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSchemaInputAttributes(apiObjects []*entityresolution.SchemaInputAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"field_name": aws.StringValue(apiObject.FieldName),
+			"group_name": aws.StringValue(apiObject.GroupName),
+			"hashed":     aws.BoolValue(apiObject.Hashed),
+			"match_key":  aws.StringValue(apiObject.MatchKey),
+			"sub_type":   aws.StringValue(apiObject.SubType),
+			"type":       aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,118 @@
+//go:build sweep
+// +build sweep
+
+package entityresolution
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/entityresolution"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_entityresolution_matching_workflow", &resource.Sweeper{
+		Name: "aws_entityresolution_matching_workflow",
+		F:    sweepMatchingWorkflows,
+	})
+
+	resource.AddTestSweepers("aws_entityresolution_schema_mapping", &resource.Sweeper{
+		Name: "aws_entityresolution_schema_mapping",
+		F:    sweepSchemaMappings,
+		Dependencies: []string{
+			"aws_entityresolution_matching_workflow",
+		},
+	})
+}
+
+func sweepMatchingWorkflows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EntityResolutionConn()
+	input := &entityresolution.ListMatchingWorkflowsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListMatchingWorkflowsPagesWithContext(ctx, input, func(page *entityresolution.ListMatchingWorkflowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkflowSummaries {
+			r := ResourceMatchingWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.WorkflowName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Entity Resolution Matching Workflow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Entity Resolution Matching Workflows (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution Matching Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSchemaMappings(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EntityResolutionConn()
+	input := &entityresolution.ListSchemaMappingsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListSchemaMappingsPagesWithContext(ctx, input, func(page *entityresolution.ListSchemaMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SchemaList {
+			r := ResourceSchemaMapping()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SchemaName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Entity Resolution Schema Mapping sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Entity Resolution Schema Mappings (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Entity Resolution Schema Mappings (%s): %w", region, err)
+	}
+
+	return nil
+}
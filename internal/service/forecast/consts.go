@@ -0,0 +1,11 @@
+package forecast
+
+const (
+	statusActive           = "ACTIVE"
+	statusCreateInProgress = "CREATE_IN_PROGRESS"
+	statusCreatePending    = "CREATE_PENDING"
+	statusDeleteInProgress = "DELETE_IN_PROGRESS"
+	statusDeletePending    = "DELETE_PENDING"
+	statusUpdateInProgress = "UPDATE_IN_PROGRESS"
+	statusUpdatePending    = "UPDATE_PENDING"
+)
@@ -0,0 +1,410 @@
+package forecast
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dataset_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"dataset_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(forecastservice.DatasetType_Values(), false),
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(forecastservice.Domain_Values(), false),
+			},
+			"encryption_config": encryptionConfigSchema(),
+			"schema": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 63),
+									},
+									"attribute_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(forecastservice.AttributeType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func encryptionConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"kms_key_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("dataset_name").(string)
+	input := &forecastservice.CreateDatasetInput{
+		DatasetName: aws.String(name),
+		DatasetType: aws.String(d.Get("dataset_type").(string)),
+		Domain:      aws.String(d.Get("domain").(string)),
+		Schema:      expandSchema(d.Get("schema").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("data_frequency"); ok {
+		input.DataFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("encryption_config"); ok {
+		input.EncryptionConfig = expandEncryptionConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateDatasetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Forecast Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DatasetArn))
+
+	if _, err := waitDatasetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Dataset (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDatasetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Forecast Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Forecast Dataset (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.DatasetArn)
+	d.Set("data_frequency", output.DataFrequency)
+	d.Set("dataset_name", output.DatasetName)
+	d.Set("dataset_type", output.DatasetType)
+	d.Set("domain", output.Domain)
+	if err := d.Set("encryption_config", flattenEncryptionConfig(output.EncryptionConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_config: %s", err)
+	}
+	if err := d.Set("schema", flattenSchema(output.Schema)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting schema: %s", err)
+	}
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Forecast Dataset (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Forecast Dataset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	log.Printf("[DEBUG] Deleting Forecast Dataset: %s", d.Id())
+	_, err := conn.DeleteDatasetWithContext(ctx, &forecastservice.DeleteDatasetInput{
+		DatasetArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Forecast Dataset (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDatasetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Dataset (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDatasetByARN(ctx context.Context, conn *forecastservice.ForecastService, arn string) (*forecastservice.DescribeDatasetOutput, error) {
+	input := &forecastservice.DescribeDatasetInput{
+		DatasetArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDatasetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDataset(ctx context.Context, conn *forecastservice.ForecastService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDatasetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDatasetActive(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeDatasetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusDataset(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeDatasetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDatasetDeleted(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeDatasetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusDataset(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeDatasetOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandEncryptionConfig(tfList []interface{}) *forecastservice.EncryptionConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &forecastservice.EncryptionConfig{
+		KMSKeyArn: aws.String(tfMap["kms_key_arn"].(string)),
+		RoleArn:   aws.String(tfMap["role_arn"].(string)),
+	}
+}
+
+func flattenEncryptionConfig(apiObject *forecastservice.EncryptionConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"kms_key_arn": aws.StringValue(apiObject.KMSKeyArn),
+		"role_arn":    aws.StringValue(apiObject.RoleArn),
+	}}
+}
+
+func expandSchema(tfList []interface{}) *forecastservice.Schema {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &forecastservice.Schema{}
+
+	for _, tfMapRaw := range tfMap["attribute"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Attributes = append(apiObject.Attributes, &forecastservice.SchemaAttribute{
+			AttributeName: aws.String(tfMap["attribute_name"].(string)),
+			AttributeType: aws.String(tfMap["attribute_type"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenSchema(apiObject *forecastservice.Schema) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Attributes {
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attribute_name": aws.StringValue(v.AttributeName),
+			"attribute_type": aws.StringValue(v.AttributeType),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"attribute": tfList,
+	}}
+}
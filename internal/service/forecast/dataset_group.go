@@ -0,0 +1,285 @@
+package forecast
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDatasetGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetGroupCreate,
+		ReadWithoutTimeout:   resourceDatasetGroupRead,
+		UpdateWithoutTimeout: resourceDatasetGroupUpdate,
+		DeleteWithoutTimeout: resourceDatasetGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"dataset_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(forecastservice.Domain_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatasetGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("dataset_group_name").(string)
+	input := &forecastservice.CreateDatasetGroupInput{
+		DatasetGroupName: aws.String(name),
+		Domain:           aws.String(d.Get("domain").(string)),
+	}
+
+	if v, ok := d.GetOk("dataset_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.DatasetArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateDatasetGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Forecast Dataset Group (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DatasetGroupArn))
+
+	if _, err := waitDatasetGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Dataset Group (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDatasetGroupRead(ctx, d, meta)...)
+}
+
+func resourceDatasetGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindDatasetGroupByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Forecast Dataset Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Forecast Dataset Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.DatasetGroupArn)
+	d.Set("dataset_arns", aws.StringValueSlice(output.DatasetArns))
+	d.Set("dataset_group_name", output.DatasetGroupName)
+	d.Set("domain", output.Domain)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Forecast Dataset Group (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDatasetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	if d.HasChange("dataset_arns") {
+		input := &forecastservice.UpdateDatasetGroupInput{
+			DatasetArns:     flex.ExpandStringSet(d.Get("dataset_arns").(*schema.Set)),
+			DatasetGroupArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateDatasetGroupWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Forecast Dataset Group (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDatasetGroupActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Forecast Dataset Group (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Forecast Dataset Group (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatasetGroupRead(ctx, d, meta)...)
+}
+
+func resourceDatasetGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	log.Printf("[DEBUG] Deleting Forecast Dataset Group: %s", d.Id())
+	_, err := conn.DeleteDatasetGroupWithContext(ctx, &forecastservice.DeleteDatasetGroupInput{
+		DatasetGroupArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Forecast Dataset Group (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDatasetGroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Dataset Group (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDatasetGroupByARN(ctx context.Context, conn *forecastservice.ForecastService, arn string) (*forecastservice.DescribeDatasetGroupOutput, error) {
+	input := &forecastservice.DescribeDatasetGroupInput{
+		DatasetGroupArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDatasetGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDatasetGroup(ctx context.Context, conn *forecastservice.ForecastService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDatasetGroupByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDatasetGroupActive(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeDatasetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress, statusUpdatePending, statusUpdateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusDatasetGroup(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeDatasetGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDatasetGroupDeleted(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeDatasetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusDatasetGroup(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeDatasetGroupOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,182 @@
+package forecast_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfforecast "github.com/hashicorp/terraform-provider-aws/internal/service/forecast"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccForecastDatasetGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v forecastservice.DescribeDatasetGroupOutput
+	resourceName := "aws_forecast_dataset_group.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetGroupExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "forecast", regexp.MustCompile(`dataset-group/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "dataset_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dataset_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "domain", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccForecastDatasetGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v forecastservice.DescribeDatasetGroupOutput
+	resourceName := "aws_forecast_dataset_group.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetGroupExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfforecast.ResourceDatasetGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccForecastDatasetGroup_datasetARNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v forecastservice.DescribeDatasetGroupOutput
+	resourceName := "aws_forecast_dataset_group.test"
+	datasetResourceName := "aws_forecast_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetGroupConfig_datasetARNs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dataset_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "dataset_arns.*", datasetResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "dataset_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetGroupExists(ctx context.Context, n string, v *forecastservice.DescribeDatasetGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Forecast Dataset Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		output, err := tfforecast.FindDatasetGroupByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDatasetGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_forecast_dataset_group" {
+				continue
+			}
+
+			_, err := tfforecast.FindDatasetGroupByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Forecast Dataset Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDatasetGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_forecast_dataset_group" "test" {
+  dataset_group_name = %[1]q
+  domain             = "CUSTOM"
+}
+`, rName)
+}
+
+func testAccDatasetGroupConfig_datasetARNs(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+resource "aws_forecast_dataset_group" "test" {
+  dataset_arns       = [aws_forecast_dataset.test.arn]
+  dataset_group_name = %[1]q
+  domain             = "CUSTOM"
+}
+`, rName))
+}
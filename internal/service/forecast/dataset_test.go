@@ -0,0 +1,240 @@
+package forecast_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfforecast "github.com/hashicorp/terraform-provider-aws/internal/service/forecast"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccForecastDataset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v forecastservice.DescribeDatasetOutput
+	resourceName := "aws_forecast_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "forecast", regexp.MustCompile(`dataset/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_frequency", "D"),
+					resource.TestCheckResourceAttr(resourceName, "dataset_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "dataset_type", "TARGET_TIME_SERIES"),
+					resource.TestCheckResourceAttr(resourceName, "domain", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.attribute.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.attribute.0.attribute_name", "timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.attribute.0.attribute_type", "timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccForecastDataset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v forecastservice.DescribeDatasetOutput
+	resourceName := "aws_forecast_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfforecast.ResourceDataset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccForecastDataset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v forecastservice.DescribeDatasetOutput
+	resourceName := "aws_forecast_dataset.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDatasetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatasetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccDatasetConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatasetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatasetExists(ctx context.Context, n string, v *forecastservice.DescribeDatasetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Forecast Dataset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		output, err := tfforecast.FindDatasetByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDatasetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_forecast_dataset" {
+				continue
+			}
+
+			_, err := tfforecast.FindDatasetByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Forecast Dataset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccDatasetConfig_schema = `
+  schema {
+    attribute {
+      attribute_name = "timestamp"
+      attribute_type = "timestamp"
+    }
+
+    attribute {
+      attribute_name = "target_value"
+      attribute_type = "float"
+    }
+
+    attribute {
+      attribute_name = "item_id"
+      attribute_type = "string"
+    }
+  }
+`
+
+func testAccDatasetConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_forecast_dataset" "test" {
+  data_frequency = "D"
+  dataset_name   = %[1]q
+  dataset_type   = "TARGET_TIME_SERIES"
+  domain         = "CUSTOM"
+%[2]s
+}
+`, rName, testAccDatasetConfig_schema)
+}
+
+func testAccDatasetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_forecast_dataset" "test" {
+  data_frequency = "D"
+  dataset_name   = %[1]q
+  dataset_type   = "TARGET_TIME_SERIES"
+  domain         = "CUSTOM"
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccDatasetConfig_schema, tagKey1, tagValue1)
+}
+
+func testAccDatasetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_forecast_dataset" "test" {
+  data_frequency = "D"
+  dataset_name   = %[1]q
+  dataset_type   = "TARGET_TIME_SERIES"
+  domain         = "CUSTOM"
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccDatasetConfig_schema, tagKey1, tagValue1, tagKey2, tagValue2)
+}
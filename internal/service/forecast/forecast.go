@@ -0,0 +1,277 @@
+package forecast
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceForecast() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceForecastCreate,
+		ReadWithoutTimeout:   resourceForecastRead,
+		UpdateWithoutTimeout: resourceForecastUpdate,
+		DeleteWithoutTimeout: resourceForecastDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"forecast_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"forecast_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 20,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"predictor_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceForecastCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("forecast_name").(string)
+	input := &forecastservice.CreateForecastInput{
+		ForecastName: aws.String(name),
+		PredictorArn: aws.String(d.Get("predictor_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("forecast_types"); ok && len(v.([]interface{})) > 0 {
+		input.ForecastTypes = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateForecastWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Forecast Forecast (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ForecastArn))
+
+	if _, err := waitForecastActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Forecast (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceForecastRead(ctx, d, meta)...)
+}
+
+func resourceForecastRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindForecastByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Forecast Forecast (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Forecast Forecast (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.ForecastArn)
+	d.Set("dataset_group_arn", output.DatasetGroupArn)
+	d.Set("forecast_name", output.ForecastName)
+	d.Set("forecast_types", aws.StringValueSlice(output.ForecastTypes))
+	d.Set("predictor_arn", output.PredictorArn)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Forecast Forecast (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceForecastUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Forecast Forecast (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceForecastRead(ctx, d, meta)...)
+}
+
+func resourceForecastDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	log.Printf("[DEBUG] Deleting Forecast Forecast: %s", d.Id())
+	_, err := conn.DeleteForecastWithContext(ctx, &forecastservice.DeleteForecastInput{
+		ForecastArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Forecast Forecast (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitForecastDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Forecast (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindForecastByARN(ctx context.Context, conn *forecastservice.ForecastService, arn string) (*forecastservice.DescribeForecastOutput, error) {
+	input := &forecastservice.DescribeForecastInput{
+		ForecastArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeForecastWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusForecast(ctx context.Context, conn *forecastservice.ForecastService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindForecastByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitForecastActive(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeForecastOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusForecast(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeForecastOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitForecastDeleted(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeForecastOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusForecast(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeForecastOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
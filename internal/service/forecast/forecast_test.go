@@ -0,0 +1,119 @@
+package forecast_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfforecast "github.com/hashicorp/terraform-provider-aws/internal/service/forecast"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Training a predictor takes hours, so the forecast tests
+// require an existing predictor.
+func TestAccForecastForecast_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "FORECAST_PREDICTOR_ARN"
+	predictorARN := os.Getenv(key)
+	if predictorARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v forecastservice.DescribeForecastOutput
+	resourceName := "aws_forecast_forecast.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckForecastDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccForecastConfig_basic(rName, predictorARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckForecastExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "forecast", regexp.MustCompile(`forecast/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "dataset_group_arn"),
+					resource.TestCheckResourceAttr(resourceName, "forecast_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "predictor_arn", predictorARN),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckForecastExists(ctx context.Context, n string, v *forecastservice.DescribeForecastOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Forecast Forecast ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		output, err := tfforecast.FindForecastByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckForecastDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_forecast_forecast" {
+				continue
+			}
+
+			_, err := tfforecast.FindForecastByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Forecast Forecast %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccForecastConfig_basic(rName, predictorARN string) string {
+	return fmt.Sprintf(`
+resource "aws_forecast_forecast" "test" {
+  forecast_name = %[1]q
+  predictor_arn = %[2]q
+}
+`, rName, predictorARN)
+}
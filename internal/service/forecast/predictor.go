@@ -0,0 +1,334 @@
+package forecast
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePredictor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePredictorCreate,
+		ReadWithoutTimeout:   resourcePredictorRead,
+		UpdateWithoutTimeout: resourcePredictorUpdate,
+		DeleteWithoutTimeout: resourcePredictorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"encryption_config": encryptionConfigSchema(),
+			"explain_predictor": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"forecast_dimensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"forecast_frequency": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"forecast_horizon": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"forecast_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 20,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"optimization_metric": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(forecastservice.OptimizationMetric_Values(), false),
+			},
+			"predictor_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePredictorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("predictor_name").(string)
+	input := &forecastservice.CreateAutoPredictorInput{
+		DataConfig: &forecastservice.DataConfig{
+			DatasetGroupArn: aws.String(d.Get("dataset_group_arn").(string)),
+		},
+		ForecastFrequency: aws.String(d.Get("forecast_frequency").(string)),
+		ForecastHorizon:   aws.Int64(int64(d.Get("forecast_horizon").(int))),
+		PredictorName:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("encryption_config"); ok {
+		input.EncryptionConfig = expandEncryptionConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("explain_predictor"); ok {
+		input.ExplainPredictor = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("forecast_dimensions"); ok && len(v.([]interface{})) > 0 {
+		input.ForecastDimensions = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("forecast_types"); ok && len(v.([]interface{})) > 0 {
+		input.ForecastTypes = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("optimization_metric"); ok {
+		input.OptimizationMetric = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAutoPredictorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Forecast Predictor (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.PredictorArn))
+
+	if _, err := waitPredictorActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Predictor (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePredictorRead(ctx, d, meta)...)
+}
+
+func resourcePredictorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPredictorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Forecast Predictor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Forecast Predictor (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.PredictorArn)
+	if output.DataConfig != nil {
+		d.Set("dataset_group_arn", output.DataConfig.DatasetGroupArn)
+	} else {
+		d.Set("dataset_group_arn", nil)
+	}
+	if err := d.Set("encryption_config", flattenEncryptionConfig(output.EncryptionConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting encryption_config: %s", err)
+	}
+	d.Set("explain_predictor", output.ExplainabilityInfo != nil)
+	d.Set("forecast_dimensions", aws.StringValueSlice(output.ForecastDimensions))
+	d.Set("forecast_frequency", output.ForecastFrequency)
+	d.Set("forecast_horizon", output.ForecastHorizon)
+	d.Set("forecast_types", aws.StringValueSlice(output.ForecastTypes))
+	d.Set("optimization_metric", output.OptimizationMetric)
+	d.Set("predictor_name", output.PredictorName)
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Forecast Predictor (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePredictorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Forecast Predictor (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePredictorRead(ctx, d, meta)...)
+}
+
+func resourcePredictorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ForecastConn()
+
+	log.Printf("[DEBUG] Deleting Forecast Predictor: %s", d.Id())
+	_, err := conn.DeletePredictorWithContext(ctx, &forecastservice.DeletePredictorInput{
+		PredictorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Forecast Predictor (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPredictorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Forecast Predictor (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPredictorByARN(ctx context.Context, conn *forecastservice.ForecastService, arn string) (*forecastservice.DescribeAutoPredictorOutput, error) {
+	input := &forecastservice.DescribeAutoPredictorInput{
+		PredictorArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAutoPredictorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, forecastservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPredictor(ctx context.Context, conn *forecastservice.ForecastService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPredictorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitPredictorActive(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeAutoPredictorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusPredictor(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeAutoPredictorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPredictorDeleted(ctx context.Context, conn *forecastservice.ForecastService, arn string, timeout time.Duration) (*forecastservice.DescribeAutoPredictorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusPredictor(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*forecastservice.DescribeAutoPredictorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,122 @@
+package forecast_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfforecast "github.com/hashicorp/terraform-provider-aws/internal/service/forecast"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Training a predictor requires a dataset group with imported data,
+// so the predictor tests require an existing dataset group.
+func TestAccForecastPredictor_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "FORECAST_DATASET_GROUP_ARN"
+	datasetGroupARN := os.Getenv(key)
+	if datasetGroupARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v forecastservice.DescribeAutoPredictorOutput
+	resourceName := "aws_forecast_predictor.test"
+	rName := strings.ReplaceAll(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix), "-", "_")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, forecastservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPredictorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPredictorConfig_basic(rName, datasetGroupARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPredictorExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "forecast", regexp.MustCompile(`predictor/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "dataset_group_arn", datasetGroupARN),
+					resource.TestCheckResourceAttr(resourceName, "forecast_frequency", "D"),
+					resource.TestCheckResourceAttr(resourceName, "forecast_horizon", "7"),
+					resource.TestCheckResourceAttr(resourceName, "predictor_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPredictorExists(ctx context.Context, n string, v *forecastservice.DescribeAutoPredictorOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Forecast Predictor ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		output, err := tfforecast.FindPredictorByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPredictorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ForecastConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_forecast_predictor" {
+				continue
+			}
+
+			_, err := tfforecast.FindPredictorByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Forecast Predictor %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPredictorConfig_basic(rName, datasetGroupARN string) string {
+	return fmt.Sprintf(`
+resource "aws_forecast_predictor" "test" {
+  dataset_group_arn  = %[2]q
+  forecast_frequency = "D"
+  forecast_horizon   = 7
+  predictor_name     = %[1]q
+}
+`, rName, datasetGroupARN)
+}
@@ -0,0 +1,222 @@
+//go:build sweep
+// +build sweep
+
+package forecast
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_forecast_dataset", &resource.Sweeper{
+		Name: "aws_forecast_dataset",
+		F:    sweepDatasets,
+		Dependencies: []string{
+			"aws_forecast_dataset_group",
+		},
+	})
+
+	resource.AddTestSweepers("aws_forecast_dataset_group", &resource.Sweeper{
+		Name: "aws_forecast_dataset_group",
+		F:    sweepDatasetGroups,
+		Dependencies: []string{
+			"aws_forecast_predictor",
+		},
+	})
+
+	resource.AddTestSweepers("aws_forecast_forecast", &resource.Sweeper{
+		Name: "aws_forecast_forecast",
+		F:    sweepForecasts,
+	})
+
+	resource.AddTestSweepers("aws_forecast_predictor", &resource.Sweeper{
+		Name: "aws_forecast_predictor",
+		F:    sweepPredictors,
+		Dependencies: []string{
+			"aws_forecast_forecast",
+		},
+	})
+}
+
+func sweepDatasets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ForecastConn()
+	input := &forecastservice.ListDatasetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDatasetsPagesWithContext(ctx, input, func(page *forecastservice.ListDatasetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Datasets {
+			r := ResourceDataset()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatasetArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Forecast Dataset sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Forecast Datasets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Forecast Datasets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDatasetGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ForecastConn()
+	input := &forecastservice.ListDatasetGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDatasetGroupsPagesWithContext(ctx, input, func(page *forecastservice.ListDatasetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatasetGroups {
+			r := ResourceDatasetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatasetGroupArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Forecast Dataset Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Forecast Dataset Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Forecast Dataset Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepForecasts(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ForecastConn()
+	input := &forecastservice.ListForecastsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListForecastsPagesWithContext(ctx, input, func(page *forecastservice.ListForecastsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Forecasts {
+			r := ResourceForecast()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ForecastArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Forecast Forecast sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Forecast Forecasts (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Forecast Forecasts (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepPredictors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ForecastConn()
+	input := &forecastservice.ListPredictorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPredictorsPagesWithContext(ctx, input, func(page *forecastservice.ListPredictorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Predictors {
+			r := ResourcePredictor()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PredictorArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Forecast Predictor sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Forecast Predictors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Forecast Predictors (%s): %w", region, err)
+	}
+
+	return nil
+}
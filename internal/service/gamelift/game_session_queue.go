@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -65,6 +66,31 @@ func ResourceGameSessionQueue() *schema.Resource {
 					},
 				},
 			},
+			"priority_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location_order": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"priority_order": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 4,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(gamelift.PriorityType_Values(), false),
+							},
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"timeout_in_seconds": {
@@ -96,6 +122,10 @@ func resourceGameSessionQueueCreate(ctx context.Context, d *schema.ResourceData,
 		input.NotificationTarget = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("priority_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PriorityConfiguration = expandPriorityConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[INFO] Creating GameLift Session Queue: %s", input)
 	out, err := conn.CreateGameSessionQueueWithContext(ctx, &input)
 	if err != nil {
@@ -151,6 +181,13 @@ func resourceGameSessionQueueRead(ctx context.Context, d *schema.ResourceData, m
 	if err := d.Set("player_latency_policy", flattenPlayerLatencyPolicies(sessionQueue.PlayerLatencyPolicies)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting player_latency_policy: %s", err)
 	}
+	if sessionQueue.PriorityConfiguration != nil {
+		if err := d.Set("priority_configuration", []interface{}{flattenPriorityConfiguration(sessionQueue.PriorityConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting priority_configuration: %s", err)
+		}
+	} else {
+		d.Set("priority_configuration", nil)
+	}
 
 	tags, err := ListTags(ctx, conn, arn)
 
@@ -214,6 +251,10 @@ func resourceGameSessionQueueUpdate(ctx context.Context, d *schema.ResourceData,
 		input.NotificationTarget = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("priority_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PriorityConfiguration = expandPriorityConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.UpdateGameSessionQueueWithContext(ctx, &input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating GameLift Game Session Queue (%s): %s", d.Id(), err)
@@ -279,3 +320,39 @@ func expandGameSessionPlayerLatencyPolicies(destinationsPlayerLatencyPolicyMap [
 	}
 	return playerLatencyPolicies
 }
+
+func expandPriorityConfiguration(tfMap map[string]interface{}) *gamelift.PriorityConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &gamelift.PriorityConfiguration{}
+
+	if v, ok := tfMap["location_order"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LocationOrder = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["priority_order"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PriorityOrder = flex.ExpandStringList(v)
+	}
+
+	return apiObject
+}
+
+func flattenPriorityConfiguration(apiObject *gamelift.PriorityConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LocationOrder; v != nil {
+		tfMap["location_order"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.PriorityOrder; v != nil {
+		tfMap["priority_order"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
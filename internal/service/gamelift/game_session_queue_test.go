@@ -160,6 +160,54 @@ func TestAccGameLiftGameSessionQueue_tags(t *testing.T) {
 	})
 }
 
+func TestAccGameLiftGameSessionQueue_priorityConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf gamelift.GameSessionQueue
+
+	resourceName := "aws_gamelift_game_session_queue.test"
+	queueName := testAccGameSessionQueuePrefix + sdkacctest.RandString(8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, gamelift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGameSessionQueueDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGameSessionQueueConfig_priorityConfiguration(queueName, `"LATENCY", "COST", "DESTINATION", "LOCATION"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameSessionQueueExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.0.location_order.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "priority_configuration.0.location_order.0", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.0.priority_order.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.0.priority_order.0", "LATENCY"),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.0.priority_order.3", "LOCATION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGameSessionQueueConfig_priorityConfiguration(queueName, `"COST", "LATENCY", "LOCATION", "DESTINATION"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameSessionQueueExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.0.priority_order.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.0.priority_order.0", "COST"),
+					resource.TestCheckResourceAttr(resourceName, "priority_configuration.0.priority_order.3", "DESTINATION"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGameLiftGameSessionQueue_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf gamelift.GameSessionQueue
@@ -373,3 +421,21 @@ resource "aws_gamelift_game_session_queue" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccGameSessionQueueConfig_priorityConfiguration(rName, priorityOrder string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_gamelift_game_session_queue" "test" {
+  name         = %[1]q
+  destinations = []
+
+  priority_configuration {
+    location_order = [data.aws_region.current.name]
+    priority_order = [%[2]s]
+  }
+
+  timeout_in_seconds = 10
+}
+`, rName, priorityOrder)
+}
@@ -0,0 +1,333 @@
+package globalaccelerator
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCrossAccountAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCrossAccountAttachmentCreate,
+		ReadWithoutTimeout:   resourceCrossAccountAttachmentRead,
+		UpdateWithoutTimeout: resourceCrossAccountAttachmentUpdate,
+		DeleteWithoutTimeout: resourceCrossAccountAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"endpoint_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCrossAccountAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &globalaccelerator.CreateCrossAccountAttachmentInput{
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Name:             aws.String(name),
+		Tags:             Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("principals"); ok && v.(*schema.Set).Len() > 0 {
+		input.Principals = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource"); ok && v.(*schema.Set).Len() > 0 {
+		input.Resources = expandResources(v.(*schema.Set).List())
+	}
+
+	output, err := conn.CreateCrossAccountAttachmentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Global Accelerator Cross-account Attachment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CrossAccountAttachment.AttachmentArn))
+
+	return resourceCrossAccountAttachmentRead(ctx, d, meta)
+}
+
+func resourceCrossAccountAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	attachment, err := FindCrossAccountAttachmentByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Cross-account Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Global Accelerator Cross-account Attachment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", attachment.AttachmentArn)
+	if attachment.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(attachment.CreatedTime).Format(time.RFC3339))
+	} else {
+		d.Set("created_time", nil)
+	}
+	if attachment.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(attachment.LastModifiedTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_time", nil)
+	}
+	d.Set("name", attachment.Name)
+	d.Set("principals", aws.StringValueSlice(attachment.Principals))
+	if err := d.Set("resource", flattenResources(attachment.Resources)); err != nil {
+		return diag.Errorf("setting resource: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for Global Accelerator Cross-account Attachment (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCrossAccountAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	if d.HasChanges("name", "principals", "resource") {
+		input := &globalaccelerator.UpdateCrossAccountAttachmentInput{
+			AttachmentArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("principals") {
+			o, n := d.GetChange("principals")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.AddPrincipals = flex.ExpandStringSet(add)
+			}
+
+			if del := os.Difference(ns); del.Len() > 0 {
+				input.RemovePrincipals = flex.ExpandStringSet(del)
+			}
+		}
+
+		if d.HasChange("resource") {
+			o, n := d.GetChange("resource")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := ns.Difference(os).List(); len(add) > 0 {
+				input.AddResources = expandResources(add)
+			}
+
+			if del := os.Difference(ns).List(); len(del) > 0 {
+				input.RemoveResources = expandResources(del)
+			}
+		}
+
+		_, err := conn.UpdateCrossAccountAttachmentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Global Accelerator Cross-account Attachment (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating Global Accelerator Cross-account Attachment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceCrossAccountAttachmentRead(ctx, d, meta)
+}
+
+func resourceCrossAccountAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Cross-account Attachment: %s", d.Id())
+	_, err := conn.DeleteCrossAccountAttachmentWithContext(ctx, &globalaccelerator.DeleteCrossAccountAttachmentInput{
+		AttachmentArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeAttachmentNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Global Accelerator Cross-account Attachment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandResource(tfMap map[string]interface{}) *globalaccelerator.Resource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &globalaccelerator.Resource{}
+
+	if v, ok := tfMap["cidr"].(string); ok && v != "" {
+		apiObject.Cidr = aws.String(v)
+	}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandResources(tfList []interface{}) []*globalaccelerator.Resource {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*globalaccelerator.Resource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandResource(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenResource(apiObject *globalaccelerator.Resource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Cidr; v != nil {
+		tfMap["cidr"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EndpointId; v != nil {
+		tfMap["endpoint_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["region"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenResources(apiObjects []*globalaccelerator.Resource) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenResource(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,167 @@
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomRoutingEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomRoutingEndpointCreate,
+		ReadWithoutTimeout:   resourceCustomRoutingEndpointRead,
+		DeleteWithoutTimeout: resourceCustomRoutingEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	endpointGroupARN := d.Get("endpoint_group_arn").(string)
+	endpointID := d.Get("endpoint_id").(string)
+	id := CustomRoutingEndpointCreateResourceID(endpointGroupARN, endpointID)
+	input := &globalaccelerator.AddCustomRoutingEndpointsInput{
+		EndpointConfigurations: []*globalaccelerator.CustomRoutingEndpointConfiguration{{
+			EndpointId: aws.String(endpointID),
+		}},
+		EndpointGroupArn: aws.String(endpointGroupARN),
+	}
+
+	_, err := conn.AddCustomRoutingEndpointsWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Global Accelerator Custom Routing Endpoint (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	return resourceCustomRoutingEndpointRead(ctx, d, meta)
+}
+
+func resourceCustomRoutingEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	endpointGroupARN, endpointID, err := CustomRoutingEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = FindCustomRoutingEndpointByTwoPartKey(ctx, conn, endpointGroupARN, endpointID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Custom Routing Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Global Accelerator Custom Routing Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("endpoint_group_arn", endpointGroupARN)
+	d.Set("endpoint_id", endpointID)
+
+	return nil
+}
+
+func resourceCustomRoutingEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	endpointGroupARN, endpointID, err := CustomRoutingEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Custom Routing Endpoint: %s", d.Id())
+	_, err = conn.RemoveCustomRoutingEndpointsWithContext(ctx, &globalaccelerator.RemoveCustomRoutingEndpointsInput{
+		EndpointGroupArn: aws.String(endpointGroupARN),
+		EndpointIds:      aws.StringSlice([]string{endpointID}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException, globalaccelerator.ErrCodeEndpointNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Global Accelerator Custom Routing Endpoint (%s): %s", d.Id(), err)
+	}
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	return nil
+}
+
+const customRoutingEndpointResourceIDSeparator = ","
+
+func CustomRoutingEndpointCreateResourceID(endpointGroupARN, endpointID string) string {
+	parts := []string{endpointGroupARN, endpointID}
+	id := strings.Join(parts, customRoutingEndpointResourceIDSeparator)
+
+	return id
+}
+
+func CustomRoutingEndpointParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, customRoutingEndpointResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EndpointGroupARN%[2]sEndpointID", id, customRoutingEndpointResourceIDSeparator)
+}
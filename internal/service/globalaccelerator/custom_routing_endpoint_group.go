@@ -0,0 +1,437 @@
+package globalaccelerator
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomRoutingEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomRoutingEndpointGroupCreate,
+		ReadWithoutTimeout:   resourceCustomRoutingEndpointGroupRead,
+		UpdateWithoutTimeout: resourceCustomRoutingEndpointGroupUpdate,
+		DeleteWithoutTimeout: resourceCustomRoutingEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_configuration": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"protocols": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(globalaccelerator.CustomRoutingProtocol_Values(), false),
+							},
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"endpoint_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"endpoint_group_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	input := &globalaccelerator.CreateCustomRoutingEndpointGroupInput{
+		DestinationConfigurations: expandCustomRoutingDestinationConfigurations(d.Get("destination_configuration").(*schema.Set).List()),
+		EndpointGroupRegion:       aws.String(meta.(*conns.AWSClient).Region),
+		IdempotencyToken:          aws.String(resource.UniqueId()),
+		ListenerArn:               aws.String(d.Get("listener_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("endpoint_group_region"); ok {
+		input.EndpointGroupRegion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateCustomRoutingEndpointGroupWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Global Accelerator Custom Routing Endpoint Group: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.EndpointGroup.EndpointGroupArn))
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	if v, ok := d.GetOk("endpoint_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		input := &globalaccelerator.AddCustomRoutingEndpointsInput{
+			EndpointConfigurations: expandCustomRoutingEndpointConfigurations(v.(*schema.Set).List()),
+			EndpointGroupArn:       aws.String(d.Id()),
+		}
+
+		_, err := conn.AddCustomRoutingEndpointsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), err)
+		}
+
+		if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+		}
+	}
+
+	return resourceCustomRoutingEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceCustomRoutingEndpointGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	endpointGroup, err := FindCustomRoutingEndpointGroupByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Custom Routing Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Global Accelerator Custom Routing Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	listenerARN, err := EndpointGroupARNToListenerARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("arn", endpointGroup.EndpointGroupArn)
+	if err := d.Set("destination_configuration", flattenCustomRoutingDestinationDescriptions(endpointGroup.DestinationDescriptions)); err != nil {
+		return diag.Errorf("setting destination_configuration: %s", err)
+	}
+	if err := d.Set("endpoint_configuration", flattenCustomRoutingEndpointDescriptions(endpointGroup.EndpointDescriptions)); err != nil {
+		return diag.Errorf("setting endpoint_configuration: %s", err)
+	}
+	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
+	d.Set("listener_arn", listenerARN)
+
+	return nil
+}
+
+func resourceCustomRoutingEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	if d.HasChange("endpoint_configuration") {
+		acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		o, n := d.GetChange("endpoint_configuration")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			var endpointIDs []string
+
+			for _, apiObject := range expandCustomRoutingEndpointConfigurations(del) {
+				endpointIDs = append(endpointIDs, aws.StringValue(apiObject.EndpointId))
+			}
+
+			input := &globalaccelerator.RemoveCustomRoutingEndpointsInput{
+				EndpointGroupArn: aws.String(d.Id()),
+				EndpointIds:      aws.StringSlice(endpointIDs),
+			}
+
+			_, err := conn.RemoveCustomRoutingEndpointsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("removing Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), err)
+			}
+
+			if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			input := &globalaccelerator.AddCustomRoutingEndpointsInput{
+				EndpointConfigurations: expandCustomRoutingEndpointConfigurations(add),
+				EndpointGroupArn:       aws.String(d.Id()),
+			}
+
+			_, err := conn.AddCustomRoutingEndpointsWithContext(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), err)
+			}
+
+			if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+			}
+		}
+	}
+
+	return resourceCustomRoutingEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceCustomRoutingEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Custom Routing Endpoint Group: %s", d.Id())
+	_, err := conn.DeleteCustomRoutingEndpointGroupWithContext(ctx, &globalaccelerator.DeleteCustomRoutingEndpointGroupInput{
+		EndpointGroupArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Global Accelerator Custom Routing Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	return nil
+}
+
+func expandCustomRoutingDestinationConfiguration(tfMap map[string]interface{}) *globalaccelerator.CustomRoutingDestinationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &globalaccelerator.CustomRoutingDestinationConfiguration{}
+
+	if v, ok := tfMap["from_port"].(int); ok && v != 0 {
+		apiObject.FromPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["protocols"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Protocols = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["to_port"].(int); ok && v != 0 {
+		apiObject.ToPort = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandCustomRoutingDestinationConfigurations(tfList []interface{}) []*globalaccelerator.CustomRoutingDestinationConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*globalaccelerator.CustomRoutingDestinationConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCustomRoutingDestinationConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCustomRoutingEndpointConfiguration(tfMap map[string]interface{}) *globalaccelerator.CustomRoutingEndpointConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &globalaccelerator.CustomRoutingEndpointConfiguration{}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCustomRoutingEndpointConfigurations(tfList []interface{}) []*globalaccelerator.CustomRoutingEndpointConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*globalaccelerator.CustomRoutingEndpointConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandCustomRoutingEndpointConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenCustomRoutingDestinationDescription(apiObject *globalaccelerator.CustomRoutingDestinationDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.FromPort; v != nil {
+		tfMap["from_port"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Protocols; v != nil {
+		tfMap["protocols"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ToPort; v != nil {
+		tfMap["to_port"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenCustomRoutingDestinationDescriptions(apiObjects []*globalaccelerator.CustomRoutingDestinationDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCustomRoutingDestinationDescription(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenCustomRoutingEndpointDescription(apiObject *globalaccelerator.CustomRoutingEndpointDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EndpointId; v != nil {
+		tfMap["endpoint_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenCustomRoutingEndpointDescriptions(apiObjects []*globalaccelerator.CustomRoutingEndpointDescription) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenCustomRoutingEndpointDescription(apiObject))
+	}
+
+	return tfList
+}
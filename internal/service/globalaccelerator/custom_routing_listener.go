@@ -0,0 +1,167 @@
+package globalaccelerator
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCustomRoutingListener() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomRoutingListenerCreate,
+		ReadWithoutTimeout:   resourceCustomRoutingListenerRead,
+		UpdateWithoutTimeout: resourceCustomRoutingListenerUpdate,
+		DeleteWithoutTimeout: resourceCustomRoutingListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accelerator_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port_range": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+	acceleratorARN := d.Get("accelerator_arn").(string)
+
+	input := &globalaccelerator.CreateCustomRoutingListenerInput{
+		AcceleratorArn:   aws.String(acceleratorARN),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		PortRanges:       expandPortRanges(d.Get("port_range").(*schema.Set).List()),
+	}
+
+	output, err := conn.CreateCustomRoutingListenerWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Global Accelerator Custom Routing Listener: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Listener.ListenerArn))
+
+	// Creating a listener triggers the accelerator to change status to InPending.
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	return resourceCustomRoutingListenerRead(ctx, d, meta)
+}
+
+func resourceCustomRoutingListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	listener, err := FindCustomRoutingListenerByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Custom Routing Listener (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Global Accelerator Custom Routing Listener (%s): %s", d.Id(), err)
+	}
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("accelerator_arn", acceleratorARN)
+	if err := d.Set("port_range", flattenPortRanges(listener.PortRanges)); err != nil {
+		return diag.Errorf("setting port_range: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCustomRoutingListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+	acceleratorARN := d.Get("accelerator_arn").(string)
+
+	input := &globalaccelerator.UpdateCustomRoutingListenerInput{
+		ListenerArn: aws.String(d.Id()),
+		PortRanges:  expandPortRanges(d.Get("port_range").(*schema.Set).List()),
+	}
+
+	_, err := conn.UpdateCustomRoutingListenerWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Global Accelerator Custom Routing Listener (%s): %s", d.Id(), err)
+	}
+
+	// Updating a listener triggers the accelerator to change status to InPending.
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	return resourceCustomRoutingListenerRead(ctx, d, meta)
+}
+
+func resourceCustomRoutingListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+	acceleratorARN := d.Get("accelerator_arn").(string)
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Custom Routing Listener: %s", d.Id())
+	_, err := conn.DeleteCustomRoutingListenerWithContext(ctx, &globalaccelerator.DeleteCustomRoutingListenerInput{
+		ListenerArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeListenerNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Global Accelerator Custom Routing Listener (%s): %s", d.Id(), err)
+	}
+
+	// Deleting a listener triggers the accelerator to change status to InPending.
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %s", acceleratorARN, err)
+	}
+
+	return nil
+}
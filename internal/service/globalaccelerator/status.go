@@ -24,3 +24,19 @@ func statusAccelerator(ctx context.Context, conn *globalaccelerator.GlobalAccele
 		return accelerator, aws.StringValue(accelerator.Status), nil
 	}
 }
+
+func statusCustomRoutingAccelerator(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		accelerator, err := FindCustomRoutingAcceleratorByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return accelerator, aws.StringValue(accelerator.Status), nil
+	}
+}
@@ -24,3 +24,20 @@ func waitAcceleratorDeployed(ctx context.Context, conn *globalaccelerator.Global
 
 	return nil, err
 }
+
+func waitCustomRoutingAcceleratorDeployed(ctx context.Context, conn *globalaccelerator.GlobalAccelerator, arn string, timeout time.Duration) (*globalaccelerator.CustomRoutingAccelerator, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalaccelerator.CustomRoutingAcceleratorStatusInProgress},
+		Target:  []string{globalaccelerator.CustomRoutingAcceleratorStatusDeployed},
+		Refresh: statusCustomRoutingAccelerator(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*globalaccelerator.CustomRoutingAccelerator); ok {
+		return output, err
+	}
+
+	return nil, err
+}
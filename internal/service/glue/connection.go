@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -30,7 +31,10 @@ func ResourceConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceConnectionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -231,7 +235,7 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	return diags
+	return append(diags, resourceConnectionRead(ctx, d, meta)...)
 }
 
 func resourceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -252,6 +256,53 @@ func resourceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// resourceConnectionCustomizeDiff validates that the connection properties required by the connection type are configured.
+func resourceConnectionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	connectionType := diff.Get("connection_type").(string)
+
+	if connectionType == glue.ConnectionTypeNetwork {
+		if v := diff.GetRawConfig().GetAttr("physical_connection_requirements"); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+			return fmt.Errorf("physical_connection_requirements must be configured for %s connections", connectionType)
+		}
+
+		return nil
+	}
+
+	v := diff.GetRawConfig().GetAttr("connection_properties")
+
+	if !v.IsKnown() {
+		return nil
+	}
+
+	properties := make(map[string]bool)
+	if !v.IsNull() {
+		for k := range v.AsValueMap() {
+			properties[k] = true
+		}
+	}
+
+	var required string
+
+	// The properties of MARKETPLACE and CUSTOM connections are defined by the connector
+	// and are not validated by the Glue API, e.g. JDBC connectors use JDBC_CONNECTION_URL.
+	switch connectionType {
+	case glue.ConnectionTypeJdbc:
+		if !properties[glue.ConnectionPropertyKeyJdbcConnectionUrl] && !(properties[glue.ConnectionPropertyKeyHost] && properties[glue.ConnectionPropertyKeyPort] && properties[glue.ConnectionPropertyKeyJdbcEngine]) {
+			return fmt.Errorf("connection_properties must contain %s, or all of %s, %s and %s, for %s connections", glue.ConnectionPropertyKeyJdbcConnectionUrl, glue.ConnectionPropertyKeyHost, glue.ConnectionPropertyKeyPort, glue.ConnectionPropertyKeyJdbcEngine, connectionType)
+		}
+	case glue.ConnectionTypeKafka:
+		required = glue.ConnectionPropertyKeyKafkaBootstrapServers
+	case glue.ConnectionTypeMongodb:
+		required = glue.ConnectionPropertyKeyConnectionUrl
+	}
+
+	if required != "" && !properties[required] {
+		return fmt.Errorf("connection_properties must contain %s for %s connections", required, connectionType)
+	}
+
+	return nil
+}
+
 func DecodeConnectionID(id string) (string, string, error) {
 	idParts := strings.Split(id, ":")
 	if len(idParts) != 2 {
@@ -299,10 +350,8 @@ func expandConnectionInput(d *schema.ResourceData) *glue.ConnectionInput {
 		connectionInput.MatchCriteria = flex.ExpandStringList(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("physical_connection_requirements"); ok {
-		physicalConnectionRequirementsList := v.([]interface{})
-		physicalConnectionRequirementsMap := physicalConnectionRequirementsList[0].(map[string]interface{})
-		connectionInput.PhysicalConnectionRequirements = expandPhysicalConnectionRequirements(physicalConnectionRequirementsMap)
+	if v, ok := d.GetOk("physical_connection_requirements"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		connectionInput.PhysicalConnectionRequirements = expandPhysicalConnectionRequirements(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	return connectionInput
@@ -311,16 +360,16 @@ func expandConnectionInput(d *schema.ResourceData) *glue.ConnectionInput {
 func expandPhysicalConnectionRequirements(m map[string]interface{}) *glue.PhysicalConnectionRequirements {
 	physicalConnectionRequirements := &glue.PhysicalConnectionRequirements{}
 
-	if v, ok := m["availability_zone"]; ok {
-		physicalConnectionRequirements.AvailabilityZone = aws.String(v.(string))
+	if v, ok := m["availability_zone"].(string); ok && v != "" {
+		physicalConnectionRequirements.AvailabilityZone = aws.String(v)
 	}
 
-	if v, ok := m["security_group_id_list"]; ok {
-		physicalConnectionRequirements.SecurityGroupIdList = flex.ExpandStringSet(v.(*schema.Set))
+	if v, ok := m["security_group_id_list"].(*schema.Set); ok && v.Len() > 0 {
+		physicalConnectionRequirements.SecurityGroupIdList = flex.ExpandStringSet(v)
 	}
 
-	if v, ok := m["subnet_id"]; ok {
-		physicalConnectionRequirements.SubnetId = aws.String(v.(string))
+	if v, ok := m["subnet_id"].(string); ok && v != "" {
+		physicalConnectionRequirements.SubnetId = aws.String(v)
 	}
 
 	return physicalConnectionRequirements
@@ -331,6 +380,11 @@ func flattenPhysicalConnectionRequirements(physicalConnectionRequirements *glue.
 		return []map[string]interface{}{}
 	}
 
+	// The API returns an empty structure for connections that were created without physical connection requirements.
+	if aws.StringValue(physicalConnectionRequirements.AvailabilityZone) == "" && len(physicalConnectionRequirements.SecurityGroupIdList) == 0 && aws.StringValue(physicalConnectionRequirements.SubnetId) == "" {
+		return []map[string]interface{}{}
+	}
+
 	m := map[string]interface{}{
 		"availability_zone":      aws.StringValue(physicalConnectionRequirements.AvailabilityZone),
 		"security_group_id_list": flex.FlattenStringSet(physicalConnectionRequirements.SecurityGroupIdList),
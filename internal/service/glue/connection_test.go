@@ -3,6 +3,7 @@ package glue_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/glue"
@@ -174,6 +175,48 @@ func TestAccGlueConnection_kafka(t *testing.T) {
 	})
 }
 
+func TestAccGlueConnection_jdbcConnector(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connection glue.Connection
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_connection.test"
+
+	jdbcConnectionUrl := fmt.Sprintf("jdbc:postgresql://%s:5432/testdatabase", acctest.RandomDomainName())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_jdbcConnector(rName, glue.ConnectionTypeCustom, jdbcConnectionUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.JDBC_CONNECTION_URL", jdbcConnectionUrl),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "CUSTOM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectionConfig_jdbcConnector(rName, glue.ConnectionTypeMarketplace, jdbcConnectionUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &connection),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "connection_properties.JDBC_CONNECTION_URL", jdbcConnectionUrl),
+					resource.TestCheckResourceAttr(resourceName, "connection_type", "MARKETPLACE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueConnection_network(t *testing.T) {
 	ctx := acctest.Context(t)
 	var connection glue.Connection
@@ -338,6 +381,32 @@ func TestAccGlueConnection_physicalConnectionRequirements(t *testing.T) {
 	})
 }
 
+func TestAccGlueConnection_connectionPropertiesValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, glue.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConnectionConfig_connectionProperties(rName, glue.ConnectionTypeJdbc, "USERNAME", "testusername"),
+				ExpectError: regexp.MustCompile(`must contain JDBC_CONNECTION_URL`),
+			},
+			{
+				Config:      testAccConnectionConfig_connectionProperties(rName, glue.ConnectionTypeKafka, "KAFKA_SSL_ENABLED", "true"),
+				ExpectError: regexp.MustCompile(`must contain KAFKA_BOOTSTRAP_SERVERS`),
+			},
+			{
+				Config:      testAccConnectionConfig_connectionProperties(rName, glue.ConnectionTypeNetwork, "KAFKA_SSL_ENABLED", "true"),
+				ExpectError: regexp.MustCompile(`physical_connection_requirements must be configured`),
+			},
+		},
+	})
+}
+
 func TestAccGlueConnection_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var connection glue.Connection
@@ -653,6 +722,35 @@ resource "aws_glue_connection" "test" {
 `, rName, bootstrapServers)
 }
 
+func testAccConnectionConfig_jdbcConnector(rName, connectionType, jdbcConnectionUrl string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_connection" "test" {
+  name = %[1]q
+
+  connection_type = %[2]q
+  connection_properties = {
+    CONNECTOR_CLASS_NAME = "org.postgresql.Driver"
+    CONNECTOR_TYPE       = "Jdbc"
+    CONNECTOR_URL        = "s3://%[1]s/postgresql.jar"
+    JDBC_CONNECTION_URL  = %[3]q
+  }
+}
+`, rName, connectionType, jdbcConnectionUrl)
+}
+
+func testAccConnectionConfig_connectionProperties(rName, connectionType, propertyKey, propertyValue string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_connection" "test" {
+  name = %[1]q
+
+  connection_type = %[2]q
+  connection_properties = {
+    %[3]s = %[4]q
+  }
+}
+`, rName, connectionType, propertyKey, propertyValue)
+}
+
 func testAccConnectionConfig_network(rName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
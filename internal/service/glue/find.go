@@ -287,3 +287,28 @@ func FindCrawlerByName(ctx context.Context, conn *glue.Glue, name string) (*glue
 
 	return output.Crawler, nil
 }
+
+func FindUsageProfileByName(ctx context.Context, conn *glue.Glue, name string) (*glue.GetUsageProfileOutput, error) {
+	input := &glue.GetUsageProfileInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetUsageProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
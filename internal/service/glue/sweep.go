@@ -73,6 +73,11 @@ func init() {
 		F:    sweepTriggers,
 	})
 
+	resource.AddTestSweepers("aws_glue_usage_profile", &resource.Sweeper{
+		Name: "aws_glue_usage_profile",
+		F:    sweepUsageProfiles,
+	})
+
 	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
 		Name: "aws_glue_workflow",
 		F:    sweepWorkflow,
@@ -594,3 +599,47 @@ func sweepWorkflow(region string) error {
 	}
 	return nil
 }
+
+func sweepUsageProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GlueConn()
+	input := &glue.ListUsageProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListUsageProfilesPagesWithContext(ctx, input, func(page *glue.ListUsageProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Profiles {
+			r := ResourceUsageProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Glue Usage Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Glue Usage Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Glue Usage Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,338 @@
+package glue
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceUsageProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsageProfileCreate,
+		ReadWithoutTimeout:   resourceUsageProfileRead,
+		UpdateWithoutTimeout: resourceUsageProfileUpdate,
+		DeleteWithoutTimeout: resourceUsageProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"job_configuration":     usageProfileConfigurationObjectSchema(),
+						"session_configuration": usageProfileConfigurationObjectSchema(),
+					},
+				},
+			},
+			"created_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"last_modified_on": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func usageProfileConfigurationObjectSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_values": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"default_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"max_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"min_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceUsageProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &glue.CreateUsageProfileInput{
+		Configuration: expandProfileConfiguration(d.Get("configuration").([]interface{})),
+		Name:          aws.String(name),
+		Tags:          Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateUsageProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glue Usage Profile (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceUsageProfileRead(ctx, d, meta)...)
+}
+
+func resourceUsageProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindUsageProfileByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Glue Usage Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glue Usage Profile (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "glue",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("usageProfile/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("configuration", flattenProfileConfiguration(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	if output.CreatedOn != nil {
+		d.Set("created_on", aws.TimeValue(output.CreatedOn).Format(time.RFC3339))
+	} else {
+		d.Set("created_on", nil)
+	}
+	d.Set("description", output.Description)
+	if output.LastModifiedOn != nil {
+		d.Set("last_modified_on", aws.TimeValue(output.LastModifiedOn).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_on", nil)
+	}
+	d.Set("name", output.Name)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Glue Usage Profile (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceUsageProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+
+	if d.HasChanges("configuration", "description") {
+		input := &glue.UpdateUsageProfileInput{
+			Configuration: expandProfileConfiguration(d.Get("configuration").([]interface{})),
+			Name:          aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateUsageProfileWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Glue Usage Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags: %s", err)
+		}
+	}
+
+	return append(diags, resourceUsageProfileRead(ctx, d, meta)...)
+}
+
+func resourceUsageProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlueConn()
+
+	log.Printf("[DEBUG] Deleting Glue Usage Profile: %s", d.Id())
+	_, err := conn.DeleteUsageProfileWithContext(ctx, &glue.DeleteUsageProfileInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Glue Usage Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandProfileConfiguration(tfList []interface{}) *glue.ProfileConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &glue.ProfileConfiguration{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &glue.ProfileConfiguration{}
+
+	if v, ok := tfMap["job_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.JobConfiguration = expandConfigurationObjects(v.List())
+	}
+
+	if v, ok := tfMap["session_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SessionConfiguration = expandConfigurationObjects(v.List())
+	}
+
+	return apiObject
+}
+
+func expandConfigurationObjects(tfList []interface{}) map[string]*glue.ConfigurationObject {
+	apiObjects := make(map[string]*glue.ConfigurationObject)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &glue.ConfigurationObject{}
+
+		if v, ok := tfMap["allowed_values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.AllowedValues = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["default_value"].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["max_value"].(string); ok && v != "" {
+			apiObject.MaxValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["min_value"].(string); ok && v != "" {
+			apiObject.MinValue = aws.String(v)
+		}
+
+		apiObjects[tfMap["key"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenProfileConfiguration(apiObject *glue.ProfileConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"job_configuration":     flattenConfigurationObjects(apiObject.JobConfiguration),
+		"session_configuration": flattenConfigurationObjects(apiObject.SessionConfiguration),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenConfigurationObjects(apiObjects map[string]*glue.ConfigurationObject) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"allowed_values": aws.StringValueSlice(apiObject.AllowedValues),
+			"default_value":  aws.StringValue(apiObject.DefaultValue),
+			"key":            k,
+			"max_value":      aws.StringValue(apiObject.MaxValue),
+			"min_value":      aws.StringValue(apiObject.MinValue),
+		})
+	}
+
+	return tfList
+}
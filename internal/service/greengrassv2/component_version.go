@@ -0,0 +1,591 @@
+package greengrassv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/greengrassv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentVersionCreate,
+		ReadWithoutTimeout:   resourceComponentVersionRead,
+		UpdateWithoutTimeout: resourceComponentVersionUpdate,
+		DeleteWithoutTimeout: resourceComponentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+				ExactlyOneOf:     []string{"inline_recipe", "lambda_function"},
+			},
+			"lambda_function": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_dependency": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"component_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"dependency_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.ComponentDependencyType_Values(), false),
+									},
+									"version_requirement": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_lambda_parameters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"environment_variables": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"event_source": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"topic": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringInSlice(greengrassv2.LambdaEventSourceType_Values(), false),
+												},
+											},
+										},
+									},
+									"exec_args": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"input_payload_encoding_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(greengrassv2.LambdaInputPayloadEncodingType_Values(), false),
+									},
+									"max_idle_time_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_instances_count": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_queue_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"pinned": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"status_timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"timeout_in_seconds": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"component_platform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attributes": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"component_version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"lambda_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"inline_recipe", "lambda_function"},
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceComponentVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &greengrassv2.CreateComponentVersionInput{}
+
+	if v, ok := d.GetOk("inline_recipe"); ok {
+		input.InlineRecipe = []byte(v.(string))
+	}
+
+	if v, ok := d.GetOk("lambda_function"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LambdaFunction = expandLambdaFunctionRecipeSource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateComponentVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Component Version: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	if _, err := waitComponentVersionDeployable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Greengrass V2 Component Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindComponentVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", output.ComponentVersion)
+	d.Set("description", output.Description)
+	d.Set("publisher", output.Publisher)
+	if output.Status != nil {
+		d.Set("status", output.Status.ComponentState)
+	} else {
+		d.Set("status", nil)
+	}
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceComponentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Greengrass V2 Component Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Conn()
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponentWithContext(ctx, &greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindComponentVersionByARN(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) (*greengrassv2.DescribeComponentOutput, error) {
+	input := &greengrassv2.DescribeComponentInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeComponentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, greengrassv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusComponentVersion(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.ComponentState), nil
+	}
+}
+
+func waitComponentVersionDeployable(ctx context.Context, conn *greengrassv2.GreengrassV2, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{greengrassv2.CloudComponentStateRequested, greengrassv2.CloudComponentStateInitiated},
+		Target:  []string{greengrassv2.CloudComponentStateDeployable},
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		tfresource.SetLastError(err, componentStatusError(output.Status))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func componentStatusError(apiObject *greengrassv2.CloudComponentStatus) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errs []string
+
+	if v := aws.StringValue(apiObject.Message); v != "" {
+		errs = append(errs, v)
+	}
+
+	keys := make([]string, 0, len(apiObject.Errors))
+	for k := range apiObject.Errors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		errs = append(errs, fmt.Sprintf("%s: %s", k, aws.StringValue(apiObject.Errors[k])))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
+
+func expandLambdaFunctionRecipeSource(tfMap map[string]interface{}) *greengrassv2.LambdaFunctionRecipeSource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaFunctionRecipeSource{
+		LambdaArn: aws.String(tfMap["lambda_arn"].(string)),
+	}
+
+	if v, ok := tfMap["component_dependency"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComponentDependencies = expandComponentDependencyRequirements(v.List())
+	}
+
+	if v, ok := tfMap["component_lambda_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ComponentLambdaParameters = expandLambdaExecutionParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["component_name"].(string); ok && v != "" {
+		apiObject.ComponentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["component_platform"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ComponentPlatforms = expandComponentPlatforms(v)
+	}
+
+	if v, ok := tfMap["component_version"].(string); ok && v != "" {
+		apiObject.ComponentVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandComponentDependencyRequirements(tfList []interface{}) map[string]*greengrassv2.ComponentDependencyRequirement {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*greengrassv2.ComponentDependencyRequirement)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentDependencyRequirement{}
+
+		if v, ok := tfMap["dependency_type"].(string); ok && v != "" {
+			apiObject.DependencyType = aws.String(v)
+		}
+
+		if v, ok := tfMap["version_requirement"].(string); ok && v != "" {
+			apiObject.VersionRequirement = aws.String(v)
+		}
+
+		apiObjects[tfMap["component_name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandLambdaExecutionParameters(tfMap map[string]interface{}) *greengrassv2.LambdaExecutionParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &greengrassv2.LambdaExecutionParameters{}
+
+	if v, ok := tfMap["environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.EnvironmentVariables = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["event_source"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.EventSources = append(apiObject.EventSources, &greengrassv2.LambdaEventSource{
+				Topic: aws.String(tfMap["topic"].(string)),
+				Type:  aws.String(tfMap["type"].(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["exec_args"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ExecArgs = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["input_payload_encoding_type"].(string); ok && v != "" {
+		apiObject.InputPayloadEncodingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["max_idle_time_in_seconds"].(int); ok && v != 0 {
+		apiObject.MaxIdleTimeInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_instances_count"].(int); ok && v != 0 {
+		apiObject.MaxInstancesCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_queue_size"].(int); ok && v != 0 {
+		apiObject.MaxQueueSize = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pinned"].(bool); ok {
+		apiObject.Pinned = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["status_timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.StatusTimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout_in_seconds"].(int); ok && v != 0 {
+		apiObject.TimeoutInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandComponentPlatforms(tfList []interface{}) []*greengrassv2.ComponentPlatform {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*greengrassv2.ComponentPlatform
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &greengrassv2.ComponentPlatform{}
+
+		if v, ok := tfMap["attributes"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Attributes = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
@@ -0,0 +1,411 @@
+package healthlake
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceFHIRDatastore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFHIRDatastoreCreate,
+		ReadWithoutTimeout:   resourceFHIRDatastoreRead,
+		UpdateWithoutTimeout: resourceFHIRDatastoreUpdate,
+		DeleteWithoutTimeout: resourceFHIRDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"datastore_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_type_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(healthlake.FHIRVersion_Values(), false),
+			},
+			"identity_provider_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorization_strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(healthlake.AuthorizationStrategy_Values(), false),
+						},
+						"fine_grained_authorization_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"idp_lambda_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"metadata": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+						},
+					},
+				},
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(healthlake.PreloadDataType_Values(), false),
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(healthlake.CmkType_Values(), false),
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameFHIRDatastore = "FHIR Datastore"
+)
+
+func resourceFHIRDatastoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn()
+
+	in := &healthlake.CreateFHIRDatastoreInput{
+		DatastoreTypeVersion: aws.String(d.Get("datastore_type_version").(string)),
+	}
+
+	if v, ok := d.GetOk("datastore_name"); ok {
+		in.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("identity_provider_configuration"); ok {
+		in.IdentityProviderConfiguration = expandIdentityProviderConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("preload_data_config"); ok {
+		in.PreloadDataConfig = expandPreloadDataConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok {
+		in.SseConfiguration = expandSseConfiguration(v.([]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateFHIRDatastoreWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionCreating, ResNameFHIRDatastore, d.Get("datastore_name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(out.DatastoreId))
+
+	if _, err := waitFHIRDatastoreCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionWaitingForCreation, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	return resourceFHIRDatastoreRead(ctx, d, meta)
+}
+
+func resourceFHIRDatastoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn()
+
+	out, err := FindFHIRDatastoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake FHIR Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionReading, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	if out.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(out.CreatedAt).Format(time.RFC3339))
+	}
+	d.Set("datastore_arn", out.DatastoreArn)
+	d.Set("datastore_endpoint", out.DatastoreEndpoint)
+	d.Set("datastore_id", out.DatastoreId)
+	d.Set("datastore_name", out.DatastoreName)
+	d.Set("datastore_status", out.DatastoreStatus)
+	d.Set("datastore_type_version", out.DatastoreTypeVersion)
+
+	if err := d.Set("identity_provider_configuration", flattenIdentityProviderConfiguration(out.IdentityProviderConfiguration)); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	if err := d.Set("preload_data_config", flattenPreloadDataConfig(out.PreloadDataConfig)); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	if err := d.Set("sse_configuration", flattenSseConfiguration(out.SseConfiguration)); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.DatastoreArn))
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionReading, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionSetting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceFHIRDatastoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("datastore_arn").(string), o, n); err != nil {
+			return create.DiagError(names.HealthLake, create.ErrActionUpdating, ResNameFHIRDatastore, d.Id(), err)
+		}
+	}
+
+	return resourceFHIRDatastoreRead(ctx, d, meta)
+}
+
+func resourceFHIRDatastoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).HealthLakeConn()
+
+	log.Printf("[INFO] Deleting HealthLake FHIR Datastore %s", d.Id())
+
+	_, err := conn.DeleteFHIRDatastoreWithContext(ctx, &healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionDeleting, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	if _, err := waitFHIRDatastoreDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.HealthLake, create.ErrActionWaitingForDeletion, ResNameFHIRDatastore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIdentityProviderConfiguration(tfList []interface{}) *healthlake.IdentityProviderConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &healthlake.IdentityProviderConfiguration{
+		AuthorizationStrategy:           aws.String(tfMap["authorization_strategy"].(string)),
+		FineGrainedAuthorizationEnabled: aws.Bool(tfMap["fine_grained_authorization_enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["idp_lambda_arn"].(string); ok && v != "" {
+		apiObject.IdpLambdaArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata"].(string); ok && v != "" {
+		apiObject.Metadata = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPreloadDataConfig(tfList []interface{}) *healthlake.PreloadDataConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &healthlake.PreloadDataConfig{
+		PreloadDataType: aws.String(tfMap["preload_data_type"].(string)),
+	}
+}
+
+func expandSseConfiguration(tfList []interface{}) *healthlake.SseConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &healthlake.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		kmsEncryptionConfig := &healthlake.KmsEncryptionConfig{
+			CmkType: aws.String(tfMap["cmk_type"].(string)),
+		}
+
+		if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+			kmsEncryptionConfig.KmsKeyId = aws.String(v)
+		}
+
+		apiObject.KmsEncryptionConfig = kmsEncryptionConfig
+	}
+
+	return apiObject
+}
+
+func flattenIdentityProviderConfiguration(apiObject *healthlake.IdentityProviderConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"authorization_strategy":             aws.StringValue(apiObject.AuthorizationStrategy),
+			"fine_grained_authorization_enabled": aws.BoolValue(apiObject.FineGrainedAuthorizationEnabled),
+			"idp_lambda_arn":                     aws.StringValue(apiObject.IdpLambdaArn),
+			"metadata":                           aws.StringValue(apiObject.Metadata),
+		},
+	}
+}
+
+func flattenPreloadDataConfig(apiObject *healthlake.PreloadDataConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"preload_data_type": aws.StringValue(apiObject.PreloadDataType),
+		},
+	}
+}
+
+func flattenSseConfiguration(apiObject *healthlake.SseConfiguration) []interface{} {
+	if apiObject == nil || apiObject.KmsEncryptionConfig == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"kms_encryption_config": []interface{}{
+				map[string]interface{}{
+					"cmk_type":   aws.StringValue(apiObject.KmsEncryptionConfig.CmkType),
+					"kms_key_id": aws.StringValue(apiObject.KmsEncryptionConfig.KmsKeyId),
+				},
+			},
+		},
+	}
+}
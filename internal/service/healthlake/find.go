@@ -0,0 +1,41 @@
+package healthlake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindFHIRDatastoreByID(ctx context.Context, conn *healthlake.HealthLake, id string) (*healthlake.DatastoreProperties, error) {
+	in := &healthlake.DescribeFHIRDatastoreInput{
+		DatastoreId: aws.String(id),
+	}
+	out, err := conn.DescribeFHIRDatastoreWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.DatastoreProperties == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if state := aws.StringValue(out.DatastoreProperties.DatastoreStatus); state == healthlake.DatastoreStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: in,
+		}
+	}
+
+	return out.DatastoreProperties, nil
+}
@@ -0,0 +1,26 @@
+package healthlake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusFHIRDatastore(ctx context.Context, conn *healthlake.HealthLake, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindFHIRDatastoreByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.DatastoreStatus), nil
+	}
+}
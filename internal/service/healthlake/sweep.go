@@ -0,0 +1,70 @@
+//go:build sweep
+// +build sweep
+
+package healthlake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_healthlake_fhir_datastore", &resource.Sweeper{
+		Name: "aws_healthlake_fhir_datastore",
+		F:    sweepFHIRDatastores,
+	})
+}
+
+func sweepFHIRDatastores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).HealthLakeConn()
+	input := &healthlake.ListFHIRDatastoresInput{
+		Filter: &healthlake.DatastoreFilter{
+			DatastoreStatus: aws.String(healthlake.DatastoreStatusActive),
+		},
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFHIRDatastoresPagesWithContext(ctx, input, func(page *healthlake.ListFHIRDatastoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatastorePropertiesList {
+			r := ResourceFHIRDatastore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatastoreId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping HealthLake FHIR Datastore sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing HealthLake FHIR Datastores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping HealthLake FHIR Datastores (%s): %w", region, err)
+	}
+
+	return nil
+}
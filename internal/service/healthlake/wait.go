@@ -0,0 +1,50 @@
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitFHIRDatastoreCreated(ctx context.Context, conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusCreating},
+		Target:  []string{healthlake.DatastoreStatusActive},
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		if v := out.ErrorCause; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitFHIRDatastoreDeleted(ctx context.Context, conn *healthlake.HealthLake, id string, timeout time.Duration) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusActive, healthlake.DatastoreStatusDeleting},
+		Target:  []string{},
+		Refresh: statusFHIRDatastore(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return out, err
+	}
+
+	return nil, err
+}
@@ -2,12 +2,22 @@ package iam
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
 	"log"
+	"net"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -32,6 +42,10 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_thumbprint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"url": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -54,13 +68,17 @@ func ResourceOpenIDConnectProvider() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(40, 40),
 				},
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceOpenIDConnectProviderCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -76,6 +94,16 @@ func resourceOpenIDConnectProviderCreate(ctx context.Context, d *schema.Resource
 		ThumbprintList: flex.ExpandStringList(d.Get("thumbprint_list").([]interface{})),
 	}
 
+	if d.Get("auto_thumbprint").(bool) {
+		thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, d.Get("url").(string), nil)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM OIDC Provider: %s", err)
+		}
+
+		input.ThumbprintList = aws.StringSlice([]string{thumbprint})
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -156,12 +184,22 @@ func resourceOpenIDConnectProviderUpdate(ctx context.Context, d *schema.Resource
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMConn()
 
-	if d.HasChange("thumbprint_list") {
+	if d.HasChanges("auto_thumbprint", "thumbprint_list") {
 		input := &iam.UpdateOpenIDConnectProviderThumbprintInput{
 			OpenIDConnectProviderArn: aws.String(d.Id()),
 			ThumbprintList:           flex.ExpandStringList(d.Get("thumbprint_list").([]interface{})),
 		}
 
+		if d.Get("auto_thumbprint").(bool) {
+			thumbprint, err := findOpenIDConnectProviderThumbprint(ctx, d.Get("url").(string), nil)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IAM OIDC Provider (%s) thumbprint: %s", d.Id(), err)
+			}
+
+			input.ThumbprintList = aws.StringSlice([]string{thumbprint})
+		}
+
 		_, err := conn.UpdateOpenIDConnectProviderThumbprintWithContext(ctx, input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating IAM OIDC Provider (%s) thumbprint: %s", d.Id(), err)
@@ -204,3 +242,73 @@ func resourceOpenIDConnectProviderDelete(ctx context.Context, d *schema.Resource
 
 	return diags
 }
+
+func resourceOpenIDConnectProviderCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("auto_thumbprint").(bool) {
+		if diff.GetRawConfig().GetAttr("thumbprint_list").IsNull() {
+			return fmt.Errorf("thumbprint_list must be configured when auto_thumbprint is false")
+		}
+
+		return nil
+	}
+
+	if v := diff.GetRawConfig().GetAttr("thumbprint_list"); !v.IsNull() {
+		return fmt.Errorf("thumbprint_list cannot be configured when auto_thumbprint is true")
+	}
+
+	// The thumbprint is retrieved from the identity provider during Create and Update only, so that
+	// planning does not connect to it.
+	if diff.Id() == "" || diff.HasChange("auto_thumbprint") {
+		return diff.SetNewComputed("thumbprint_list")
+	}
+
+	return nil
+}
+
+// findOpenIDConnectProviderThumbprint returns the SHA-1 thumbprint of the top intermediate CA certificate
+// in the chain presented by the identity provider, as described in
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_create_oidc_verify-thumbprint.html.
+// The chain is verified against rootCAs, or the host's root CA set if rootCAs is nil.
+func findOpenIDConnectProviderThumbprint(ctx context.Context, providerURL string, rootCAs *x509.CertPool) (string, error) {
+	if !strings.Contains(providerURL, "://") {
+		providerURL = "https://" + providerURL
+	}
+
+	u, err := url.Parse(providerURL)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing OIDC provider URL (%s): %w", providerURL, err)
+	}
+
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: 30 * time.Second},
+		Config: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    rootCAs,
+			ServerName: u.Hostname(),
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+
+	if err != nil {
+		return "", fmt.Errorf("retrieving OIDC provider (%s) certificate chain: %w", host, err)
+	}
+
+	defer conn.Close()
+
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+
+	if len(certs) == 0 {
+		return "", fmt.Errorf("retrieving OIDC provider (%s) certificate chain: no certificates presented", host)
+	}
+
+	sum := sha1.Sum(certs[len(certs)-1].Raw) //nolint:gosec // IAM requires SHA-1 thumbprints
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -3,6 +3,7 @@ package iam_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -133,6 +134,40 @@ func TestAccIAMOpenIDConnectProvider_disappears(t *testing.T) {
 	})
 }
 
+func TestAccIAMOpenIDConnectProvider_autoThumbprint(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(5)
+	resourceName := "aws_iam_openid_connect_provider.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpenIDConnectProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpenIDConnectProviderConfig_autoThumbprint(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpenIDConnectProvider(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_thumbprint", "true"),
+					resource.TestCheckResourceAttr(resourceName, "thumbprint_list.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "thumbprint_list.0", regexp.MustCompile(`^[0-9a-f]{40}$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_thumbprint"},
+			},
+			{
+				Config:   testAccOpenIDConnectProviderConfig_autoThumbprint(rString),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckOpenIDConnectProviderDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
@@ -211,6 +246,20 @@ resource "aws_iam_openid_connect_provider" "test" {
 `, rString)
 }
 
+func testAccOpenIDConnectProviderConfig_autoThumbprint(rString string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_openid_connect_provider" "test" {
+  url = "https://token.actions.githubusercontent.com/%s"
+
+  client_id_list = [
+    "sts.amazonaws.com",
+  ]
+
+  auto_thumbprint = true
+}
+`, rString)
+}
+
 func testAccOpenIDConnectProviderConfig_tags1(rString, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_openid_connect_provider" "test" {
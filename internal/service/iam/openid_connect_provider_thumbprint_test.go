@@ -0,0 +1,162 @@
+package iam
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFindOpenIDConnectProviderThumbprint(t *testing.T) {
+	t.Parallel()
+
+	rootCert, rootKey := testOpenIDConnectProviderCertificate(t, true, nil, nil)
+	intermediateCert, intermediateKey := testOpenIDConnectProviderCertificate(t, true, rootCert, rootKey)
+	leafCert, leafKey := testOpenIDConnectProviderCertificate(t, false, intermediateCert, intermediateKey)
+	directLeafCert, directLeafKey := testOpenIDConnectProviderCertificate(t, false, rootCert, rootKey)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(rootCert)
+
+	testCases := map[string]struct {
+		chain    []*x509.Certificate
+		key      *ecdsa.PrivateKey
+		url      func(host string) string
+		expected *x509.Certificate
+	}{
+		"intermediate chain": {
+			chain:    []*x509.Certificate{leafCert, intermediateCert},
+			key:      leafKey,
+			url:      func(host string) string { return "https://" + host + "/oidc" },
+			expected: intermediateCert,
+		},
+		"leaf only": {
+			chain:    []*x509.Certificate{directLeafCert},
+			key:      directLeafKey,
+			url:      func(host string) string { return "https://" + host },
+			expected: directLeafCert,
+		},
+		"no scheme": {
+			chain:    []*x509.Certificate{leafCert, intermediateCert},
+			key:      leafKey,
+			url:      func(host string) string { return host + "/oidc" },
+			expected: intermediateCert,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			certificate := tls.Certificate{PrivateKey: testCase.key}
+			for _, cert := range testCase.chain {
+				certificate.Certificate = append(certificate.Certificate, cert.Raw)
+			}
+
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+			server.TLS = &tls.Config{
+				Certificates: []tls.Certificate{certificate},
+				MinVersion:   tls.VersionTLS12,
+			}
+			server.Config.ErrorLog = log.New(io.Discard, "", 0)
+			server.StartTLS()
+			defer server.Close()
+
+			// The test server listens on a random port, so every case also covers a custom port.
+			host := strings.TrimPrefix(server.URL, "https://")
+
+			got, err := findOpenIDConnectProviderThumbprint(context.Background(), testCase.url(host), rootCAs)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			sum := sha1.Sum(testCase.expected.Raw) //nolint:gosec // IAM requires SHA-1 thumbprints
+			if expected := hex.EncodeToString(sum[:]); got != expected {
+				t.Errorf("got thumbprint %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestFindOpenIDConnectProviderThumbprint_untrusted(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	_, err := findOpenIDConnectProviderThumbprint(context.Background(), server.URL, x509.NewCertPool())
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+// testOpenIDConnectProviderCertificate returns a certificate and its key, signed by parent or self-signed if parent is nil.
+func testOpenIDConnectProviderCertificate(t *testing.T, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	if isCA {
+		template.BasicConstraintsValid = true
+		template.IsCA = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		template.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert, key
+}
@@ -0,0 +1,235 @@
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"context_entries": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"permissions_boundary_policy_input_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     verify.ValidIAMPolicyJSON,
+					DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	policySourceARN := d.Get("policy_source_arn").(string)
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+		PolicySourceArn: aws.String(policySourceARN),
+	}
+
+	if v, ok := d.GetOk("context_entries"); ok && v.(*schema.Set).Len() > 0 {
+		input.ContextEntries = expandContextEntries(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_policy_input_list"); ok && len(v.([]interface{})) > 0 {
+		input.PermissionsBoundaryPolicyInputList = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	var results []*iam.EvaluationResult
+
+	err := conn.SimulatePrincipalPolicyPagesWithContext(ctx, input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.EvaluationResults...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "simulating IAM Principal Policy (%s): %s", policySourceARN, err)
+	}
+
+	allAllowed := len(results) > 0
+	for _, v := range results {
+		if aws.StringValue(v.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+			break
+		}
+	}
+
+	d.SetId(policySourceARN)
+	d.Set("all_allowed", allAllowed)
+	if err := d.Set("results", flattenEvaluationResults(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting results: %s", err)
+	}
+
+	return diags
+}
+
+func expandContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iam.ContextEntry{
+			ContextKeyName:   aws.String(tfMap["key"].(string)),
+			ContextKeyType:   aws.String(tfMap["type"].(string)),
+			ContextKeyValues: flex.ExpandStringSet(tfMap["values"].(*schema.Set)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"action_name":          aws.StringValue(apiObject.EvalActionName),
+			"allowed":              aws.StringValue(apiObject.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+			"decision":             aws.StringValue(apiObject.EvalDecision),
+			"decision_details":     aws.StringValueMap(apiObject.EvalDecisionDetails),
+			"missing_context_keys": aws.StringValueSlice(apiObject.MissingContextValues),
+			"resource_arn":         aws.StringValue(apiObject.EvalResourceName),
+		}
+
+		var statements []interface{}
+		for _, v := range apiObject.MatchedStatements {
+			if v == nil {
+				continue
+			}
+
+			statements = append(statements, map[string]interface{}{
+				"source_policy_id":   aws.StringValue(v.SourcePolicyId),
+				"source_policy_type": aws.StringValue(v.SourcePolicyType),
+			})
+		}
+		tfMap["matched_statements"] = statements
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,151 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPrincipalPolicySimulationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allowedName := "data.aws_iam_principal_policy_simulation.allowed"
+	deniedName := "data.aws_iam_principal_policy_simulation.denied"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(allowedName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(allowedName, "results.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(allowedName, "results.*", map[string]string{
+						"action_name":          "s3:GetObject",
+						"allowed":              "true",
+						"decision":             iam.PolicyEvaluationDecisionTypeAllowed,
+						"matched_statements.#": "1",
+					}),
+					resource.TestCheckResourceAttr(deniedName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(deniedName, "results.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(deniedName, "results.*", map[string]string{
+						"action_name":          "s3:GetObject",
+						"allowed":              "false",
+						"decision":             iam.PolicyEvaluationDecisionTypeImplicitDeny,
+						"matched_statements.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPrincipalPolicySimulationDataSource_contextEntries(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_principal_policy_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_contextEntries(rName, "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "true"),
+				),
+			},
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig_contextEntries(rName, "198.51.100.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPrincipalPolicySimulationDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user_policy" "test" {
+  name = %[1]q
+  user = aws_iam_user.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::%[1]s/*"
+    }]
+  })
+}
+
+data "aws_iam_principal_policy_simulation" "allowed" {
+  policy_source_arn = aws_iam_user.test.arn
+  action_names      = ["s3:GetObject"]
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/example"]
+
+  depends_on = [aws_iam_user_policy.test]
+}
+
+data "aws_iam_principal_policy_simulation" "denied" {
+  policy_source_arn = aws_iam_user.test.arn
+  action_names      = ["s3:GetObject"]
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s-other/example"]
+
+  depends_on = [aws_iam_user_policy.test]
+}
+`, rName))
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig_contextEntries(rName, sourceIP string) string {
+	return acctest.ConfigCompose(testAccPrincipalPolicySimulationDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_user_policy" "test" {
+  name = %[1]q
+  user = aws_iam_user.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:ListBucket"
+      Resource = "*"
+      Condition = {
+        IpAddress = {
+          "aws:SourceIp" = "192.0.2.0/24"
+        }
+      }
+    }]
+  })
+}
+
+data "aws_iam_principal_policy_simulation" "test" {
+  policy_source_arn = aws_iam_user.test.arn
+  action_names      = ["s3:ListBucket"]
+
+  context_entries {
+    key    = "aws:SourceIp"
+    type   = "ip"
+    values = [%[2]q]
+  }
+
+  depends_on = [aws_iam_user_policy.test]
+}
+`, rName, sourceIP))
+}
@@ -6,6 +6,7 @@ package iot
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -26,6 +27,16 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_iot_domain_configuration", &resource.Sweeper{
+		Name: "aws_iot_domain_configuration",
+		F:    sweepDomainConfigurations,
+	})
+
+	resource.AddTestSweepers("aws_iot_fleet_metric", &resource.Sweeper{
+		Name: "aws_iot_fleet_metric",
+		F:    sweepFleetMetrics,
+	})
+
 	resource.AddTestSweepers("aws_iot_policy_attachment", &resource.Sweeper{
 		Name: "aws_iot_policy_attachment",
 		F:    sweepPolicyAttachments,
@@ -582,3 +593,98 @@ func sweepTopicRuleDestinations(region string) error {
 
 	return nil
 }
+
+func sweepDomainConfigurations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTConn()
+	input := &iot.ListDomainConfigurationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDomainConfigurationsPagesWithContext(ctx, input, func(page *iot.ListDomainConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DomainConfigurations {
+			name := aws.StringValue(v.DomainConfigurationName)
+
+			// AWS-managed endpoints (e.g. "iot:Data-ATS") cannot be deleted.
+			if strings.HasPrefix(name, "iot:") {
+				continue
+			}
+
+			r := ResourceDomainConfiguration()
+			d := r.Data(nil)
+			d.SetId(name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Domain Configuration sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Domain Configurations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Domain Configurations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepFleetMetrics(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).IoTConn()
+	input := &iot.ListFleetMetricsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListFleetMetricsPagesWithContext(ctx, input, func(page *iot.ListFleetMetricsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FleetMetrics {
+			r := ResourceFleetMetric()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.MetricName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT Fleet Metric sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Fleet Metrics (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT Fleet Metrics (%s): %w", region, err)
+	}
+
+	return nil
+}
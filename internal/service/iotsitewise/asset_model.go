@@ -0,0 +1,1229 @@
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssetModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetModelCreate,
+		ReadWithoutTimeout:   resourceAssetModelRead,
+		UpdateWithoutTimeout: resourceAssetModelUpdate,
+		DeleteWithoutTimeout: resourceAssetModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_model_composite_models": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     assetModelPropertyResource(),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"asset_model_hierarchies": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_asset_model_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"asset_model_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_model_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     assetModelPropertyResource(),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func assetModelPropertyResource() *schema.Resource {
+	expressionVariableSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"value": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"hierarchy_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"property_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	forwardingConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"state": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(iotsitewise.ForwardingConfigState_Values(), false),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"data_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(iotsitewise.PropertyDataType_Values(), false),
+			},
+			"data_type_spec": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"type": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"measurement": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"processing_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"forwarding_config": forwardingConfigSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"processing_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_location": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+												},
+											},
+										},
+									},
+									"variables": expressionVariableSchema(),
+									"window": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tumbling": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"interval": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"offset": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"transform": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"processing_config": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_location": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+												},
+												"forwarding_config": forwardingConfigSchema(),
+											},
+										},
+									},
+									"variables": expressionVariableSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAssetModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &iotsitewise.CreateAssetModelInput{
+		AssetModelName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("asset_model_composite_models"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelCompositeModels = expandAssetModelCompositeModelDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("asset_model_hierarchies"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelHierarchies = expandAssetModelHierarchyDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("asset_model_properties"); ok && len(v.([]interface{})) > 0 {
+		input.AssetModelProperties = expandAssetModelPropertyDefinitions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.AssetModelDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAssetModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset Model (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetModelId))
+
+	if _, err := waitAssetModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAssetModelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	resolver := newAssetModelReferenceResolver(d, output)
+
+	arn := aws.StringValue(output.AssetModelArn)
+	d.Set("arn", arn)
+	if err := d.Set("asset_model_composite_models", orderByName(flattenAssetModelCompositeModels(output.AssetModelCompositeModels, d, resolver), d.Get("asset_model_composite_models").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset_model_composite_models: %s", err)
+	}
+	if err := d.Set("asset_model_hierarchies", orderByName(flattenAssetModelHierarchies(output.AssetModelHierarchies), d.Get("asset_model_hierarchies").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset_model_hierarchies: %s", err)
+	}
+	d.Set("asset_model_id", output.AssetModelId)
+	if err := d.Set("asset_model_properties", orderByName(flattenAssetModelProperties(output.AssetModelProperties, resolver), d.Get("asset_model_properties").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting asset_model_properties: %s", err)
+	}
+	d.Set("description", output.AssetModelDescription)
+	d.Set("name", output.AssetModelName)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAssetModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		// Existing properties, hierarchies and composite models must be sent with their IDs,
+		// otherwise they are deleted and recreated. Match them up by name.
+		output, err := FindAssetModelByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		input := &iotsitewise.UpdateAssetModelInput{
+			AssetModelId:   aws.String(d.Id()),
+			AssetModelName: aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("asset_model_composite_models"); ok && len(v.([]interface{})) > 0 {
+			input.AssetModelCompositeModels = assetModelCompositeModelsForUpdate(expandAssetModelCompositeModelDefinitions(v.([]interface{})), output.AssetModelCompositeModels)
+		}
+
+		if v, ok := d.GetOk("asset_model_hierarchies"); ok && len(v.([]interface{})) > 0 {
+			input.AssetModelHierarchies = assetModelHierarchiesForUpdate(expandAssetModelHierarchyDefinitions(v.([]interface{})), output.AssetModelHierarchies)
+		}
+
+		if v, ok := d.GetOk("asset_model_properties"); ok && len(v.([]interface{})) > 0 {
+			input.AssetModelProperties = assetModelPropertiesForUpdate(expandAssetModelPropertyDefinitions(v.([]interface{})), output.AssetModelProperties)
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.AssetModelDescription = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateAssetModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetModelActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset Model (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset Model: %s", d.Id())
+	_, err := conn.DeleteAssetModelWithContext(ctx, &iotsitewise.DeleteAssetModelInput{
+		AssetModelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetModelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindAssetModelByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeAssetModelOutput, error) {
+	input := &iotsitewise.DescribeAssetModelInput{
+		AssetModelId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAssetModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AssetModelStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetModel(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAssetModelByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssetModelStatus.State), nil
+	}
+}
+
+func waitAssetModelActive(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateCreating, iotsitewise.AssetModelStateUpdating, iotsitewise.AssetModelStatePropagating},
+		Target:  []string{iotsitewise.AssetModelStateActive},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if v := output.AssetModelStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateDeleting},
+		Target:  []string{},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if v := output.AssetModelStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// assetModelReferenceResolver maps property and hierarchy IDs returned by the API back to
+// the names used in configuration, as expression variables may reference either.
+type assetModelReferenceResolver struct {
+	configured map[string]bool
+	idToName   map[string]string
+}
+
+func newAssetModelReferenceResolver(d *schema.ResourceData, apiObject *iotsitewise.DescribeAssetModelOutput) *assetModelReferenceResolver {
+	r := &assetModelReferenceResolver{
+		configured: make(map[string]bool),
+		idToName:   make(map[string]string),
+	}
+
+	for _, v := range apiObject.AssetModelProperties {
+		r.idToName[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+
+	for _, v := range apiObject.AssetModelHierarchies {
+		r.idToName[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+
+	collect := func(tfList []interface{}) {
+		for _, tfMapRaw := range tfList {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for _, tfMapRaw := range tfMap["type"].([]interface{}) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				for _, k := range []string{"metric", "transform"} {
+					for _, tfMapRaw := range tfMap[k].([]interface{}) {
+						tfMap, ok := tfMapRaw.(map[string]interface{})
+
+						if !ok {
+							continue
+						}
+
+						for _, tfMapRaw := range tfMap["variables"].([]interface{}) {
+							tfMap, ok := tfMapRaw.(map[string]interface{})
+
+							if !ok {
+								continue
+							}
+
+							for _, tfMapRaw := range tfMap["value"].([]interface{}) {
+								tfMap, ok := tfMapRaw.(map[string]interface{})
+
+								if !ok {
+									continue
+								}
+
+								for _, k := range []string{"hierarchy_id", "property_id"} {
+									if v, ok := tfMap[k].(string); ok && v != "" {
+										r.configured[v] = true
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	collect(d.Get("asset_model_properties").([]interface{}))
+
+	for _, tfMapRaw := range d.Get("asset_model_composite_models").([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			collect(tfMap["properties"].([]interface{}))
+		}
+	}
+
+	return r
+}
+
+func (r *assetModelReferenceResolver) resolve(id string) string {
+	if id == "" || r == nil || r.configured[id] {
+		return id
+	}
+
+	if name, ok := r.idToName[id]; ok && r.configured[name] {
+		return name
+	}
+
+	return id
+}
+
+// orderByName reorders tfList to follow the order of the configured blocks, matched by name.
+// Blocks that aren't configured are appended in their original order.
+func orderByName(tfList []interface{}, configured []interface{}) []interface{} {
+	if len(tfList) == 0 || len(configured) == 0 {
+		return tfList
+	}
+
+	byName := make(map[string]interface{})
+	for _, tfMapRaw := range tfList {
+		byName[tfMapRaw.(map[string]interface{})["name"].(string)] = tfMapRaw
+	}
+
+	var ordered []interface{}
+	seen := make(map[string]bool)
+
+	for _, tfMapRaw := range configured {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+
+		if v, ok := byName[name]; ok && !seen[name] {
+			ordered = append(ordered, v)
+			seen[name] = true
+		}
+	}
+
+	for _, tfMapRaw := range tfList {
+		if name := tfMapRaw.(map[string]interface{})["name"].(string); !seen[name] {
+			ordered = append(ordered, tfMapRaw)
+		}
+	}
+
+	return ordered
+}
+
+func assetModelPropertiesForUpdate(definitions []*iotsitewise.AssetModelPropertyDefinition, existing []*iotsitewise.AssetModelProperty) []*iotsitewise.AssetModelProperty {
+	ids := make(map[string]*string)
+	for _, v := range existing {
+		ids[aws.StringValue(v.Name)] = v.Id
+	}
+
+	var apiObjects []*iotsitewise.AssetModelProperty
+
+	for _, v := range definitions {
+		apiObjects = append(apiObjects, &iotsitewise.AssetModelProperty{
+			DataType:     v.DataType,
+			DataTypeSpec: v.DataTypeSpec,
+			Id:           ids[aws.StringValue(v.Name)],
+			Name:         v.Name,
+			Type:         v.Type,
+			Unit:         v.Unit,
+		})
+	}
+
+	return apiObjects
+}
+
+func assetModelHierarchiesForUpdate(definitions []*iotsitewise.AssetModelHierarchyDefinition, existing []*iotsitewise.AssetModelHierarchy) []*iotsitewise.AssetModelHierarchy {
+	ids := make(map[string]*string)
+	for _, v := range existing {
+		ids[aws.StringValue(v.Name)] = v.Id
+	}
+
+	var apiObjects []*iotsitewise.AssetModelHierarchy
+
+	for _, v := range definitions {
+		apiObjects = append(apiObjects, &iotsitewise.AssetModelHierarchy{
+			ChildAssetModelId: v.ChildAssetModelId,
+			Id:                ids[aws.StringValue(v.Name)],
+			Name:              v.Name,
+		})
+	}
+
+	return apiObjects
+}
+
+func assetModelCompositeModelsForUpdate(definitions []*iotsitewise.AssetModelCompositeModelDefinition, existing []*iotsitewise.AssetModelCompositeModel) []*iotsitewise.AssetModelCompositeModel {
+	byName := make(map[string]*iotsitewise.AssetModelCompositeModel)
+	for _, v := range existing {
+		byName[aws.StringValue(v.Name)] = v
+	}
+
+	var apiObjects []*iotsitewise.AssetModelCompositeModel
+
+	for _, v := range definitions {
+		apiObject := &iotsitewise.AssetModelCompositeModel{
+			Description: v.Description,
+			Name:        v.Name,
+			Type:        v.Type,
+		}
+
+		if e, ok := byName[aws.StringValue(v.Name)]; ok {
+			apiObject.Id = e.Id
+			apiObject.Properties = assetModelPropertiesForUpdate(v.Properties, e.Properties)
+		} else {
+			apiObject.Properties = assetModelPropertiesForUpdate(v.Properties, nil)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelCompositeModelDefinitions(tfList []interface{}) []*iotsitewise.AssetModelCompositeModelDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotsitewise.AssetModelCompositeModelDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.AssetModelCompositeModelDefinition{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["properties"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Properties = expandAssetModelPropertyDefinitions(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelHierarchyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelHierarchyDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotsitewise.AssetModelHierarchyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &iotsitewise.AssetModelHierarchyDefinition{
+			ChildAssetModelId: aws.String(tfMap["child_asset_model_id"].(string)),
+			Name:              aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandAssetModelPropertyDefinitions(tfList []interface{}) []*iotsitewise.AssetModelPropertyDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotsitewise.AssetModelPropertyDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.AssetModelPropertyDefinition{
+			DataType: aws.String(tfMap["data_type"].(string)),
+			Name:     aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["data_type_spec"].(string); ok && v != "" {
+			apiObject.DataTypeSpec = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Type = expandPropertyType(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["unit"].(string); ok && v != "" {
+			apiObject.Unit = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPropertyType(tfMap map[string]interface{}) *iotsitewise.PropertyType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotsitewise.PropertyType{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		attribute := &iotsitewise.Attribute{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["default_value"].(string); ok && v != "" {
+				attribute.DefaultValue = aws.String(v)
+			}
+		}
+
+		apiObject.Attribute = attribute
+	}
+
+	if v, ok := tfMap["measurement"].([]interface{}); ok && len(v) > 0 {
+		measurement := &iotsitewise.Measurement{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["processing_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				if v := expandForwardingConfig(tfMap["forwarding_config"].([]interface{})); v != nil {
+					measurement.ProcessingConfig = &iotsitewise.MeasurementProcessingConfig{
+						ForwardingConfig: v,
+					}
+				}
+			}
+		}
+
+		apiObject.Measurement = measurement
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		metric := &iotsitewise.Metric{
+			Expression: aws.String(tfMap["expression"].(string)),
+			Variables:  expandExpressionVariables(tfMap["variables"].([]interface{})),
+		}
+
+		if v, ok := tfMap["processing_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			metric.ProcessingConfig = &iotsitewise.MetricProcessingConfig{
+				ComputeLocation: aws.String(tfMap["compute_location"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			window := &iotsitewise.MetricWindow{}
+
+			if v, ok := tfMap["tumbling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				tumbling := &iotsitewise.TumblingWindow{
+					Interval: aws.String(tfMap["interval"].(string)),
+				}
+
+				if v, ok := tfMap["offset"].(string); ok && v != "" {
+					tumbling.Offset = aws.String(v)
+				}
+
+				window.Tumbling = tumbling
+			}
+
+			metric.Window = window
+		}
+
+		apiObject.Metric = metric
+	}
+
+	if v, ok := tfMap["transform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		transform := &iotsitewise.Transform{
+			Expression: aws.String(tfMap["expression"].(string)),
+			Variables:  expandExpressionVariables(tfMap["variables"].([]interface{})),
+		}
+
+		if v, ok := tfMap["processing_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			transform.ProcessingConfig = &iotsitewise.TransformProcessingConfig{
+				ComputeLocation:  aws.String(tfMap["compute_location"].(string)),
+				ForwardingConfig: expandForwardingConfig(tfMap["forwarding_config"].([]interface{})),
+			}
+		}
+
+		apiObject.Transform = transform
+	}
+
+	return apiObject
+}
+
+func expandForwardingConfig(tfList []interface{}) *iotsitewise.ForwardingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotsitewise.ForwardingConfig{
+		State: aws.String(tfMap["state"].(string)),
+	}
+}
+
+func expandExpressionVariables(tfList []interface{}) []*iotsitewise.ExpressionVariable {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotsitewise.ExpressionVariable
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.ExpressionVariable{
+			Name:  aws.String(tfMap["name"].(string)),
+			Value: &iotsitewise.VariableValue{},
+		}
+
+		if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["hierarchy_id"].(string); ok && v != "" {
+				apiObject.Value.HierarchyId = aws.String(v)
+			}
+
+			if v, ok := tfMap["property_id"].(string); ok && v != "" {
+				apiObject.Value.PropertyId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAssetModelCompositeModels(apiObjects []*iotsitewise.AssetModelCompositeModel, d *schema.ResourceData, resolver *assetModelReferenceResolver) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	configured := make(map[string][]interface{})
+	for _, tfMapRaw := range d.Get("asset_model_composite_models").([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			configured[tfMap["name"].(string)] = tfMap["properties"].([]interface{})
+		}
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		name := aws.StringValue(apiObject.Name)
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"id":          aws.StringValue(apiObject.Id),
+			"name":        name,
+			"properties":  orderByName(flattenAssetModelProperties(apiObject.Properties, resolver), configured[name]),
+			"type":        aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAssetModelHierarchies(apiObjects []*iotsitewise.AssetModelHierarchy) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"child_asset_model_id": aws.StringValue(apiObject.ChildAssetModelId),
+			"id":                   aws.StringValue(apiObject.Id),
+			"name":                 aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAssetModelProperties(apiObjects []*iotsitewise.AssetModelProperty, resolver *assetModelReferenceResolver) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"data_type":      aws.StringValue(apiObject.DataType),
+			"data_type_spec": aws.StringValue(apiObject.DataTypeSpec),
+			"id":             aws.StringValue(apiObject.Id),
+			"name":           aws.StringValue(apiObject.Name),
+			"unit":           aws.StringValue(apiObject.Unit),
+		}
+
+		if v := apiObject.Type; v != nil {
+			tfMap["type"] = []interface{}{flattenPropertyType(v, resolver)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPropertyType(apiObject *iotsitewise.PropertyType, resolver *assetModelReferenceResolver) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attribute; v != nil {
+		tfMap["attribute"] = []interface{}{map[string]interface{}{
+			"default_value": aws.StringValue(v.DefaultValue),
+		}}
+	}
+
+	if v := apiObject.Measurement; v != nil {
+		measurement := map[string]interface{}{}
+
+		if v := v.ProcessingConfig; v != nil {
+			measurement["processing_config"] = []interface{}{map[string]interface{}{
+				"forwarding_config": flattenForwardingConfig(v.ForwardingConfig),
+			}}
+		}
+
+		tfMap["measurement"] = []interface{}{measurement}
+	}
+
+	if v := apiObject.Metric; v != nil {
+		metric := map[string]interface{}{
+			"expression": aws.StringValue(v.Expression),
+			"variables":  flattenExpressionVariables(v.Variables, resolver),
+		}
+
+		if v := v.ProcessingConfig; v != nil {
+			metric["processing_config"] = []interface{}{map[string]interface{}{
+				"compute_location": aws.StringValue(v.ComputeLocation),
+			}}
+		}
+
+		if v := v.Window; v != nil {
+			window := map[string]interface{}{}
+
+			if v := v.Tumbling; v != nil {
+				window["tumbling"] = []interface{}{map[string]interface{}{
+					"interval": aws.StringValue(v.Interval),
+					"offset":   aws.StringValue(v.Offset),
+				}}
+			}
+
+			metric["window"] = []interface{}{window}
+		}
+
+		tfMap["metric"] = []interface{}{metric}
+	}
+
+	if v := apiObject.Transform; v != nil {
+		transform := map[string]interface{}{
+			"expression": aws.StringValue(v.Expression),
+			"variables":  flattenExpressionVariables(v.Variables, resolver),
+		}
+
+		if v := v.ProcessingConfig; v != nil {
+			transform["processing_config"] = []interface{}{map[string]interface{}{
+				"compute_location":  aws.StringValue(v.ComputeLocation),
+				"forwarding_config": flattenForwardingConfig(v.ForwardingConfig),
+			}}
+		}
+
+		tfMap["transform"] = []interface{}{transform}
+	}
+
+	return tfMap
+}
+
+func flattenForwardingConfig(apiObject *iotsitewise.ForwardingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"state": aws.StringValue(apiObject.State),
+	}}
+}
+
+func flattenExpressionVariables(apiObjects []*iotsitewise.ExpressionVariable, resolver *assetModelReferenceResolver) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = []interface{}{map[string]interface{}{
+				"hierarchy_id": resolver.resolve(aws.StringValue(v.HierarchyId)),
+				"property_id":  resolver.resolve(aws.StringValue(v.PropertyId)),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,332 @@
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSiteWiseAssetModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotsitewise", regexp.MustCompile(`asset-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_composite_models.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_hierarchies.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "asset_model_id"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAssetModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetModelConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAssetModelConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_properties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 iotsitewise.DescribeAssetModelOutput
+	resourceName := "aws_iotsitewise_asset_model.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_properties(rName, "Celsius"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_hierarchies.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_hierarchies.0.name", "children"),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_hierarchies.0.child_asset_model_id", "aws_iotsitewise_asset_model.child", "id"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.0.name", "location"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.0.type.0.attribute.0.default_value", "unknown"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.1.name", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.1.unit", "Celsius"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.1.type.0.measurement.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.2.name", "temperature_max"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.2.type.0.metric.0.variables.0.value.0.property_id", "temperature"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.2.type.0.metric.0.window.0.tumbling.0.interval", "5m"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Expression variables are read back as property IDs rather than names.
+				ImportStateVerifyIgnore: []string{"asset_model_properties.2.type.0.metric.0.variables.0.value.0.property_id"},
+			},
+			{
+				Config: testAccAssetModelConfig_properties(rName, "Fahrenheit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName, &v2),
+					testAccCheckAssetModelNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "asset_model_properties.1.unit", "Fahrenheit"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssetModelExists(ctx context.Context, n string, v *iotsitewise.DescribeAssetModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT SiteWise Asset Model ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn()
+
+		output, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssetModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset_model" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssetModelNotRecreated(before, after *iotsitewise.DescribeAssetModelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.AssetModelId), aws.StringValue(after.AssetModelId); before != after {
+			return fmt.Errorf("IoT SiteWise Asset Model (%s) recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetModelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAssetModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAssetModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAssetModelConfig_properties(rName, unit string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "child" {
+  name = "%[1]s-child"
+}
+
+resource "aws_iotsitewise_asset_model" "test" {
+  name        = %[1]q
+  description = "Terraform acceptance test"
+
+  asset_model_hierarchies {
+    name                 = "children"
+    child_asset_model_id = aws_iotsitewise_asset_model.child.id
+  }
+
+  asset_model_properties {
+    name      = "location"
+    data_type = "STRING"
+
+    type {
+      attribute {
+        default_value = "unknown"
+      }
+    }
+  }
+
+  asset_model_properties {
+    name      = "temperature"
+    data_type = "DOUBLE"
+    unit      = %[2]q
+
+    type {
+      measurement {}
+    }
+  }
+
+  asset_model_properties {
+    name      = "temperature_max"
+    data_type = "DOUBLE"
+    unit      = %[2]q
+
+    type {
+      metric {
+        expression = "max(temperature)"
+
+        variables {
+          name = "temperature"
+
+          value {
+            property_id = "temperature"
+          }
+        }
+
+        window {
+          tumbling {
+            interval = "5m"
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, unit)
+}
@@ -0,0 +1,282 @@
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTSiteWiseAsset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iotsitewise", regexp.MustCompile(`asset/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "asset_hierarchies.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "asset_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_id", "aws_iotsitewise_asset_model.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "asset_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "asset_properties.0.name", "serial_number"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAsset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAssetConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 iotsitewise.DescribeAssetOutput
+	resourceName := "aws_iotsitewise_asset.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := rName + "-updated"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iotsitewise.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_update(rName, rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccAssetConfig_update(rName, rNameUpdated, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName, &v2),
+					testAccCheckAssetNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssetExists(ctx context.Context, n string, v *iotsitewise.DescribeAssetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT SiteWise Asset ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn()
+
+		output, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAssetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssetNotRecreated(before, after *iotsitewise.DescribeAssetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.AssetId), aws.StringValue(after.AssetId); before != after {
+			return fmt.Errorf("IoT SiteWise Asset (%s) recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  asset_model_properties {
+    name      = "serial_number"
+    data_type = "STRING"
+
+    type {
+      attribute {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAssetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+}
+`, rName))
+}
+
+func testAccAssetConfig_update(rName, name, description string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+  description    = %[2]q
+}
+`, name, description))
+}
+
+func testAccAssetConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAssetConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
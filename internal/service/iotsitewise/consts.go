@@ -0,0 +1,9 @@
+package iotsitewise
+
+import (
+	"time"
+)
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,294 @@
+package iotsitewise
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGatewayCreate,
+		ReadWithoutTimeout:   resourceGatewayRead,
+		UpdateWithoutTimeout: resourceGatewayUpdate,
+		DeleteWithoutTimeout: resourceGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"gateway_platform": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"greengrass": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"gateway_platform.0.greengrass", "gateway_platform.0.greengrass_v2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"greengrass_v2": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"gateway_platform.0.greengrass", "gateway_platform.0.greengrass_v2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"core_device_thing_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("gateway_name").(string)
+	input := &iotsitewise.CreateGatewayInput{
+		GatewayName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("gateway_platform"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.GatewayPlatform = expandGatewayPlatform(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateGatewayWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Gateway (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.GatewayId))
+
+	return append(diags, resourceGatewayRead(ctx, d, meta)...)
+}
+
+func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindGatewayByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Gateway (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.GatewayArn)
+	d.Set("arn", arn)
+	d.Set("gateway_id", output.GatewayId)
+	d.Set("gateway_name", output.GatewayName)
+	if output.GatewayPlatform != nil {
+		if err := d.Set("gateway_platform", []interface{}{flattenGatewayPlatform(output.GatewayPlatform)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting gateway_platform: %s", err)
+		}
+	} else {
+		d.Set("gateway_platform", nil)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for IoT SiteWise Gateway (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+
+	if d.HasChange("gateway_name") {
+		_, err := conn.UpdateGatewayWithContext(ctx, &iotsitewise.UpdateGatewayInput{
+			GatewayId:   aws.String(d.Id()),
+			GatewayName: aws.String(d.Get("gateway_name").(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Gateway (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Gateway (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGatewayRead(ctx, d, meta)...)
+}
+
+func resourceGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Gateway: %s", d.Id())
+	_, err := conn.DeleteGatewayWithContext(ctx, &iotsitewise.DeleteGatewayInput{
+		GatewayId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Gateway (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindGatewayByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeGatewayOutput, error) {
+	input := &iotsitewise.DescribeGatewayInput{
+		GatewayId: aws.String(id),
+	}
+
+	output, err := conn.DescribeGatewayWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandGatewayPlatform(tfMap map[string]interface{}) *iotsitewise.GatewayPlatform {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotsitewise.GatewayPlatform{}
+
+	if v, ok := tfMap["greengrass"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Greengrass = &iotsitewise.Greengrass{
+			GroupArn: aws.String(tfMap["group_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["greengrass_v2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.GreengrassV2 = &iotsitewise.GreengrassV2{
+			CoreDeviceThingName: aws.String(tfMap["core_device_thing_name"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenGatewayPlatform(apiObject *iotsitewise.GatewayPlatform) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Greengrass; v != nil {
+		tfMap["greengrass"] = []interface{}{map[string]interface{}{
+			"group_arn": aws.StringValue(v.GroupArn),
+		}}
+	}
+
+	if v := apiObject.GreengrassV2; v != nil {
+		tfMap["greengrass_v2"] = []interface{}{map[string]interface{}{
+			"core_device_thing_name": aws.StringValue(v.CoreDeviceThingName),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,397 @@
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePortal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortalCreate,
+		ReadWithoutTimeout:   resourcePortalRead,
+		UpdateWithoutTimeout: resourcePortalUpdate,
+		DeleteWithoutTimeout: resourcePortalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"notification_lambda_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_sender_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"portal_auth_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      iotsitewise.AuthModeSso,
+				ValidateFunc: validation.StringInSlice(iotsitewise.AuthMode_Values(), false),
+			},
+			"portal_client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_contact_email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"portal_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"portal_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"portal_start_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("portal_name").(string)
+	input := &iotsitewise.CreatePortalInput{
+		PortalAuthMode:     aws.String(d.Get("portal_auth_mode").(string)),
+		PortalContactEmail: aws.String(d.Get("portal_contact_email").(string)),
+		PortalName:         aws.String(name),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Alarms = expandAlarms(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("notification_sender_email"); ok {
+		input.NotificationSenderEmail = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("portal_description"); ok {
+		input.PortalDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	// Retry for IAM eventual consistency.
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreatePortalWithContext(ctx, input)
+	}, iotsitewise.ErrCodeInvalidRequestException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Portal (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*iotsitewise.CreatePortalOutput).PortalId))
+
+	if _, err := waitPortalActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Portal (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindPortalByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Portal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Portal (%s): %s", d.Id(), err)
+	}
+
+	if output.Alarms != nil {
+		if err := d.Set("alarms", []interface{}{flattenAlarms(output.Alarms)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting alarms: %s", err)
+		}
+	} else {
+		d.Set("alarms", nil)
+	}
+	arn := aws.StringValue(output.PortalArn)
+	d.Set("arn", arn)
+	d.Set("notification_sender_email", output.NotificationSenderEmail)
+	d.Set("portal_auth_mode", output.PortalAuthMode)
+	d.Set("portal_client_id", output.PortalClientId)
+	d.Set("portal_contact_email", output.PortalContactEmail)
+	d.Set("portal_description", output.PortalDescription)
+	d.Set("portal_id", output.PortalId)
+	d.Set("portal_name", output.PortalName)
+	d.Set("portal_start_url", output.PortalStartUrl)
+	d.Set("role_arn", output.RoleArn)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for IoT SiteWise Portal (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iotsitewise.UpdatePortalInput{
+			PortalContactEmail: aws.String(d.Get("portal_contact_email").(string)),
+			PortalId:           aws.String(d.Id()),
+			PortalName:         aws.String(d.Get("portal_name").(string)),
+			RoleArn:            aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Alarms = expandAlarms(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("notification_sender_email"); ok {
+			input.NotificationSenderEmail = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("portal_description"); ok {
+			input.PortalDescription = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdatePortalWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Portal (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPortalActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Portal (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Portal (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn()
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Portal: %s", d.Id())
+	_, err := conn.DeletePortalWithContext(ctx, &iotsitewise.DeletePortalInput{
+		PortalId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Portal (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPortalDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Portal (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindPortalByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribePortalOutput, error) {
+	input := &iotsitewise.DescribePortalInput{
+		PortalId: aws.String(id),
+	}
+
+	output, err := conn.DescribePortalWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PortalStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPortal(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPortalByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PortalStatus.State), nil
+	}
+}
+
+func waitPortalActive(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribePortalOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.PortalStateCreating, iotsitewise.PortalStateUpdating},
+		Target:  []string{iotsitewise.PortalStateActive},
+		Refresh: statusPortal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribePortalOutput); ok {
+		if v := output.PortalStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPortalDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribePortalOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotsitewise.PortalStateDeleting},
+		Target:  []string{},
+		Refresh: statusPortal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribePortalOutput); ok {
+		if v := output.PortalStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlarms(tfMap map[string]interface{}) *iotsitewise.Alarms {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iotsitewise.Alarms{
+		AlarmRoleArn: aws.String(tfMap["alarm_role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["notification_lambda_arn"].(string); ok && v != "" {
+		apiObject.NotificationLambdaArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAlarms(apiObject *iotsitewise.Alarms) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"alarm_role_arn":          aws.StringValue(apiObject.AlarmRoleArn),
+		"notification_lambda_arn": aws.StringValue(apiObject.NotificationLambdaArn),
+	}
+}
@@ -0,0 +1,911 @@
+package iottwinmaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceComponentType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentTypeCreate,
+		ReadWithoutTimeout:   resourceComponentTypeRead,
+		UpdateWithoutTimeout: resourceComponentTypeUpdate,
+		DeleteWithoutTimeout: resourceComponentTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_.\-0-9:]+$`), "must contain only alphanumeric characters and/or the following: _.-:"),
+				),
+			},
+			"component_type_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"extends_from": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"function": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"implemented_by": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"is_native": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"lambda_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"required_properties": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(iottwinmaker.Scope_Values(), false),
+						},
+					},
+				},
+			},
+			"is_abstract": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_schema_initialized": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_singleton": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"property_definition": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configuration": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"data_type": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     dataValueResource(),
+									},
+									"nested_type": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iottwinmaker.Type_Values(), false),
+												},
+												"unit_of_measure": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"relationship": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"relationship_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"target_component_type_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(iottwinmaker.Type_Values(), false),
+									},
+									"unit_of_measure": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"default_value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     dataValueResource(),
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"is_external_id": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_required_in_entity": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_stored_externally": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"is_time_series": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+// dataValueResource returns the schema for the scalar subset of a TwinMaker DataValue.
+func dataValueResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"boolean_value": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"double_value": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"expression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"integer_value": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"long_value": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"string_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceComponentTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	workspaceID := d.Get("workspace_id").(string)
+	componentTypeID := d.Get("component_type_id").(string)
+	id := ComponentTypeCreateResourceID(workspaceID, componentTypeID)
+	input := &iottwinmaker.CreateComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("component_type_name"); ok {
+		input.ComponentTypeName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("extends_from"); ok && v.(*schema.Set).Len() > 0 {
+		input.ExtendsFrom = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("function"); ok && v.(*schema.Set).Len() > 0 {
+		input.Functions = expandFunctions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("is_singleton"); ok {
+		input.IsSingleton = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("property_definition"); ok && v.(*schema.Set).Len() > 0 {
+		input.PropertyDefinitions = expandPropertyDefinitions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateComponentTypeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Component Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitComponentTypeActive(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Component Type (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentTypeRead(ctx, d, meta)...)
+}
+
+func resourceComponentTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	workspaceID, componentTypeID, err := ComponentTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Component Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("component_type_id", output.ComponentTypeId)
+	d.Set("component_type_name", output.ComponentTypeName)
+	d.Set("description", output.Description)
+	d.Set("extends_from", aws.StringValueSlice(output.ExtendsFrom))
+	if err := d.Set("function", flattenFunctions(output.Functions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting function: %s", err)
+	}
+	d.Set("is_abstract", output.IsAbstract)
+	d.Set("is_schema_initialized", output.IsSchemaInitialized)
+	d.Set("is_singleton", output.IsSingleton)
+	if err := d.Set("property_definition", flattenPropertyDefinitions(output.PropertyDefinitions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting property_definition: %s", err)
+	}
+	if output.Status != nil {
+		d.Set("status", output.Status.State)
+	} else {
+		d.Set("status", nil)
+	}
+	d.Set("workspace_id", output.WorkspaceId)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceComponentTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+
+	workspaceID, componentTypeID, err := ComponentTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iottwinmaker.UpdateComponentTypeInput{
+			ComponentTypeId: aws.String(componentTypeID),
+			WorkspaceId:     aws.String(workspaceID),
+		}
+
+		if d.HasChange("component_type_name") {
+			input.ComponentTypeName = aws.String(d.Get("component_type_name").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("extends_from") {
+			input.ExtendsFrom = flex.ExpandStringSet(d.Get("extends_from").(*schema.Set))
+		}
+
+		if d.HasChange("function") {
+			input.Functions = expandFunctions(d.Get("function").(*schema.Set).List())
+		}
+
+		if d.HasChange("is_singleton") {
+			input.IsSingleton = aws.Bool(d.Get("is_singleton").(bool))
+		}
+
+		if d.HasChange("property_definition") {
+			input.PropertyDefinitions = expandPropertyDefinitions(d.Get("property_definition").(*schema.Set).List())
+		}
+
+		_, err := conn.UpdateComponentTypeWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitComponentTypeActive(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Component Type (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Component Type (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceComponentTypeRead(ctx, d, meta)...)
+}
+
+func resourceComponentTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+
+	workspaceID, componentTypeID, err := ComponentTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Component Type: %s", d.Id())
+	_, err = conn.DeleteComponentTypeWithContext(ctx, &iottwinmaker.DeleteComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT TwinMaker Component Type (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitComponentTypeDeleted(ctx, conn, workspaceID, componentTypeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Component Type (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const componentTypeResourceIDSeparator = ","
+
+func ComponentTypeCreateResourceID(workspaceID, componentTypeID string) string {
+	parts := []string{workspaceID, componentTypeID}
+	id := strings.Join(parts, componentTypeResourceIDSeparator)
+
+	return id
+}
+
+func ComponentTypeParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, componentTypeResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected workspace-id%[2]scomponent-type-id", id, componentTypeResourceIDSeparator)
+}
+
+func FindComponentTypeByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string) (*iottwinmaker.GetComponentTypeOutput, error) {
+	input := &iottwinmaker.GetComponentTypeInput{
+		ComponentTypeId: aws.String(componentTypeID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	output, err := conn.GetComponentTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusComponentType(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
+
+func waitComponentTypeActive(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iottwinmaker.StateCreating, iottwinmaker.StateUpdating},
+		Target:  []string{iottwinmaker.StateActive},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if status := output.Status; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitComponentTypeDeleted(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, componentTypeID string, timeout time.Duration) (*iottwinmaker.GetComponentTypeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iottwinmaker.StateDeleting},
+		Target:  []string{},
+		Refresh: statusComponentType(ctx, conn, workspaceID, componentTypeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetComponentTypeOutput); ok {
+		if status := output.Status; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandFunctions(tfList []interface{}) map[string]*iottwinmaker.FunctionRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*iottwinmaker.FunctionRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iottwinmaker.FunctionRequest{}
+
+		if v, ok := tfMap["implemented_by"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			dataConnector := &iottwinmaker.DataConnector{}
+
+			if v, ok := tfMap["is_native"].(bool); ok && v {
+				dataConnector.IsNative = aws.Bool(v)
+			}
+
+			if v, ok := tfMap["lambda_arn"].(string); ok && v != "" {
+				dataConnector.Lambda = &iottwinmaker.LambdaFunction{
+					Arn: aws.String(v),
+				}
+			}
+
+			apiObject.ImplementedBy = dataConnector
+		}
+
+		if v, ok := tfMap["required_properties"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.RequiredProperties = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["scope"].(string); ok && v != "" {
+			apiObject.Scope = aws.String(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenFunctions(apiObjects map[string]*iottwinmaker.FunctionResponse) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		// Skip functions inherited from a parent component type.
+		if apiObject == nil || aws.BoolValue(apiObject.IsInherited) {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":                name,
+			"required_properties": aws.StringValueSlice(apiObject.RequiredProperties),
+			"scope":               aws.StringValue(apiObject.Scope),
+		}
+
+		if v := apiObject.ImplementedBy; v != nil {
+			implementedBy := map[string]interface{}{
+				"is_native": aws.BoolValue(v.IsNative),
+			}
+
+			if v.Lambda != nil {
+				implementedBy["lambda_arn"] = aws.StringValue(v.Lambda.Arn)
+			}
+
+			tfMap["implemented_by"] = []interface{}{implementedBy}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandPropertyDefinitions(tfList []interface{}) map[string]*iottwinmaker.PropertyDefinitionRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*iottwinmaker.PropertyDefinitionRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects[tfMap["name"].(string)] = expandPropertyDefinition(tfMap)
+	}
+
+	return apiObjects
+}
+
+func expandPropertyDefinition(tfMap map[string]interface{}) *iottwinmaker.PropertyDefinitionRequest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iottwinmaker.PropertyDefinitionRequest{}
+
+	if v, ok := tfMap["configuration"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Configuration = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["data_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DataType = expandDataType(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["default_value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DefaultValue = expandDataValue(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["display_name"].(string); ok && v != "" {
+		apiObject.DisplayName = aws.String(v)
+	}
+
+	if v, ok := tfMap["is_external_id"].(bool); ok {
+		apiObject.IsExternalId = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["is_required_in_entity"].(bool); ok {
+		apiObject.IsRequiredInEntity = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["is_stored_externally"].(bool); ok {
+		apiObject.IsStoredExternally = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["is_time_series"].(bool); ok {
+		apiObject.IsTimeSeries = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenPropertyDefinitions(apiObjects map[string]*iottwinmaker.PropertyDefinitionResponse) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		// Skip property definitions inherited from a parent component type.
+		if apiObject == nil || aws.BoolValue(apiObject.IsInherited) {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"configuration":         aws.StringValueMap(apiObject.Configuration),
+			"display_name":          aws.StringValue(apiObject.DisplayName),
+			"is_external_id":        aws.BoolValue(apiObject.IsExternalId),
+			"is_required_in_entity": aws.BoolValue(apiObject.IsRequiredInEntity),
+			"is_stored_externally":  aws.BoolValue(apiObject.IsStoredExternally),
+			"is_time_series":        aws.BoolValue(apiObject.IsTimeSeries),
+			"name":                  name,
+		}
+
+		if v := apiObject.DataType; v != nil {
+			tfMap["data_type"] = []interface{}{flattenDataType(v)}
+		}
+
+		if v := apiObject.DefaultValue; v != nil {
+			tfMap["default_value"] = []interface{}{flattenDataValue(v)}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandDataType(tfMap map[string]interface{}) *iottwinmaker.DataType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iottwinmaker.DataType{}
+
+	if v, ok := tfMap["allowed_values"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+				apiObject.AllowedValues = append(apiObject.AllowedValues, expandDataValue(tfMap))
+			}
+		}
+	}
+
+	if v, ok := tfMap["nested_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NestedType = expandDataType(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["relationship"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		relationship := &iottwinmaker.Relationship{}
+
+		if v, ok := tfMap["relationship_type"].(string); ok && v != "" {
+			relationship.RelationshipType = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_component_type_id"].(string); ok && v != "" {
+			relationship.TargetComponentTypeId = aws.String(v)
+		}
+
+		apiObject.Relationship = relationship
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["unit_of_measure"].(string); ok && v != "" {
+		apiObject.UnitOfMeasure = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataType(apiObject *iottwinmaker.DataType) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type":            aws.StringValue(apiObject.Type),
+		"unit_of_measure": aws.StringValue(apiObject.UnitOfMeasure),
+	}
+
+	if v := apiObject.AllowedValues; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, flattenDataValue(apiObject))
+		}
+
+		tfMap["allowed_values"] = tfList
+	}
+
+	if v := apiObject.NestedType; v != nil {
+		tfMap["nested_type"] = []interface{}{map[string]interface{}{
+			"type":            aws.StringValue(v.Type),
+			"unit_of_measure": aws.StringValue(v.UnitOfMeasure),
+		}}
+	}
+
+	if v := apiObject.Relationship; v != nil {
+		tfMap["relationship"] = []interface{}{map[string]interface{}{
+			"relationship_type":        aws.StringValue(v.RelationshipType),
+			"target_component_type_id": aws.StringValue(v.TargetComponentTypeId),
+		}}
+	}
+
+	return tfMap
+}
+
+func expandDataValue(tfMap map[string]interface{}) *iottwinmaker.DataValue {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iottwinmaker.DataValue{}
+
+	if v, ok := tfMap["boolean_value"].(bool); ok && v {
+		apiObject.BooleanValue = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["double_value"].(float64); ok && v != 0 {
+		apiObject.DoubleValue = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["expression"].(string); ok && v != "" {
+		apiObject.Expression = aws.String(v)
+	}
+
+	if v, ok := tfMap["integer_value"].(int); ok && v != 0 {
+		apiObject.IntegerValue = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["long_value"].(int); ok && v != 0 {
+		apiObject.LongValue = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["string_value"].(string); ok && v != "" {
+		apiObject.StringValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataValue(apiObject *iottwinmaker.DataValue) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"boolean_value": aws.BoolValue(apiObject.BooleanValue),
+		"double_value":  aws.Float64Value(apiObject.DoubleValue),
+		"expression":    aws.StringValue(apiObject.Expression),
+		"integer_value": aws.Int64Value(apiObject.IntegerValue),
+		"long_value":    aws.Int64Value(apiObject.LongValue),
+		"string_value":  aws.StringValue(apiObject.StringValue),
+	}
+}
@@ -0,0 +1,322 @@
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTTwinMakerComponentType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	resourceName := "aws_iottwinmaker_component_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iottwinmaker", fmt.Sprintf("workspace/%[1]s/component-type/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "component_type_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "function.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "is_abstract", "false"),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_definition.*", map[string]string{
+						"name":             "temperature",
+						"data_type.#":      "1",
+						"data_type.0.type": "DOUBLE",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	resourceName := "aws_iottwinmaker_component_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceComponentType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	resourceName := "aws_iottwinmaker_component_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentTypeConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccComponentTypeConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerComponentType_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetComponentTypeOutput
+	resourceName := "aws_iottwinmaker_component_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentTypeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentTypeConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "For testing"),
+					resource.TestCheckResourceAttr(resourceName, "property_definition.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property_definition.*", map[string]string{
+						"name":                         "status",
+						"data_type.0.type":             "STRING",
+						"default_value.0.string_value": "OK",
+						"is_required_in_entity":        "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComponentTypeExists(ctx context.Context, n string, v *iottwinmaker.GetComponentTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT TwinMaker Component Type ID is set")
+		}
+
+		workspaceID, componentTypeID, err := tfiottwinmaker.ComponentTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn()
+
+		output, err := tfiottwinmaker.FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckComponentTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_component_type" {
+				continue
+			}
+
+			workspaceID, componentTypeID, err := tfiottwinmaker.ComponentTypeParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfiottwinmaker.FindComponentTypeByTwoPartKey(ctx, conn, workspaceID, componentTypeID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Component Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccComponentTypeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccComponentTypeConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+  description       = "For testing"
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+
+  property_definition {
+    name = "status"
+
+    data_type {
+      type = "STRING"
+    }
+
+    default_value {
+      string_value = "OK"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccComponentTypeConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccComponentTypeConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_component_type" "test" {
+  workspace_id      = aws_iottwinmaker_workspace.test.workspace_id
+  component_type_id = %[1]q
+
+  property_definition {
+    name = "temperature"
+
+    data_type {
+      type = "DOUBLE"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,653 @@
+package iottwinmaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEntity() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEntityCreate,
+		ReadWithoutTimeout:   resourceEntityRead,
+		UpdateWithoutTimeout: resourceEntityUpdate,
+		DeleteWithoutTimeout: resourceEntityDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_type_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"property": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"value": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem:     dataValueResource(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"entity_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"entity_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"has_child_entities": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"parent_entity_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEntityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	workspaceID := d.Get("workspace_id").(string)
+	name := d.Get("entity_name").(string)
+	input := &iottwinmaker.CreateEntityInput{
+		EntityName:  aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("component"); ok && v.(*schema.Set).Len() > 0 {
+		input.Components = expandComponentRequests(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entity_id"); ok {
+		input.EntityId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_entity_id"); ok {
+		input.ParentEntityId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateEntityWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Entity (%s): %s", name, err)
+	}
+
+	entityID := aws.StringValue(output.EntityId)
+	d.SetId(EntityCreateResourceID(workspaceID, entityID))
+
+	if _, err := waitEntityActive(ctx, conn, workspaceID, entityID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Entity (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEntityRead(ctx, d, meta)...)
+}
+
+func resourceEntityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	workspaceID, entityID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindEntityByTwoPartKey(ctx, conn, workspaceID, entityID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Entity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Entity (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	if err := d.Set("component", flattenComponentResponses(output.Components, configuredComponentProperties(d.Get("component").(*schema.Set).List()))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting component: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("entity_id", output.EntityId)
+	d.Set("entity_name", output.EntityName)
+	d.Set("has_child_entities", output.HasChildEntities)
+	d.Set("parent_entity_id", output.ParentEntityId)
+	if output.Status != nil {
+		d.Set("status", output.Status.State)
+	} else {
+		d.Set("status", nil)
+	}
+	d.Set("workspace_id", output.WorkspaceId)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for IoT TwinMaker Entity (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceEntityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+
+	workspaceID, entityID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iottwinmaker.UpdateEntityInput{
+			EntityId:    aws.String(entityID),
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		if d.HasChange("component") {
+			o, n := d.GetChange("component")
+			input.ComponentUpdates = expandComponentUpdateRequests(o.(*schema.Set).List(), n.(*schema.Set).List())
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("entity_name") {
+			input.EntityName = aws.String(d.Get("entity_name").(string))
+		}
+
+		if d.HasChange("parent_entity_id") {
+			if v, ok := d.GetOk("parent_entity_id"); ok {
+				input.ParentEntityUpdate = &iottwinmaker.ParentEntityUpdateRequest{
+					ParentEntityId: aws.String(v.(string)),
+					UpdateType:     aws.String(iottwinmaker.ParentEntityUpdateTypeUpdate),
+				}
+			} else {
+				input.ParentEntityUpdate = &iottwinmaker.ParentEntityUpdateRequest{
+					UpdateType: aws.String(iottwinmaker.ParentEntityUpdateTypeDelete),
+				}
+			}
+		}
+
+		_, err := conn.UpdateEntityWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Entity (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitEntityActive(ctx, conn, workspaceID, entityID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Entity (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Entity (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEntityRead(ctx, d, meta)...)
+}
+
+func resourceEntityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+
+	workspaceID, entityID, err := EntityParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Entity: %s", d.Id())
+	_, err = conn.DeleteEntityWithContext(ctx, &iottwinmaker.DeleteEntityInput{
+		EntityId:    aws.String(entityID),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT TwinMaker Entity (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitEntityDeleted(ctx, conn, workspaceID, entityID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT TwinMaker Entity (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const entityResourceIDSeparator = ","
+
+func EntityCreateResourceID(workspaceID, entityID string) string {
+	parts := []string{workspaceID, entityID}
+	id := strings.Join(parts, entityResourceIDSeparator)
+
+	return id
+}
+
+func EntityParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, entityResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected workspace-id%[2]sentity-id", id, entityResourceIDSeparator)
+}
+
+func FindEntityByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string) (*iottwinmaker.GetEntityOutput, error) {
+	input := &iottwinmaker.GetEntityInput{
+		EntityId:    aws.String(entityID),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.GetEntityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEntity(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEntityByTwoPartKey(ctx, conn, workspaceID, entityID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.State), nil
+	}
+}
+
+func waitEntityActive(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string, timeout time.Duration) (*iottwinmaker.GetEntityOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iottwinmaker.StateCreating, iottwinmaker.StateUpdating},
+		Target:  []string{iottwinmaker.StateActive},
+		Refresh: statusEntity(ctx, conn, workspaceID, entityID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetEntityOutput); ok {
+		if status := output.Status; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEntityDeleted(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, entityID string, timeout time.Duration) (*iottwinmaker.GetEntityOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iottwinmaker.StateDeleting},
+		Target:  []string{},
+		Refresh: statusEntity(ctx, conn, workspaceID, entityID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iottwinmaker.GetEntityOutput); ok {
+		if status := output.Status; status != nil && status.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Error.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandComponentRequests(tfList []interface{}) map[string]*iottwinmaker.ComponentRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*iottwinmaker.ComponentRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iottwinmaker.ComponentRequest{}
+
+		if v, ok := tfMap["component_type_id"].(string); ok && v != "" {
+			apiObject.ComponentTypeId = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["property"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Properties = expandPropertyRequests(v.List(), "")
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+// expandComponentUpdateRequests diffs the old and new component configurations into the
+// create, update and delete requests that UpdateEntity expects.
+func expandComponentUpdateRequests(oldList, newList []interface{}) map[string]*iottwinmaker.ComponentUpdateRequest {
+	oldComponents := make(map[string]map[string]interface{})
+	for _, tfMapRaw := range oldList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			oldComponents[tfMap["name"].(string)] = tfMap
+		}
+	}
+
+	apiObjects := make(map[string]*iottwinmaker.ComponentUpdateRequest)
+
+	for _, tfMapRaw := range newList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject := &iottwinmaker.ComponentUpdateRequest{}
+
+		if v, ok := tfMap["component_type_id"].(string); ok && v != "" {
+			apiObject.ComponentTypeId = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok {
+			apiObject.Description = aws.String(v)
+		}
+
+		if old, ok := oldComponents[name]; ok {
+			delete(oldComponents, name)
+
+			apiObject.UpdateType = aws.String(iottwinmaker.ComponentUpdateTypeUpdate)
+			apiObject.PropertyUpdates = expandPropertyRequests(tfMap["property"].(*schema.Set).List(), iottwinmaker.PropertyUpdateTypeUpdate)
+
+			// Properties removed from the configuration are reset to their default values.
+			for _, tfMapRaw := range old["property"].(*schema.Set).List() {
+				tfMap := tfMapRaw.(map[string]interface{})
+				propertyName := tfMap["name"].(string)
+
+				if _, ok := apiObject.PropertyUpdates[propertyName]; ok {
+					continue
+				}
+
+				if apiObject.PropertyUpdates == nil {
+					apiObject.PropertyUpdates = make(map[string]*iottwinmaker.PropertyRequest)
+				}
+
+				apiObject.PropertyUpdates[propertyName] = &iottwinmaker.PropertyRequest{
+					UpdateType: aws.String(iottwinmaker.PropertyUpdateTypeResetValue),
+				}
+			}
+		} else {
+			apiObject.UpdateType = aws.String(iottwinmaker.ComponentUpdateTypeCreate)
+			apiObject.PropertyUpdates = expandPropertyRequests(tfMap["property"].(*schema.Set).List(), "")
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	for name := range oldComponents {
+		apiObjects[name] = &iottwinmaker.ComponentUpdateRequest{
+			UpdateType: aws.String(iottwinmaker.ComponentUpdateTypeDelete),
+		}
+	}
+
+	return apiObjects
+}
+
+func expandPropertyRequests(tfList []interface{}, updateType string) map[string]*iottwinmaker.PropertyRequest {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*iottwinmaker.PropertyRequest)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iottwinmaker.PropertyRequest{}
+
+		if updateType != "" {
+			apiObject.UpdateType = aws.String(updateType)
+		}
+
+		if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Value = expandDataValue(v[0].(map[string]interface{}))
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+// configuredComponentProperties returns the property names configured for each component.
+func configuredComponentProperties(tfList []interface{}) map[string]map[string]bool {
+	configured := make(map[string]map[string]bool)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		names := make(map[string]bool)
+
+		if v, ok := tfMap["property"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				names[tfMapRaw.(map[string]interface{})["name"].(string)] = true
+			}
+		}
+
+		configured[tfMap["name"].(string)] = names
+	}
+
+	return configured
+}
+
+func flattenComponentResponses(apiObjects map[string]*iottwinmaker.ComponentResponse, configured map[string]map[string]bool) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"component_type_id": aws.StringValue(apiObject.ComponentTypeId),
+			"description":       aws.StringValue(apiObject.Description),
+			"name":              name,
+		}
+
+		// GetEntity returns every property defined by the component type.
+		// Only keep those that are configured or, on import, that have a value.
+		propertyNames, isConfigured := configured[name]
+		var properties []interface{}
+
+		for propertyName, property := range apiObject.Properties {
+			if property == nil {
+				continue
+			}
+
+			if isConfigured {
+				if !propertyNames[propertyName] {
+					continue
+				}
+			} else if property.Value == nil {
+				continue
+			}
+
+			tfProperty := map[string]interface{}{
+				"name": propertyName,
+			}
+
+			if v := property.Value; v != nil {
+				tfProperty["value"] = []interface{}{flattenDataValue(v)}
+			}
+
+			properties = append(properties, tfProperty)
+		}
+
+		tfMap["property"] = properties
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
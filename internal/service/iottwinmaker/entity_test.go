@@ -0,0 +1,308 @@
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTTwinMakerEntity_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	resourceName := "aws_iottwinmaker_entity.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "iottwinmaker", regexp.MustCompile(fmt.Sprintf("workspace/%s/entity/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "entity_id"),
+					resource.TestCheckResourceAttr(resourceName, "entity_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "has_child_entities", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parent_entity_id", "$ROOT"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerEntity_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	resourceName := "aws_iottwinmaker_entity.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceEntity(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerEntity_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	resourceName := "aws_iottwinmaker_entity.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntityConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEntityConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerEntity_component(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetEntityOutput
+	resourceName := "aws_iottwinmaker_entity.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEntityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityConfig_component(rName, 20.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*", map[string]string{
+						"name":       "sensor",
+						"property.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "component.*.component_type_id", "aws_iottwinmaker_component_type.test", "component_type_id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*.property.*", map[string]string{
+						"name":                 "temperature",
+						"value.0.double_value": "20.5",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEntityConfig_component(rName, 21.5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "component.*.property.*", map[string]string{
+						"name":                 "temperature",
+						"value.0.double_value": "21.5",
+					}),
+				),
+			},
+			{
+				Config: testAccEntityConfig_componentRemoved(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEntityExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEntityExists(ctx context.Context, n string, v *iottwinmaker.GetEntityOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT TwinMaker Entity ID is set")
+		}
+
+		workspaceID, entityID, err := tfiottwinmaker.EntityParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn()
+
+		output, err := tfiottwinmaker.FindEntityByTwoPartKey(ctx, conn, workspaceID, entityID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEntityDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_entity" {
+				continue
+			}
+
+			workspaceID, entityID, err := tfiottwinmaker.EntityParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfiottwinmaker.FindEntityByTwoPartKey(ctx, conn, workspaceID, entityID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Entity %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEntityConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = %[1]q
+}
+`, rName))
+}
+
+func testAccEntityConfig_component(rName string, temperature float64) string {
+	return acctest.ConfigCompose(testAccComponentTypeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = %[1]q
+
+  component {
+    name              = "sensor"
+    component_type_id = aws_iottwinmaker_component_type.test.component_type_id
+
+    property {
+      name = "temperature"
+
+      value {
+        double_value = %[2]g
+      }
+    }
+  }
+}
+`, rName, temperature))
+}
+
+func testAccEntityConfig_componentRemoved(rName string) string {
+	return acctest.ConfigCompose(testAccComponentTypeConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = %[1]q
+}
+`, rName))
+}
+
+func testAccEntityConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccEntityConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_entity" "test" {
+  workspace_id = aws_iottwinmaker_workspace.test.workspace_id
+  entity_name  = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
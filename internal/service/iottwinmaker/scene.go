@@ -0,0 +1,299 @@
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceScene() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSceneCreate,
+		ReadWithoutTimeout:   resourceSceneRead,
+		UpdateWithoutTimeout: resourceSceneUpdate,
+		DeleteWithoutTimeout: resourceSceneDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"content_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^s3://`), "must be an S3 URI"),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"scene_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_0-9][a-zA-Z_\-0-9]*[a-zA-Z0-9]+$`), "must start with an alphanumeric character or underscore, end with an alphanumeric character, and contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"scene_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSceneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	workspaceID := d.Get("workspace_id").(string)
+	sceneID := d.Get("scene_id").(string)
+	id := SceneCreateResourceID(workspaceID, sceneID)
+	input := &iottwinmaker.CreateSceneInput{
+		ContentLocation: aws.String(d.Get("content_location").(string)),
+		SceneId:         aws.String(sceneID),
+		WorkspaceId:     aws.String(workspaceID),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.Capabilities = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scene_metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.SceneMetadata = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateSceneWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT TwinMaker Scene (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSceneRead(ctx, d, meta)...)
+}
+
+func resourceSceneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	workspaceID, sceneID, err := SceneParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindSceneByTwoPartKey(ctx, conn, workspaceID, sceneID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT TwinMaker Scene (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.Arn)
+	d.Set("arn", arn)
+	d.Set("capabilities", aws.StringValueSlice(output.Capabilities))
+	d.Set("content_location", output.ContentLocation)
+	d.Set("description", output.Description)
+	d.Set("scene_id", output.SceneId)
+	d.Set("scene_metadata", aws.StringValueMap(output.SceneMetadata))
+	d.Set("workspace_id", output.WorkspaceId)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSceneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+
+	workspaceID, sceneID, err := SceneParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &iottwinmaker.UpdateSceneInput{
+			SceneId:     aws.String(sceneID),
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		if d.HasChange("capabilities") {
+			input.Capabilities = flex.ExpandStringSet(d.Get("capabilities").(*schema.Set))
+		}
+
+		if d.HasChange("content_location") {
+			input.ContentLocation = aws.String(d.Get("content_location").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("scene_metadata") {
+			input.SceneMetadata = flex.ExpandStringMap(d.Get("scene_metadata").(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateSceneWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Scene (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT TwinMaker Scene (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSceneRead(ctx, d, meta)...)
+}
+
+func resourceSceneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTTwinMakerConn()
+
+	workspaceID, sceneID, err := SceneParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT TwinMaker Scene: %s", d.Id())
+	_, err = conn.DeleteSceneWithContext(ctx, &iottwinmaker.DeleteSceneInput{
+		SceneId:     aws.String(sceneID),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT TwinMaker Scene (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const sceneResourceIDSeparator = ","
+
+func SceneCreateResourceID(workspaceID, sceneID string) string {
+	parts := []string{workspaceID, sceneID}
+	id := strings.Join(parts, sceneResourceIDSeparator)
+
+	return id
+}
+
+func SceneParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, sceneResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected workspace-id%[2]sscene-id", id, sceneResourceIDSeparator)
+}
+
+func FindSceneByTwoPartKey(ctx context.Context, conn *iottwinmaker.IoTTwinMaker, workspaceID, sceneID string) (*iottwinmaker.GetSceneOutput, error) {
+	input := &iottwinmaker.GetSceneInput{
+		SceneId:     aws.String(sceneID),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.GetSceneWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iottwinmaker.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,285 @@
+package iottwinmaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiottwinmaker "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIoTTwinMakerScene_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSceneOutput
+	resourceName := "aws_iottwinmaker_scene.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSceneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "iottwinmaker", fmt.Sprintf("workspace/%[1]s/scene/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "content_location", fmt.Sprintf("s3://%s/scene.json", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "scene_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", "aws_iottwinmaker_workspace.test", "workspace_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerScene_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSceneOutput
+	resourceName := "aws_iottwinmaker_scene.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSceneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiottwinmaker.ResourceScene(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerScene_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSceneOutput
+	resourceName := "aws_iottwinmaker_scene.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSceneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSceneConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSceneConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTTwinMakerScene_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iottwinmaker.GetSceneOutput
+	resourceName := "aws_iottwinmaker_scene.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, iottwinmaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSceneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSceneConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "scene_metadata.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSceneConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSceneExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "For testing"),
+					resource.TestCheckResourceAttr(resourceName, "scene_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scene_metadata.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSceneExists(ctx context.Context, n string, v *iottwinmaker.GetSceneOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT TwinMaker Scene ID is set")
+		}
+
+		workspaceID, sceneID, err := tfiottwinmaker.SceneParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn()
+
+		output, err := tfiottwinmaker.FindSceneByTwoPartKey(ctx, conn, workspaceID, sceneID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSceneDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTTwinMakerConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iottwinmaker_scene" {
+				continue
+			}
+
+			workspaceID, sceneID, err := tfiottwinmaker.SceneParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfiottwinmaker.FindSceneByTwoPartKey(ctx, conn, workspaceID, sceneID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT TwinMaker Scene %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSceneConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceConfig_basic(rName), `
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "scene.json"
+  content = jsonencode({})
+}
+`)
+}
+
+func testAccSceneConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSceneConfig_base(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+}
+`, rName))
+}
+
+func testAccSceneConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccSceneConfig_base(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+  description      = "For testing"
+
+  scene_metadata = {
+    key1 = "value1"
+  }
+}
+`, rName))
+}
+
+func testAccSceneConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSceneConfig_base(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccSceneConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSceneConfig_base(rName), fmt.Sprintf(`
+resource "aws_iottwinmaker_scene" "test" {
+  workspace_id     = aws_iottwinmaker_workspace.test.workspace_id
+  scene_id         = %[1]q
+  content_location = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,273 @@
+//go:build sweep
+// +build sweep
+
+package iottwinmaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iottwinmaker"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_iottwinmaker_component_type", &resource.Sweeper{
+		Name: "aws_iottwinmaker_component_type",
+		F:    sweepComponentTypes,
+		Dependencies: []string{
+			"aws_iottwinmaker_entity",
+		},
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_entity", &resource.Sweeper{
+		Name: "aws_iottwinmaker_entity",
+		F:    sweepEntities,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_scene", &resource.Sweeper{
+		Name: "aws_iottwinmaker_scene",
+		F:    sweepScenes,
+	})
+
+	resource.AddTestSweepers("aws_iottwinmaker_workspace", &resource.Sweeper{
+		Name: "aws_iottwinmaker_workspace",
+		F:    sweepWorkspaces,
+		Dependencies: []string{
+			"aws_iottwinmaker_component_type",
+			"aws_iottwinmaker_entity",
+			"aws_iottwinmaker_scene",
+		},
+	})
+}
+
+func sweepComponentTypes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTTwinMakerConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Component Type sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListComponentTypesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		err := conn.ListComponentTypesPagesWithContext(ctx, input, func(page *iottwinmaker.ListComponentTypesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ComponentTypeSummaries {
+				componentTypeID := aws.StringValue(v.ComponentTypeId)
+
+				// Built-in component types cannot be deleted.
+				if strings.HasPrefix(componentTypeID, "com.amazon.") {
+					continue
+				}
+
+				r := ResourceComponentType()
+				d := r.Data(nil)
+				d.SetId(ComponentTypeCreateResourceID(workspaceID, componentTypeID))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing IoT TwinMaker Component Types (%s): %w", workspaceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT TwinMaker Component Types (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepEntities(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTTwinMakerConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Entity sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListEntitiesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		err := conn.ListEntitiesPagesWithContext(ctx, input, func(page *iottwinmaker.ListEntitiesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.EntitySummaries {
+				r := ResourceEntity()
+				d := r.Data(nil)
+				d.SetId(EntityCreateResourceID(workspaceID, aws.StringValue(v.EntityId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing IoT TwinMaker Entities (%s): %w", workspaceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT TwinMaker Entities (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepScenes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTTwinMakerConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Scene sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		input := &iottwinmaker.ListScenesInput{
+			WorkspaceId: aws.String(workspaceID),
+		}
+
+		err := conn.ListScenesPagesWithContext(ctx, input, func(page *iottwinmaker.ListScenesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.SceneSummaries {
+				r := ResourceScene()
+				d := r.Data(nil)
+				d.SetId(SceneCreateResourceID(workspaceID, aws.StringValue(v.SceneId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing IoT TwinMaker Scenes (%s): %w", workspaceID, err))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IoT TwinMaker Scenes (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepWorkspaces(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IoTTwinMakerConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	workspaceIDs, err := listWorkspaceIDs(ctx, conn)
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IoT TwinMaker Workspace sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		r := ResourceWorkspace()
+		d := r.Data(nil)
+		d.SetId(workspaceID)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IoT TwinMaker Workspaces (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func listWorkspaceIDs(ctx context.Context, conn *iottwinmaker.IoTTwinMaker) ([]string, error) {
+	input := &iottwinmaker.ListWorkspacesInput{}
+	var workspaceIDs []string
+
+	err := conn.ListWorkspacesPagesWithContext(ctx, input, func(page *iottwinmaker.ListWorkspacesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.WorkspaceSummaries {
+			workspaceIDs = append(workspaceIDs, aws.StringValue(v.WorkspaceId))
+		}
+
+		return !lastPage
+	})
+
+	return workspaceIDs, err
+}
@@ -0,0 +1,34 @@
+package ivsrealtime
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindStageByID(ctx context.Context, conn *ivsrealtime.IVSRealTime, arn string) (*ivsrealtime.Stage, error) {
+	in := &ivsrealtime.GetStageInput{
+		Arn: aws.String(arn),
+	}
+	out, err := conn.GetStageWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Stage == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Stage, nil
+}
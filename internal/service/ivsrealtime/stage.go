@@ -0,0 +1,210 @@
+package ivsrealtime
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceStage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStageCreate,
+		ReadWithoutTimeout:   resourceStageRead,
+		UpdateWithoutTimeout: resourceStageUpdate,
+		DeleteWithoutTimeout: resourceStageDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active_session_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"events": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"whip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]{0,128}$`), "must contain only alphanumeric characters, hyphen, or underscore and at most 128 characters"),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameStage = "Stage"
+)
+
+func resourceStageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn()
+
+	in := &ivsrealtime.CreateStageInput{}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateStageWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.IVSRealTime, create.ErrActionCreating, ResNameStage, d.Get("name").(string), err)
+	}
+
+	if out == nil || out.Stage == nil {
+		return create.DiagError(names.IVSRealTime, create.ErrActionCreating, ResNameStage, d.Get("name").(string), errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(out.Stage.Arn))
+
+	return resourceStageRead(ctx, d, meta)
+}
+
+func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn()
+
+	out, err := FindStageByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Real-Time Stage (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.IVSRealTime, create.ErrActionReading, ResNameStage, d.Id(), err)
+	}
+
+	d.Set("active_session_id", out.ActiveSessionId)
+	d.Set("arn", out.Arn)
+
+	if err := d.Set("endpoints", flattenStageEndpoints(out.Endpoints)); err != nil {
+		return create.DiagError(names.IVSRealTime, create.ErrActionSetting, ResNameStage, d.Id(), err)
+	}
+
+	d.Set("name", out.Name)
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags := KeyValueTags(out.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.IVSRealTime, create.ErrActionSetting, ResNameStage, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.IVSRealTime, create.ErrActionSetting, ResNameStage, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceStageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn()
+
+	if d.HasChange("name") {
+		in := &ivsrealtime.UpdateStageInput{
+			Arn:  aws.String(d.Id()),
+			Name: aws.String(d.Get("name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating IVS Real-Time Stage (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateStageWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.IVSRealTime, create.ErrActionUpdating, ResNameStage, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.IVSRealTime, create.ErrActionUpdating, ResNameStage, d.Id(), err)
+		}
+	}
+
+	return resourceStageRead(ctx, d, meta)
+}
+
+func resourceStageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IVSRealTimeConn()
+
+	log.Printf("[INFO] Deleting IVS Real-Time Stage %s", d.Id())
+
+	_, err := conn.DeleteStageWithContext(ctx, &ivsrealtime.DeleteStageInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.IVSRealTime, create.ErrActionDeleting, ResNameStage, d.Id(), err)
+	}
+
+	return nil
+}
+
+func flattenStageEndpoints(apiObject *ivsrealtime.StageEndpoints) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.Events; v != nil {
+		m["events"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Whip; v != nil {
+		m["whip"] = aws.StringValue(v)
+	}
+
+	return []interface{}{m}
+}
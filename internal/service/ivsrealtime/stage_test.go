@@ -0,0 +1,291 @@
+package ivsrealtime_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivsrealtime"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfivsrealtime "github.com/hashicorp/terraform-provider-aws/internal/service/ivsrealtime"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIVSRealTimeStage_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stage ivsrealtime.Stage
+
+	resourceName := "aws_ivs_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IVSRealTime, t)
+			testAccStagePreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoints.0.events"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoints.0.whip"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "0"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivs", regexp.MustCompile(`stage/.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSRealTimeStage_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stage ivsrealtime.Stage
+
+	resourceName := "aws_ivs_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IVSRealTime, t)
+			testAccStagePreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStageConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccStageConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, resourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSRealTimeStage_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivsrealtime.Stage
+
+	resourceName := "aws_ivs_stage.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.IVSRealTime, t)
+			testAccStagePreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, resourceName, &v1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStageConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, resourceName, &v2),
+					testAccCheckStageNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIVSRealTimeStage_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stage ivsrealtime.Stage
+
+	resourceName := "aws_ivs_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(ivsrealtime.EndpointsID, t)
+			testAccStagePreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ivsrealtime.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStageConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, resourceName, &stage),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfivsrealtime.ResourceStage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStageDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ivs_stage" {
+				continue
+			}
+
+			input := &ivsrealtime.GetStageInput{
+				Arn: aws.String(rs.Primary.ID),
+			}
+			_, err := conn.GetStageWithContext(ctx, input)
+			if err != nil {
+				if tfawserr.ErrCodeEquals(err, ivsrealtime.ErrCodeResourceNotFoundException) {
+					return nil
+				}
+
+				return err
+			}
+
+			return create.Error(names.IVSRealTime, create.ErrActionCheckingDestroyed, tfivsrealtime.ResNameStage, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStageExists(ctx context.Context, name string, stage *ivsrealtime.Stage) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.IVSRealTime, create.ErrActionCheckingExistence, tfivsrealtime.ResNameStage, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IVSRealTime, create.ErrActionCheckingExistence, tfivsrealtime.ResNameStage, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeConn()
+
+		output, err := tfivsrealtime.FindStageByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.IVSRealTime, create.ErrActionCheckingExistence, tfivsrealtime.ResNameStage, rs.Primary.ID, err)
+		}
+
+		*stage = *output
+
+		return nil
+	}
+}
+
+func testAccStagePreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSRealTimeConn()
+
+	input := &ivsrealtime.ListStagesInput{}
+	_, err := conn.ListStagesWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckStageNotRecreated(before, after *ivsrealtime.Stage) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Arn), aws.StringValue(after.Arn); before != after {
+			return create.Error(names.IVSRealTime, create.ErrActionCheckingNotRecreated, tfivsrealtime.ResNameStage, before, errors.New("recreated"))
+		}
+
+		return nil
+	}
+}
+
+func testAccStageConfig_basic() string {
+	return `
+resource "aws_ivs_stage" "test" {
+}
+`
+}
+
+func testAccStageConfig_name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_stage" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccStageConfig_tags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_stage" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccStageConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_stage" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
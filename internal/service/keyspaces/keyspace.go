@@ -2,6 +2,7 @@ package keyspaces
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
 	"time"
@@ -10,9 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/keyspaces"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -34,7 +37,10 @@ func ResourceKeyspace() *schema.Resource {
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceKeyspaceReplicationSpecificationCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -53,6 +59,36 @@ func ResourceKeyspace() *schema.Resource {
 					),
 				),
 			},
+			"replication_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 6,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"replication_strategy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(keyspaces.Rs_Values(), false),
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -69,6 +105,10 @@ func resourceKeyspaceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		KeyspaceName: aws.String(name),
 	}
 
+	if v, ok := d.GetOk("replication_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ReplicationSpecification = expandReplicationSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if tags := Tags(tags.IgnoreAWS()); len(tags) > 0 {
 		// The Keyspaces API requires that when Tags is set, it's non-empty.
 		input.Tags = tags
@@ -113,6 +153,9 @@ func resourceKeyspaceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set("arn", keyspace.ResourceArn)
 	d.Set("name", keyspace.KeyspaceName)
+	if err := d.Set("replication_specification", []interface{}{flattenReplicationSpecification(keyspace)}); err != nil {
+		return diag.Errorf("setting replication_specification: %s", err)
+	}
 
 	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
 
@@ -178,3 +221,62 @@ func resourceKeyspaceDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	return nil
 }
+
+func resourceKeyspaceReplicationSpecificationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("replication_specification") {
+		return nil
+	}
+
+	strategy := diff.Get("replication_specification.0.replication_strategy").(string)
+	regions := diff.Get("replication_specification.0.region_list").(*schema.Set)
+
+	if strategy != keyspaces.RsMultiRegion {
+		return nil
+	}
+
+	if regions.Len() == 0 {
+		return fmt.Errorf("replication_specification.0.region_list must be set when replication_strategy is %q", strategy)
+	}
+
+	if region := meta.(*conns.AWSClient).Region; !regions.Contains(region) {
+		return fmt.Errorf("replication_specification.0.region_list must include the current region (%s)", region)
+	}
+
+	return nil
+}
+
+func expandReplicationSpecification(tfMap map[string]interface{}) *keyspaces.ReplicationSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.ReplicationSpecification{}
+
+	if v, ok := tfMap["region_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.RegionList = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["replication_strategy"].(string); ok && v != "" {
+		apiObject.ReplicationStrategy = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenReplicationSpecification(apiObject *keyspaces.GetKeyspaceOutput) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReplicationRegions; v != nil {
+		tfMap["region_list"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.ReplicationStrategy; v != nil {
+		tfMap["replication_strategy"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -3,6 +3,7 @@ package keyspaces_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -37,6 +38,8 @@ func TestAccKeyspacesKeyspace_basic(t *testing.T) {
 					testAccCheckKeyspaceExists(ctx, resourceName),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "cassandra", "/keyspace/"+rName+"/"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "SINGLE_REGION"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
 			},
@@ -117,6 +120,64 @@ func TestAccKeyspacesKeyspace_tags(t *testing.T) {
 	})
 }
 
+func TestAccKeyspacesKeyspace_replicationSpecificationMulti(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+	resourceName := "aws_keyspaces_keyspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyspaceConfig_replicationSpecificationMulti(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyspaceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.region_list.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.AlternateRegion()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "replication_specification.0.region_list.*", acctest.ThirdRegion()),
+					resource.TestCheckResourceAttr(resourceName, "replication_specification.0.replication_strategy", "MULTI_REGION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKeyspacesKeyspace_replicationSpecificationMissingRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "tf_acc_test_" + sdkacctest.RandString(20)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, keyspaces.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyspaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKeyspaceConfig_replicationSpecificationMissingRegion(rName),
+				ExpectError: regexp.MustCompile(`region_list must include the current region`),
+			},
+		},
+	})
+}
+
 func testAccCheckKeyspaceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).KeyspacesConn()
@@ -194,3 +255,29 @@ resource "aws_keyspaces_keyspace" "test" {
 }
 `, rName, tag1Key, tag1Value, tag2Key, tag2Value)
 }
+
+func testAccKeyspaceConfig_replicationSpecificationMulti(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    region_list          = [%[2]q, %[3]q, %[4]q]
+    replication_strategy = "MULTI_REGION"
+  }
+}
+`, rName, acctest.Region(), acctest.AlternateRegion(), acctest.ThirdRegion())
+}
+
+func testAccKeyspaceConfig_replicationSpecificationMissingRegion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_keyspaces_keyspace" "test" {
+  name = %[1]q
+
+  replication_specification {
+    region_list          = [%[2]q, %[3]q]
+    replication_strategy = "MULTI_REGION"
+  }
+}
+`, rName, acctest.AlternateRegion(), acctest.ThirdRegion())
+}
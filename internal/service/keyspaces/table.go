@@ -52,6 +52,11 @@ func ResourceTable() *schema.Resource {
 
 				return false
 			}),
+			customdiff.ForceNewIfChange("client_side_timestamps", func(_ context.Context, o, n, meta interface{}) bool {
+				// Client-side timestamps cannot be disabled.
+				return len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0
+			}),
+			resourceTableAutoScalingSpecificationCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -60,6 +65,30 @@ func ResourceTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_scaling_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_capacity_auto_scaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem:     tableAutoScalingSettingsSchema(),
+						},
+						"write_capacity_auto_scaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem:     tableAutoScalingSettingsSchema(),
+						},
+					},
+				},
+			},
 			"capacity_specification": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -86,6 +115,20 @@ func ResourceTable() *schema.Resource {
 					},
 				},
 			},
+			"client_side_timestamps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(keyspaces.ClientSideTimestampsStatus_Values(), false),
+						},
+					},
+				},
+			},
 			"comment": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -294,6 +337,69 @@ func ResourceTable() *schema.Resource {
 	}
 }
 
+func tableAutoScalingSettingsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"maximum_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"minimum_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"scaling_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_tracking_scaling_policy_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disable_scale_in": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+									"scale_in_cooldown": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"scale_out_cooldown": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"target_value": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ValidateFunc: validation.FloatBetween(20, 90),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).KeyspacesConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -307,10 +413,18 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		TableName:    aws.String(tableName),
 	}
 
+	if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoScalingSpecification = expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("capacity_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.CapacitySpecification = expandCapacitySpecification(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("client_side_timestamps"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ClientSideTimestamps = expandClientSideTimestamps(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("comment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Comment = expandComment(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -380,6 +494,29 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	d.Set("arn", table.ResourceArn)
+	if table.CapacitySpecification != nil && aws.StringValue(table.CapacitySpecification.ThroughputMode) == keyspaces.ThroughputModeProvisioned {
+		output, err := FindTableAutoScalingSettingsByTwoPartKey(ctx, conn, keyspaceName, tableName)
+
+		// Tables without auto scaling must not require permission to read auto scaling settings.
+		if _, ok := d.GetOk("auto_scaling_specification"); !ok && tfawserr.ErrCodeEquals(err, keyspaces.ErrCodeAccessDeniedException) {
+			log.Printf("[WARN] reading Keyspaces Table (%s) auto scaling settings: %s", d.Id(), err)
+			output, err = &keyspaces.GetTableAutoScalingSettingsOutput{}, nil
+		}
+
+		if err != nil {
+			return diag.Errorf("reading Keyspaces Table (%s) auto scaling settings: %s", d.Id(), err)
+		}
+
+		if output.AutoScalingSpecification != nil {
+			if err := d.Set("auto_scaling_specification", []interface{}{flattenAutoScalingSpecification(output.AutoScalingSpecification)}); err != nil {
+				return diag.Errorf("setting auto_scaling_specification: %s", err)
+			}
+		} else {
+			d.Set("auto_scaling_specification", nil)
+		}
+	} else {
+		d.Set("auto_scaling_specification", nil)
+	}
 	if table.CapacitySpecification != nil {
 		if err := d.Set("capacity_specification", []interface{}{flattenCapacitySpecificationSummary(table.CapacitySpecification)}); err != nil {
 			return diag.Errorf("setting capacity_specification: %s", err)
@@ -387,6 +524,13 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	} else {
 		d.Set("capacity_specification", nil)
 	}
+	if table.ClientSideTimestamps != nil {
+		if err := d.Set("client_side_timestamps", []interface{}{flattenClientSideTimestamps(table.ClientSideTimestamps)}); err != nil {
+			return diag.Errorf("setting client_side_timestamps: %s", err)
+		}
+	} else {
+		d.Set("client_side_timestamps", nil)
+	}
 	if table.Comment != nil {
 		if err := d.Set("comment", []interface{}{flattenComment(table.Comment)}); err != nil {
 			return diag.Errorf("setting comment: %s", err)
@@ -479,6 +623,48 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			}
 		}
 
+		if d.HasChange("auto_scaling_specification") {
+			if v, ok := d.GetOk("auto_scaling_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input := &keyspaces.UpdateTableInput{
+					AutoScalingSpecification: expandAutoScalingSpecification(v.([]interface{})[0].(map[string]interface{})),
+					KeyspaceName:             aws.String(keyspaceName),
+					TableName:                aws.String(tableName),
+				}
+
+				log.Printf("[DEBUG] Updating Keyspaces Table: %s", input)
+				_, err := conn.UpdateTableWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("updating Keyspaces Table (%s) AutoScalingSpecification: %s", d.Id(), err)
+				}
+
+				if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.Errorf("waiting for Keyspaces Table (%s) AutoScalingSpecification update: %s", d.Id(), err)
+				}
+			}
+		}
+
+		if d.HasChange("client_side_timestamps") {
+			if v, ok := d.GetOk("client_side_timestamps"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input := &keyspaces.UpdateTableInput{
+					ClientSideTimestamps: expandClientSideTimestamps(v.([]interface{})[0].(map[string]interface{})),
+					KeyspaceName:         aws.String(keyspaceName),
+					TableName:            aws.String(tableName),
+				}
+
+				log.Printf("[DEBUG] Updating Keyspaces Table: %s", input)
+				_, err := conn.UpdateTableWithContext(ctx, input)
+
+				if err != nil {
+					return diag.Errorf("updating Keyspaces Table (%s) ClientSideTimestamps: %s", d.Id(), err)
+				}
+
+				if _, err := waitTableUpdated(ctx, conn, keyspaceName, tableName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.Errorf("waiting for Keyspaces Table (%s) ClientSideTimestamps update: %s", d.Id(), err)
+				}
+			}
+		}
+
 		if d.HasChange("default_time_to_live") {
 			input := &keyspaces.UpdateTableInput{
 				DefaultTimeToLive: aws.Int64(int64(d.Get("default_time_to_live").(int))),
@@ -640,6 +826,24 @@ func resourceTableDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceTableAutoScalingSpecificationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// auto_scaling_specification is Computed, so only validate it when it's configured.
+	if raw := diff.GetRawConfig().GetAttr("auto_scaling_specification"); !raw.IsKnown() || raw.IsNull() || raw.LengthInt() == 0 {
+		return nil
+	}
+
+	if !diff.NewValueKnown("capacity_specification") {
+		return nil
+	}
+
+	// Auto scaling is only supported for tables in provisioned capacity mode.
+	if v := diff.Get("capacity_specification.0.throughput_mode").(string); v != keyspaces.ThroughputModeProvisioned {
+		return fmt.Errorf("auto_scaling_specification requires capacity_specification.0.throughput_mode to be %q, got %q", keyspaces.ThroughputModeProvisioned, v)
+	}
+
+	return nil
+}
+
 const tableIDSeparator = "/"
 
 func TableCreateResourceID(keyspaceName, tableName string) string {
@@ -727,6 +931,90 @@ func waitTableUpdated(ctx context.Context, conn *keyspaces.Keyspaces, keyspaceNa
 	return nil, err
 }
 
+func expandAutoScalingSpecification(tfMap map[string]interface{}) *keyspaces.AutoScalingSpecification {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.AutoScalingSpecification{}
+
+	if v, ok := tfMap["read_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ReadCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["write_capacity_auto_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WriteCapacityAutoScaling = expandAutoScalingSettings(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingSettings(tfMap map[string]interface{}) *keyspaces.AutoScalingSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.AutoScalingSettings{}
+
+	if v, ok := tfMap["auto_scaling_disabled"].(bool); ok {
+		apiObject.AutoScalingDisabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["maximum_units"].(int); ok && v != 0 {
+		apiObject.MaximumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum_units"].(int); ok && v != 0 {
+		apiObject.MinimumUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scaling_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ScalingPolicy = expandAutoScalingPolicy(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingPolicy(tfMap map[string]interface{}) *keyspaces.AutoScalingPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.AutoScalingPolicy{}
+
+	if v, ok := tfMap["target_tracking_scaling_policy_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TargetTrackingScalingPolicyConfiguration = expandTargetTrackingScalingPolicyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTargetTrackingScalingPolicyConfiguration(tfMap map[string]interface{}) *keyspaces.TargetTrackingScalingPolicyConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.TargetTrackingScalingPolicyConfiguration{}
+
+	if v, ok := tfMap["disable_scale_in"].(bool); ok {
+		apiObject.DisableScaleIn = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["scale_in_cooldown"].(int); ok && v != 0 {
+		apiObject.ScaleInCooldown = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scale_out_cooldown"].(int); ok && v != 0 {
+		apiObject.ScaleOutCooldown = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["target_value"].(float64); ok {
+		apiObject.TargetValue = aws.Float64(v)
+	}
+
+	return apiObject
+}
+
 func expandCapacitySpecification(tfMap map[string]interface{}) *keyspaces.CapacitySpecification {
 	if tfMap == nil {
 		return nil
@@ -749,6 +1037,20 @@ func expandCapacitySpecification(tfMap map[string]interface{}) *keyspaces.Capaci
 	return apiObject
 }
 
+func expandClientSideTimestamps(tfMap map[string]interface{}) *keyspaces.ClientSideTimestamps {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &keyspaces.ClientSideTimestamps{}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func expandComment(tfMap map[string]interface{}) *keyspaces.Comment {
 	if tfMap == nil {
 		return nil
@@ -1003,6 +1305,90 @@ func expandStaticColumns(tfList []interface{}) []*keyspaces.StaticColumn {
 	return apiObjects
 }
 
+func flattenAutoScalingSpecification(apiObject *keyspaces.AutoScalingSpecification) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ReadCapacityAutoScaling; v != nil {
+		tfMap["read_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	if v := apiObject.WriteCapacityAutoScaling; v != nil {
+		tfMap["write_capacity_auto_scaling"] = []interface{}{flattenAutoScalingSettings(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAutoScalingSettings(apiObject *keyspaces.AutoScalingSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutoScalingDisabled; v != nil {
+		tfMap["auto_scaling_disabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.MaximumUnits; v != nil {
+		tfMap["maximum_units"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MinimumUnits; v != nil {
+		tfMap["minimum_units"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ScalingPolicy; v != nil {
+		tfMap["scaling_policy"] = []interface{}{flattenAutoScalingPolicy(v)}
+	}
+
+	return tfMap
+}
+
+func flattenAutoScalingPolicy(apiObject *keyspaces.AutoScalingPolicy) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.TargetTrackingScalingPolicyConfiguration; v != nil {
+		tfMap["target_tracking_scaling_policy_configuration"] = []interface{}{flattenTargetTrackingScalingPolicyConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTargetTrackingScalingPolicyConfiguration(apiObject *keyspaces.TargetTrackingScalingPolicyConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DisableScaleIn; v != nil {
+		tfMap["disable_scale_in"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.ScaleInCooldown; v != nil {
+		tfMap["scale_in_cooldown"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ScaleOutCooldown; v != nil {
+		tfMap["scale_out_cooldown"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.TargetValue; v != nil {
+		tfMap["target_value"] = aws.Float64Value(v)
+	}
+
+	return tfMap
+}
+
 func flattenCapacitySpecificationSummary(apiObject *keyspaces.CapacitySpecificationSummary) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1025,6 +1411,20 @@ func flattenCapacitySpecificationSummary(apiObject *keyspaces.CapacitySpecificat
 	return tfMap
 }
 
+func flattenClientSideTimestamps(apiObject *keyspaces.ClientSideTimestamps) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func flattenComment(apiObject *keyspaces.Comment) map[string]interface{} {
 	if apiObject == nil {
 		return nil
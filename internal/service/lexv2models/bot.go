@@ -0,0 +1,432 @@
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceBot() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotCreate,
+		ReadWithoutTimeout:   resourceBotRead,
+		UpdateWithoutTimeout: resourceBotUpdate,
+		DeleteWithoutTimeout: resourceBotDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_privacy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_directed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"alias_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lexmodelsv2.BotType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceBotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateBotInput{
+		BotName:                 aws.String(name),
+		DataPrivacy:             expandDataPrivacy(d.Get("data_privacy").([]interface{})),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("members"); ok && len(v.([]interface{})) > 0 {
+		input.BotMembers = expandBotMembers(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.BotType = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.BotTags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateBotWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Models Bot (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BotId))
+
+	if _, err := waitBotAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Models Bot (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBotRead(ctx, d, meta)...)
+}
+
+func resourceBotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindBotByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Bot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Models Bot (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "lex",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("bot/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	if err := d.Set("data_privacy", flattenDataPrivacy(output.DataPrivacy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_privacy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+	if err := d.Set("members", flattenBotMembers(output.BotMembers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting members: %s", err)
+	}
+	d.Set("name", output.BotName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("type", output.BotType)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Lex V2 Models Bot (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceBotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &lexmodelsv2.UpdateBotInput{
+			BotId:                   aws.String(d.Id()),
+			BotName:                 aws.String(d.Get("name").(string)),
+			BotType:                 aws.String(d.Get("type").(string)),
+			DataPrivacy:             expandDataPrivacy(d.Get("data_privacy").([]interface{})),
+			Description:             aws.String(d.Get("description").(string)),
+			IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+			RoleArn:                 aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("members"); ok && len(v.([]interface{})) > 0 {
+			input.BotMembers = expandBotMembers(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateBotWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lex V2 Models Bot (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitBotAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Models Bot (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lex V2 Models Bot (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBotRead(ctx, d, meta)...)
+}
+
+func resourceBotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	log.Printf("[DEBUG] Deleting Lex V2 Models Bot: %s", d.Id())
+	_, err := conn.DeleteBotWithContext(ctx, &lexmodelsv2.DeleteBotInput{
+		BotId:                  aws.String(d.Id()),
+		SkipResourceInUseCheck: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Models Bot (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Models Bot (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindBotByID(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string) (*lexmodelsv2.DescribeBotOutput, error) {
+	input := &lexmodelsv2.DescribeBotInput{
+		BotId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBotWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusBot(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotStatus), nil
+	}
+}
+
+func waitBotAvailable(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusCreating, lexmodelsv2.BotStatusUpdating},
+		Target:  []string{lexmodelsv2.BotStatusAvailable},
+		Refresh: statusBot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, id string, timeout time.Duration) (*lexmodelsv2.DescribeBotOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotStatusAvailable, lexmodelsv2.BotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBot(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandDataPrivacy(tfList []interface{}) *lexmodelsv2.DataPrivacy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &lexmodelsv2.DataPrivacy{
+		ChildDirected: aws.Bool(tfMap["child_directed"].(bool)),
+	}
+}
+
+func flattenDataPrivacy(apiObject *lexmodelsv2.DataPrivacy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"child_directed": aws.BoolValue(apiObject.ChildDirected),
+	}}
+}
+
+func expandBotMembers(tfList []interface{}) []*lexmodelsv2.BotMember {
+	var apiObjects []*lexmodelsv2.BotMember
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.BotMember{
+			BotMemberAliasId:   aws.String(tfMap["alias_id"].(string)),
+			BotMemberAliasName: aws.String(tfMap["alias_name"].(string)),
+			BotMemberId:        aws.String(tfMap["id"].(string)),
+			BotMemberName:      aws.String(tfMap["name"].(string)),
+			BotMemberVersion:   aws.String(tfMap["version"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenBotMembers(apiObjects []*lexmodelsv2.BotMember) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"alias_id":   aws.StringValue(apiObject.BotMemberAliasId),
+			"alias_name": aws.StringValue(apiObject.BotMemberAliasName),
+			"id":         aws.StringValue(apiObject.BotMemberId),
+			"name":       aws.StringValue(apiObject.BotMemberName),
+			"version":    aws.StringValue(apiObject.BotMemberVersion),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,361 @@
+package lexv2models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceBotLocale() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBotLocaleCreate,
+		ReadWithoutTimeout:   resourceBotLocaleRead,
+		UpdateWithoutTimeout: resourceBotLocaleUpdate,
+		DeleteWithoutTimeout: resourceBotLocaleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "DRAFT",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nlu_intent_confidence_threshold": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"voice_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.VoiceEngine_Values(), false),
+						},
+						"voice_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBotLocaleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+	id := BotLocaleCreateResourceID(botID, botVersion, localeID)
+	input := &lexmodelsv2.CreateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VoiceSettings = expandVoiceSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.CreateBotLocaleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Models Bot Locale (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitBotLocaleCreated(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Models Bot Locale (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBotLocaleRead(ctx, d, meta)...)
+}
+
+func resourceBotLocaleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Bot Locale (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("nlu_intent_confidence_threshold", output.NluIntentConfidenceThreshold)
+	d.Set("name", output.LocaleName)
+	if output.VoiceSettings != nil {
+		if err := d.Set("voice_settings", []interface{}{flattenVoiceSettings(output.VoiceSettings)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting voice_settings: %s", err)
+		}
+	} else {
+		d.Set("voice_settings", nil)
+	}
+
+	return diags
+}
+
+func resourceBotLocaleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	input := &lexmodelsv2.UpdateBotLocaleInput{
+		BotId:                        aws.String(botID),
+		BotVersion:                   aws.String(botVersion),
+		Description:                  aws.String(d.Get("description").(string)),
+		LocaleId:                     aws.String(localeID),
+		NluIntentConfidenceThreshold: aws.Float64(d.Get("nlu_intent_confidence_threshold").(float64)),
+	}
+
+	if v, ok := d.GetOk("voice_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.VoiceSettings = expandVoiceSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err = conn.UpdateBotLocaleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotLocaleCreated(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Models Bot Locale (%s) update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBotLocaleRead(ctx, d, meta)...)
+}
+
+func resourceBotLocaleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, localeID, err := BotLocaleParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Models Bot Locale: %s", d.Id())
+	_, err = conn.DeleteBotLocaleWithContext(ctx, &lexmodelsv2.DeleteBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Models Bot Locale (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBotLocaleDeleted(ctx, conn, botID, botVersion, localeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lex V2 Models Bot Locale (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const botLocaleResourceIDSeparator = ","
+
+func BotLocaleCreateResourceID(botID, botVersion, localeID string) string {
+	parts := []string{botID, botVersion, localeID}
+	id := strings.Join(parts, botLocaleResourceIDSeparator)
+
+	return id
+}
+
+func BotLocaleParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, botLocaleResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected bot-id%[2]sbot-version%[2]slocale-id", id, botLocaleResourceIDSeparator)
+}
+
+func FindBotLocaleByThreePartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	input := &lexmodelsv2.DescribeBotLocaleInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+	}
+
+	output, err := conn.DescribeBotLocaleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusBotLocale(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBotLocaleByThreePartKey(ctx, conn, botID, botVersion, localeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.BotLocaleStatus), nil
+	}
+}
+
+func waitBotLocaleCreated(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusCreating, lexmodelsv2.BotLocaleStatusBuilding, lexmodelsv2.BotLocaleStatusProcessing},
+		Target:  []string{lexmodelsv2.BotLocaleStatusNotBuilt, lexmodelsv2.BotLocaleStatusBuilt, lexmodelsv2.BotLocaleStatusReadyExpressTesting},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(output.FailureReasons), "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBotLocaleDeleted(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID string, timeout time.Duration) (*lexmodelsv2.DescribeBotLocaleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lexmodelsv2.BotLocaleStatusDeleting},
+		Target:  []string{},
+		Refresh: statusBotLocale(ctx, conn, botID, botVersion, localeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*lexmodelsv2.DescribeBotLocaleOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandVoiceSettings(tfMap map[string]interface{}) *lexmodelsv2.VoiceSettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lexmodelsv2.VoiceSettings{
+		VoiceId: aws.String(tfMap["voice_id"].(string)),
+	}
+
+	if v, ok := tfMap["engine"].(string); ok && v != "" {
+		apiObject.Engine = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenVoiceSettings(apiObject *lexmodelsv2.VoiceSettings) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"engine":   aws.StringValue(apiObject.Engine),
+		"voice_id": aws.StringValue(apiObject.VoiceId),
+	}
+}
@@ -0,0 +1,438 @@
+package lexv2models
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSlotType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSlotTypeCreate,
+		ReadWithoutTimeout:   resourceSlotTypeRead,
+		UpdateWithoutTimeout: resourceSlotTypeUpdate,
+		DeleteWithoutTimeout: resourceSlotTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bot_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "DRAFT",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"locale_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"parent_slot_type_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"slot_type_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"slot_type_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sample_value": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 140),
+									},
+								},
+							},
+						},
+						"synonyms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 140),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"value_selection_setting": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resolution_strategy": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lexmodelsv2.SlotValueResolutionStrategy_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSlotTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID := d.Get("bot_id").(string)
+	botVersion := d.Get("bot_version").(string)
+	localeID := d.Get("locale_id").(string)
+	name := d.Get("name").(string)
+	input := &lexmodelsv2.CreateSlotTypeInput{
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(botVersion),
+		LocaleId:     aws.String(localeID),
+		SlotTypeName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_values"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandSlotTypeValues(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_selection_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueSelectionSetting = expandSlotValueSelectionSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateSlotTypeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lex V2 Models Slot Type (%s): %s", name, err)
+	}
+
+	d.SetId(SlotTypeCreateResourceID(botID, botVersion, localeID, aws.StringValue(output.SlotTypeId)))
+
+	return append(diags, resourceSlotTypeRead(ctx, d, meta)...)
+}
+
+func resourceSlotTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, localeID, slotTypeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindSlotTypeByFourPartKey(ctx, conn, botID, botVersion, localeID, slotTypeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lex V2 Models Slot Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bot_id", output.BotId)
+	d.Set("bot_version", output.BotVersion)
+	d.Set("description", output.Description)
+	d.Set("locale_id", output.LocaleId)
+	d.Set("name", output.SlotTypeName)
+	d.Set("parent_slot_type_signature", output.ParentSlotTypeSignature)
+	d.Set("slot_type_id", output.SlotTypeId)
+	if err := d.Set("slot_type_values", flattenSlotTypeValues(output.SlotTypeValues)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting slot_type_values: %s", err)
+	}
+	if output.ValueSelectionSetting != nil {
+		if err := d.Set("value_selection_setting", []interface{}{flattenSlotValueSelectionSetting(output.ValueSelectionSetting)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting value_selection_setting: %s", err)
+		}
+	} else {
+		d.Set("value_selection_setting", nil)
+	}
+
+	return diags
+}
+
+func resourceSlotTypeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, localeID, slotTypeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	input := &lexmodelsv2.UpdateSlotTypeInput{
+		BotId:        aws.String(botID),
+		BotVersion:   aws.String(botVersion),
+		Description:  aws.String(d.Get("description").(string)),
+		LocaleId:     aws.String(localeID),
+		SlotTypeId:   aws.String(slotTypeID),
+		SlotTypeName: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("parent_slot_type_signature"); ok {
+		input.ParentSlotTypeSignature = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("slot_type_values"); ok && len(v.([]interface{})) > 0 {
+		input.SlotTypeValues = expandSlotTypeValues(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("value_selection_setting"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ValueSelectionSetting = expandSlotValueSelectionSetting(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err = conn.UpdateSlotTypeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSlotTypeRead(ctx, d, meta)...)
+}
+
+func resourceSlotTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LexModelsV2Conn()
+
+	botID, botVersion, localeID, slotTypeID, err := SlotTypeParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lex V2 Models Slot Type: %s", d.Id())
+	_, err = conn.DeleteSlotTypeWithContext(ctx, &lexmodelsv2.DeleteSlotTypeInput{
+		BotId:                  aws.String(botID),
+		BotVersion:             aws.String(botVersion),
+		LocaleId:               aws.String(localeID),
+		SkipResourceInUseCheck: aws.Bool(true),
+		SlotTypeId:             aws.String(slotTypeID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lex V2 Models Slot Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const slotTypeResourceIDSeparator = ","
+
+func SlotTypeCreateResourceID(botID, botVersion, localeID, slotTypeID string) string {
+	parts := []string{botID, botVersion, localeID, slotTypeID}
+	id := strings.Join(parts, slotTypeResourceIDSeparator)
+
+	return id
+}
+
+func SlotTypeParseResourceID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, slotTypeResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected bot-id%[2]sbot-version%[2]slocale-id%[2]sslot-type-id", id, slotTypeResourceIDSeparator)
+}
+
+func FindSlotTypeByFourPartKey(ctx context.Context, conn *lexmodelsv2.LexModelsV2, botID, botVersion, localeID, slotTypeID string) (*lexmodelsv2.DescribeSlotTypeOutput, error) {
+	input := &lexmodelsv2.DescribeSlotTypeInput{
+		BotId:      aws.String(botID),
+		BotVersion: aws.String(botVersion),
+		LocaleId:   aws.String(localeID),
+		SlotTypeId: aws.String(slotTypeID),
+	}
+
+	output, err := conn.DescribeSlotTypeWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lexmodelsv2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandSampleValue(tfList []interface{}) *lexmodelsv2.SampleValue {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &lexmodelsv2.SampleValue{
+		Value: aws.String(tfMap["value"].(string)),
+	}
+}
+
+func flattenSampleValue(apiObject *lexmodelsv2.SampleValue) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"value": aws.StringValue(apiObject.Value),
+	}}
+}
+
+func expandSampleValues(tfList []interface{}) []*lexmodelsv2.SampleValue {
+	var apiObjects []*lexmodelsv2.SampleValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &lexmodelsv2.SampleValue{
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenSampleValues(apiObjects []*lexmodelsv2.SampleValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func expandSlotTypeValues(tfList []interface{}) []*lexmodelsv2.SlotTypeValue {
+	var apiObjects []*lexmodelsv2.SlotTypeValue
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lexmodelsv2.SlotTypeValue{
+			SampleValue: expandSampleValue(tfMap["sample_value"].([]interface{})),
+		}
+
+		if v, ok := tfMap["synonyms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Synonyms = expandSampleValues(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenSlotTypeValues(apiObjects []*lexmodelsv2.SlotTypeValue) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"sample_value": flattenSampleValue(apiObject.SampleValue),
+			"synonyms":     flattenSampleValues(apiObject.Synonyms),
+		})
+	}
+
+	return tfList
+}
+
+func expandSlotValueSelectionSetting(tfMap map[string]interface{}) *lexmodelsv2.SlotValueSelectionSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &lexmodelsv2.SlotValueSelectionSetting{
+		ResolutionStrategy: aws.String(tfMap["resolution_strategy"].(string)),
+	}
+}
+
+func flattenSlotValueSelectionSetting(apiObject *lexmodelsv2.SlotValueSelectionSetting) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"resolution_strategy": aws.StringValue(apiObject.ResolutionStrategy),
+	}
+}
@@ -0,0 +1,145 @@
+package lexv2models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lexmodelsv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflexv2models "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLexV2ModelsSlotType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lexmodelsv2.DescribeSlotTypeOutput
+	resourceName := "aws_lexv2models_slot_type.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lexmodelsv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlotTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlotTypeConfig_basic(rName, "OriginalValue"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_id", "aws_lexv2models_bot.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bot_version", "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, "locale_id", "en_US"),
+					resource.TestCheckResourceAttr(resourceName, "name", "FlowerTypes"),
+					resource.TestCheckResourceAttrSet(resourceName, "slot_type_id"),
+					resource.TestCheckResourceAttr(resourceName, "slot_type_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "slot_type_values.0.sample_value.0.value", "tulips"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_setting.0.resolution_strategy", "OriginalValue"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlotTypeConfig_basic(rName, "TopResolution"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSlotTypeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_setting.0.resolution_strategy", "TopResolution"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSlotTypeExists(ctx context.Context, n string, v *lexmodelsv2.DescribeSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex V2 Models Slot Type ID is set")
+		}
+
+		botID, botVersion, localeID, slotTypeID, err := tflexv2models.SlotTypeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		output, err := tflexv2models.FindSlotTypeByFourPartKey(ctx, conn, botID, botVersion, localeID, slotTypeID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSlotTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelsV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lexv2models_slot_type" {
+				continue
+			}
+
+			botID, botVersion, localeID, slotTypeID, err := tflexv2models.SlotTypeParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflexv2models.FindSlotTypeByFourPartKey(ctx, conn, botID, botVersion, localeID, slotTypeID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lex V2 Models Slot Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSlotTypeConfig_basic(rName, resolutionStrategy string) string {
+	return acctest.ConfigCompose(testAccBotLocaleConfig_base(rName), fmt.Sprintf(`
+resource "aws_lexv2models_slot_type" "test" {
+  bot_id    = aws_lexv2models_bot.test.id
+  locale_id = aws_lexv2models_bot_locale.test.locale_id
+  name      = "FlowerTypes"
+
+  slot_type_values {
+    sample_value {
+      value = "tulips"
+    }
+
+    synonyms {
+      value = "tulip"
+    }
+  }
+
+  value_selection_setting {
+    resolution_strategy = %[1]q
+  }
+}
+`, resolutionStrategy))
+}
@@ -0,0 +1,45 @@
+package macie_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMacieMemberAccountAssociation_retired(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "aws_macie_member_account_association" "test" {
+  member_account_id = "123456789012"
+}
+`,
+				ExpectError: regexp.MustCompile(`retirement of Amazon Macie Classic`),
+			},
+		},
+	})
+}
+
+func TestAccMacieS3BucketAssociation_retired(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "aws_macie_s3_bucket_association" "test" {
+  bucket_name = "tf-test-macie-bucket"
+}
+`,
+				ExpectError: regexp.MustCompile(`retirement of Amazon Macie Classic`),
+			},
+		},
+	})
+}
@@ -4,12 +4,8 @@ import (
 	"context"
 	"log"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/macie"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -28,77 +24,27 @@ func ResourceMemberAccountAssociation() *schema.Resource {
 				ValidateFunc: verify.ValidAccountID,
 			},
 		},
+
+		DeprecationMessage: `With the retirement of Amazon Macie Classic the aws_macie_member_account_association resource has been deprecated and will be removed in the next major version. Use the aws_macie2_member resource instead.`,
 	}
 }
 
 func resourceMemberAccountAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).MacieConn()
-
-	memberAccountId := d.Get("member_account_id").(string)
-	req := &macie.AssociateMemberAccountInput{
-		MemberAccountId: aws.String(memberAccountId),
-	}
-
-	log.Printf("[DEBUG] Creating Macie member account association: %#v", req)
-	_, err := conn.AssociateMemberAccountWithContext(ctx, req)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Macie member account association: %s", err)
-	}
-
-	d.SetId(memberAccountId)
-	return append(diags, resourceMemberAccountAssociationRead(ctx, d, meta)...)
+	return sdkdiag.AppendErrorf(diags, `with the retirement of Amazon Macie Classic no new Macie member account associations can be created`)
 }
 
 func resourceMemberAccountAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).MacieConn()
-
-	req := &macie.ListMemberAccountsInput{}
-
-	var res *macie.MemberAccount
-	err := conn.ListMemberAccountsPagesWithContext(ctx, req, func(page *macie.ListMemberAccountsOutput, lastPage bool) bool {
-		for _, v := range page.MemberAccounts {
-			if aws.StringValue(v.AccountId) == d.Get("member_account_id").(string) {
-				res = v
-				return false
-			}
-		}
-
-		return true
-	})
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "listing Macie member account associations: %s", err)
-	}
-
-	if res == nil {
-		log.Printf("[WARN] Macie member account association (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
-	}
 
+	// The Macie Classic API is no longer available, so existing state is kept as-is.
 	return diags
 }
 
 func resourceMemberAccountAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).MacieConn()
-
-	log.Printf("[DEBUG] Deleting Macie member account association: %s", d.Id())
 
-	_, err := conn.DisassociateMemberAccountWithContext(ctx, &macie.DisassociateMemberAccountInput{
-		MemberAccountId: aws.String(d.Get("member_account_id").(string)),
-	})
-	if err != nil {
-		if tfawserr.ErrMessageContains(err, macie.ErrCodeInvalidInputException, "is a master Macie account and cannot be disassociated") {
-			log.Printf("[INFO] Macie master account (%s) cannot be disassociated, removing from state", d.Id())
-			return diags
-		} else if tfawserr.ErrMessageContains(err, macie.ErrCodeInvalidInputException, "is not yet associated with Macie") {
-			return diags
-		} else {
-			return sdkdiag.AppendErrorf(diags, "deleting Macie member account association: %s", err)
-		}
-	}
+	log.Printf("[WARN] Amazon Macie Classic has been retired, removing Macie member account association (%s) from state", d.Id())
 
 	return diags
 }
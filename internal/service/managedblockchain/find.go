@@ -0,0 +1,152 @@
+package managedblockchain
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindNetworkByID(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string) (*managedblockchain.Network, error) {
+	in := &managedblockchain.GetNetworkInput{
+		NetworkId: aws.String(id),
+	}
+	out, err := conn.GetNetworkWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Network == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := aws.StringValue(out.Network.Status); status == managedblockchain.NetworkStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: in,
+		}
+	}
+
+	return out.Network, nil
+}
+
+func FindMemberByTwoPartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string) (*managedblockchain.Member, error) {
+	in := &managedblockchain.GetMemberInput{
+		MemberId:  aws.String(memberID),
+		NetworkId: aws.String(networkID),
+	}
+	out, err := conn.GetMemberWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Member == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := aws.StringValue(out.Member.Status); status == managedblockchain.MemberStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: in,
+		}
+	}
+
+	return out.Member, nil
+}
+
+// FindOwnedMemberByNetworkID returns the member of the network that is owned by the current account.
+func FindOwnedMemberByNetworkID(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID string) (*managedblockchain.MemberSummary, error) {
+	in := &managedblockchain.ListMembersInput{
+		IsOwned:   aws.Bool(true),
+		NetworkId: aws.String(networkID),
+	}
+	var out []*managedblockchain.MemberSummary
+
+	err := conn.ListMembersPagesWithContext(ctx, in, func(page *managedblockchain.ListMembersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Members {
+			if v != nil && aws.StringValue(v.Status) != managedblockchain.MemberStatusDeleted {
+				out = append(out, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if count := len(out); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+
+	return out[0], nil
+}
+
+func FindNodeByThreePartKey(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string) (*managedblockchain.Node, error) {
+	in := &managedblockchain.GetNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		in.MemberId = aws.String(memberID)
+	}
+
+	out, err := conn.GetNodeWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Node == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := aws.StringValue(out.Node.Status); status == managedblockchain.NodeStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: in,
+		}
+	}
+
+	return out.Node, nil
+}
@@ -0,0 +1,534 @@
+package managedblockchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceMember() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMemberCreate,
+		ReadWithoutTimeout:   resourceMemberRead,
+		UpdateWithoutTimeout: resourceMemberUpdate,
+		DeleteWithoutTimeout: resourceMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ca_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"invitation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member_configuration": memberConfigurationSchema(),
+			"member_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameMember = "Member"
+)
+
+func memberConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"description": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(0, 128),
+				},
+				"framework_configuration": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"fabric": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"admin_password": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											Sensitive:    true,
+											ValidateFunc: validation.StringLenBetween(8, 32),
+										},
+										"admin_username": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringLenBetween(1, 16),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"kms_key_arn": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+				},
+				"log_publishing_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"fabric": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Computed: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"ca_logs": logConfigurationsSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func logConfigurationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cloudwatch": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enabled": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID := d.Get("network_id").(string)
+	in := &managedblockchain.CreateMemberInput{
+		InvitationId:        aws.String(d.Get("invitation_id").(string)),
+		MemberConfiguration: expandMemberConfiguration(d.Get("member_configuration").([]interface{})),
+		NetworkId:           aws.String(networkID),
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.MemberConfiguration.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateMemberWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionCreating, ResNameMember, aws.StringValue(in.MemberConfiguration.Name), err)
+	}
+
+	memberID := aws.StringValue(out.MemberId)
+	d.SetId(MemberCreateResourceID(networkID, memberID))
+
+	if _, err := waitMemberCreated(ctx, conn, networkID, memberID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionWaitingForCreation, ResNameMember, d.Id(), err)
+	}
+
+	return resourceMemberRead(ctx, d, meta)
+}
+
+func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID, memberID, err := MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameMember, d.Id(), err)
+	}
+
+	out, err := FindMemberByTwoPartKey(ctx, conn, networkID, memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameMember, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	if v := out.FrameworkAttributes; v != nil && v.Fabric != nil {
+		d.Set("ca_endpoint", v.Fabric.CaEndpoint)
+	} else {
+		d.Set("ca_endpoint", nil)
+	}
+	d.Set("creation_date", aws.TimeValue(out.CreationDate).Format(time.RFC3339))
+	d.Set("member_id", out.Id)
+	d.Set("network_id", out.NetworkId)
+
+	if err := d.Set("member_configuration", flattenMember(out, d.Get("member_configuration").([]interface{}))); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameMember, d.Id(), err)
+	}
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameMember, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameMember, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameMember, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID, memberID, err := MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameMember, d.Id(), err)
+	}
+
+	if d.HasChange("member_configuration.0.log_publishing_configuration") {
+		if err := updateMemberLogPublishingConfiguration(ctx, conn, networkID, memberID, d.Get("member_configuration.0.log_publishing_configuration").([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameMember, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameMember, d.Id(), err)
+		}
+	}
+
+	return resourceMemberRead(ctx, d, meta)
+}
+
+func resourceMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID, memberID, err := MemberParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionDeleting, ResNameMember, d.Id(), err)
+	}
+
+	if err := deleteMember(ctx, conn, networkID, memberID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionDeleting, ResNameMember, d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateMemberLogPublishingConfiguration(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string, tfList []interface{}, timeout time.Duration) error {
+	in := &managedblockchain.UpdateMemberInput{
+		LogPublishingConfiguration: expandMemberLogPublishingConfiguration(tfList),
+		MemberId:                   aws.String(memberID),
+		NetworkId:                  aws.String(networkID),
+	}
+
+	if _, err := conn.UpdateMemberWithContext(ctx, in); err != nil {
+		return err
+	}
+
+	if _, err := waitMemberUpdated(ctx, conn, networkID, memberID, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+func deleteMember(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string, timeout time.Duration) error {
+	log.Printf("[INFO] Deleting Managed Blockchain Member %s", memberID)
+
+	_, err := conn.DeleteMemberWithContext(ctx, &managedblockchain.DeleteMemberInput{
+		MemberId:  aws.String(memberID),
+		NetworkId: aws.String(networkID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitMemberDeleted(ctx, conn, networkID, memberID, timeout); err != nil {
+		return fmt.Errorf("waiting for deletion: %w", err)
+	}
+
+	return nil
+}
+
+const memberIDSeparator = ","
+
+func MemberCreateResourceID(networkID, memberID string) string {
+	parts := []string{networkID, memberID}
+	id := strings.Join(parts, memberIDSeparator)
+
+	return id
+}
+
+func MemberParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, memberIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NETWORK-ID%[2]sMEMBER-ID", id, memberIDSeparator)
+}
+
+func expandMemberConfiguration(tfList []interface{}) *managedblockchain.MemberConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &managedblockchain.MemberConfiguration{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["description"].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["framework_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.FrameworkConfiguration = &managedblockchain.MemberFrameworkConfiguration{}
+
+		if v, ok := v[0].(map[string]interface{})["fabric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.FrameworkConfiguration.Fabric = &managedblockchain.MemberFabricConfiguration{
+				AdminPassword: aws.String(tfMap["admin_password"].(string)),
+				AdminUsername: aws.String(tfMap["admin_username"].(string)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_publishing_configuration"].([]interface{}); ok && len(v) > 0 {
+		apiObject.LogPublishingConfiguration = expandMemberLogPublishingConfiguration(v)
+	}
+
+	return apiObject
+}
+
+func expandMemberLogPublishingConfiguration(tfList []interface{}) *managedblockchain.MemberLogPublishingConfiguration {
+	apiObject := &managedblockchain.MemberLogPublishingConfiguration{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	if v, ok := tfList[0].(map[string]interface{})["fabric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Fabric = &managedblockchain.MemberFabricLogPublishingConfiguration{
+			CaLogs: expandLogConfigurations(v[0].(map[string]interface{})["ca_logs"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandLogConfigurations(tfList []interface{}) *managedblockchain.LogConfigurations {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &managedblockchain.LogConfigurations{}
+
+	if v, ok := tfList[0].(map[string]interface{})["cloudwatch"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Cloudwatch = &managedblockchain.LogConfiguration{
+			Enabled: aws.Bool(v[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+// flattenMember flattens the member's configuration. The admin password is not
+// returned by the API, so it is carried over from the prior configuration.
+func flattenMember(apiObject *managedblockchain.Member, tfList []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var adminPassword string
+
+	if len(tfList) > 0 && tfList[0] != nil {
+		adminPassword = readAdminPassword(tfList[0].(map[string]interface{}))
+	}
+
+	fabric := map[string]interface{}{
+		"admin_password": adminPassword,
+	}
+
+	if v := apiObject.FrameworkAttributes; v != nil && v.Fabric != nil {
+		fabric["admin_username"] = aws.StringValue(v.Fabric.AdminUsername)
+	}
+
+	tfMap := map[string]interface{}{
+		"description": aws.StringValue(apiObject.Description),
+		"framework_configuration": []interface{}{
+			map[string]interface{}{
+				"fabric": []interface{}{fabric},
+			},
+		},
+		"kms_key_arn":                  aws.StringValue(apiObject.KmsKeyArn),
+		"log_publishing_configuration": flattenMemberLogPublishingConfiguration(apiObject.LogPublishingConfiguration),
+		"name":                         aws.StringValue(apiObject.Name),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func readAdminPassword(tfMap map[string]interface{}) string {
+	v, ok := tfMap["framework_configuration"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return ""
+	}
+
+	v, ok = v[0].(map[string]interface{})["fabric"].([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return ""
+	}
+
+	return v[0].(map[string]interface{})["admin_password"].(string)
+}
+
+func flattenMemberLogPublishingConfiguration(apiObject *managedblockchain.MemberLogPublishingConfiguration) []interface{} {
+	if apiObject == nil || apiObject.Fabric == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"fabric": []interface{}{
+				map[string]interface{}{
+					"ca_logs": flattenLogConfigurations(apiObject.Fabric.CaLogs),
+				},
+			},
+		},
+	}
+}
+
+func flattenLogConfigurations(apiObject *managedblockchain.LogConfigurations) []interface{} {
+	if apiObject == nil || apiObject.Cloudwatch == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cloudwatch": []interface{}{
+				map[string]interface{}{
+					"enabled": aws.BoolValue(apiObject.Cloudwatch.Enabled),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package managedblockchain_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Joining a network requires an invitation, which is only issued once a proposal
+// made by an existing member has been approved.
+func TestAccManagedBlockchainMember_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	networkID := os.Getenv("MANAGEDBLOCKCHAIN_NETWORK_ID")
+	invitationID := os.Getenv("MANAGEDBLOCKCHAIN_INVITATION_ID")
+	if networkID == "" || invitationID == "" {
+		t.Skip("Environment variable MANAGEDBLOCKCHAIN_NETWORK_ID or MANAGEDBLOCKCHAIN_INVITATION_ID is not set")
+	}
+
+	var member managedblockchain.Member
+	resourceName := "aws_managedblockchain_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMemberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfig_basic(networkID, invitationID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(ctx, resourceName, &member),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "managedblockchain", regexp.MustCompile(`members/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "ca_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "member_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "member_configuration.0.name", "org2"),
+					resource.TestCheckResourceAttrSet(resourceName, "member_id"),
+					resource.TestCheckResourceAttr(resourceName, "network_id", networkID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"invitation_id", "member_configuration.0.framework_configuration.0.fabric.0.admin_password"},
+			},
+		},
+	})
+}
+
+func testAccCheckMemberDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_managedblockchain_member" {
+				continue
+			}
+
+			networkID, memberID, err := tfmanagedblockchain.MemberParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmanagedblockchain.FindMemberByTwoPartKey(ctx, conn, networkID, memberID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingDestroyed, tfmanagedblockchain.ResNameMember, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMemberExists(ctx context.Context, name string, member *managedblockchain.Member) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameMember, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameMember, name, errors.New("not set"))
+		}
+
+		networkID, memberID, err := tfmanagedblockchain.MemberParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn()
+
+		output, err := tfmanagedblockchain.FindMemberByTwoPartKey(ctx, conn, networkID, memberID)
+
+		if err != nil {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameMember, rs.Primary.ID, err)
+		}
+
+		*member = *output
+
+		return nil
+	}
+}
+
+func testAccMemberConfig_basic(networkID, invitationID string) string {
+	return fmt.Sprintf(`
+resource "aws_managedblockchain_member" "test" {
+  network_id    = %[1]q
+  invitation_id = %[2]q
+
+  member_configuration {
+    name = "org2"
+
+    framework_configuration {
+      fabric {
+        admin_username = "admin"
+        admin_password = "Passw0rd1234"
+      }
+    }
+  }
+}
+`, networkID, invitationID)
+}
@@ -0,0 +1,412 @@
+package managedblockchain
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceNetwork() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkCreate,
+		ReadWithoutTimeout:   resourceNetworkRead,
+		UpdateWithoutTimeout: resourceNetworkUpdate,
+		DeleteWithoutTimeout: resourceNetworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"framework": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchain.Framework_Values(), false),
+			},
+			"framework_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fabric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"edition": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(managedblockchain.Edition_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"framework_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 8),
+			},
+			"member_configuration": memberConfigurationSchema(),
+			"member_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"ordering_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"voting_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"approval_threshold_policy": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"proposal_duration_in_hours": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 168),
+									},
+									"threshold_comparator": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(managedblockchain.ThresholdComparator_Values(), false),
+									},
+									"threshold_percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"vpc_endpoint_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameNetwork = "Network"
+)
+
+func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	name := d.Get("name").(string)
+	in := &managedblockchain.CreateNetworkInput{
+		Framework:           aws.String(d.Get("framework").(string)),
+		FrameworkVersion:    aws.String(d.Get("framework_version").(string)),
+		MemberConfiguration: expandMemberConfiguration(d.Get("member_configuration").([]interface{})),
+		Name:                aws.String(name),
+		VotingPolicy:        expandVotingPolicy(d.Get("voting_policy").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("framework_configuration"); ok {
+		in.FrameworkConfiguration = expandNetworkFrameworkConfiguration(v.([]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateNetworkWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionCreating, ResNameNetwork, name, err)
+	}
+
+	networkID, memberID := aws.StringValue(out.NetworkId), aws.StringValue(out.MemberId)
+	d.SetId(networkID)
+	d.Set("member_id", memberID)
+
+	if _, err := waitNetworkCreated(ctx, conn, networkID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionWaitingForCreation, ResNameNetwork, d.Id(), err)
+	}
+
+	if _, err := waitMemberCreated(ctx, conn, networkID, memberID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionWaitingForCreation, ResNameNetwork, d.Id(), err)
+	}
+
+	return resourceNetworkRead(ctx, d, meta)
+}
+
+func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	out, err := FindNetworkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Network (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameNetwork, d.Id(), err)
+	}
+
+	// The member created alongside the network is not part of the network's description.
+	memberID := d.Get("member_id").(string)
+	if memberID == "" {
+		summary, err := FindOwnedMemberByNetworkID(ctx, conn, d.Id())
+
+		if err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameNetwork, d.Id(), err)
+		}
+
+		memberID = aws.StringValue(summary.Id)
+	}
+
+	member, err := FindMemberByTwoPartKey(ctx, conn, d.Id(), memberID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Network (%s) member (%s) not found, removing from state", d.Id(), memberID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameNetwork, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("creation_date", aws.TimeValue(out.CreationDate).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("framework", out.Framework)
+
+	if err := d.Set("framework_configuration", flattenNetworkFrameworkAttributes(out.FrameworkAttributes)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNetwork, d.Id(), err)
+	}
+
+	d.Set("framework_version", out.FrameworkVersion)
+
+	if err := d.Set("member_configuration", flattenMember(member, d.Get("member_configuration").([]interface{}))); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNetwork, d.Id(), err)
+	}
+
+	d.Set("member_id", member.Id)
+	d.Set("name", out.Name)
+	if v := out.FrameworkAttributes; v != nil && v.Fabric != nil {
+		d.Set("ordering_service_endpoint", v.Fabric.OrderingServiceEndpoint)
+	} else {
+		d.Set("ordering_service_endpoint", nil)
+	}
+
+	if err := d.Set("voting_policy", flattenVotingPolicy(out.VotingPolicy)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNetwork, d.Id(), err)
+	}
+
+	d.Set("vpc_endpoint_service_name", out.VpcEndpointServiceName)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameNetwork, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNetwork, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNetwork, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	if d.HasChange("member_configuration.0.log_publishing_configuration") {
+		if err := updateMemberLogPublishingConfiguration(ctx, conn, d.Id(), d.Get("member_id").(string), d.Get("member_configuration.0.log_publishing_configuration").([]interface{}), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameNetwork, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameNetwork, d.Id(), err)
+		}
+	}
+
+	return resourceNetworkRead(ctx, d, meta)
+}
+
+func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	// A network cannot be deleted directly. It is removed once its last member is deleted,
+	// so only the member owned by this account is deleted here.
+	if err := deleteMember(ctx, conn, d.Id(), d.Get("member_id").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionDeleting, ResNameNetwork, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandNetworkFrameworkConfiguration(tfList []interface{}) *managedblockchain.NetworkFrameworkConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &managedblockchain.NetworkFrameworkConfiguration{}
+
+	if v, ok := tfList[0].(map[string]interface{})["fabric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Fabric = &managedblockchain.NetworkFabricConfiguration{
+			Edition: aws.String(v[0].(map[string]interface{})["edition"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandVotingPolicy(tfList []interface{}) *managedblockchain.VotingPolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &managedblockchain.VotingPolicy{}
+
+	if v, ok := tfList[0].(map[string]interface{})["approval_threshold_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		policy := &managedblockchain.ApprovalThresholdPolicy{}
+
+		if v, ok := tfMap["proposal_duration_in_hours"].(int); ok && v != 0 {
+			policy.ProposalDurationInHours = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["threshold_comparator"].(string); ok && v != "" {
+			policy.ThresholdComparator = aws.String(v)
+		}
+
+		if v, ok := tfMap["threshold_percentage"].(int); ok && v != 0 {
+			policy.ThresholdPercentage = aws.Int64(int64(v))
+		}
+
+		apiObject.ApprovalThresholdPolicy = policy
+	}
+
+	return apiObject
+}
+
+func flattenNetworkFrameworkAttributes(apiObject *managedblockchain.NetworkFrameworkAttributes) []interface{} {
+	if apiObject == nil || apiObject.Fabric == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"fabric": []interface{}{
+				map[string]interface{}{
+					"edition": aws.StringValue(apiObject.Fabric.Edition),
+				},
+			},
+		},
+	}
+}
+
+func flattenVotingPolicy(apiObject *managedblockchain.VotingPolicy) []interface{} {
+	if apiObject == nil || apiObject.ApprovalThresholdPolicy == nil {
+		return nil
+	}
+
+	policy := apiObject.ApprovalThresholdPolicy
+
+	return []interface{}{
+		map[string]interface{}{
+			"approval_threshold_policy": []interface{}{
+				map[string]interface{}{
+					"proposal_duration_in_hours": aws.Int64Value(policy.ProposalDurationInHours),
+					"threshold_comparator":       aws.StringValue(policy.ThresholdComparator),
+					"threshold_percentage":       aws.Int64Value(policy.ThresholdPercentage),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,342 @@
+package managedblockchain_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccManagedBlockchainNetwork_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var network managedblockchain.Network
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkExists(ctx, resourceName, &network),
+					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "arn", "managedblockchain", regexp.MustCompile(`networks/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "framework", managedblockchain.FrameworkHyperledgerFabric),
+					resource.TestCheckResourceAttr(resourceName, "framework_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "framework_configuration.0.fabric.0.edition", managedblockchain.EditionStarter),
+					resource.TestCheckResourceAttr(resourceName, "framework_version", "2.2"),
+					resource.TestCheckResourceAttr(resourceName, "member_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "member_configuration.0.framework_configuration.0.fabric.0.admin_username", "admin"),
+					resource.TestCheckResourceAttr(resourceName, "member_configuration.0.name", "org1"),
+					resource.TestCheckResourceAttrSet(resourceName, "member_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "ordering_service_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "voting_policy.0.approval_threshold_policy.0.threshold_comparator", managedblockchain.ThresholdComparatorGreaterThan),
+					resource.TestCheckResourceAttr(resourceName, "voting_policy.0.approval_threshold_policy.0.threshold_percentage", "50"),
+					resource.TestCheckResourceAttrSet(resourceName, "vpc_endpoint_service_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"member_configuration.0.framework_configuration.0.fabric.0.admin_password"},
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainNetwork_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var network managedblockchain.Network
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkExists(ctx, resourceName, &network),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmanagedblockchain.ResourceNetwork(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainNetwork_logPublishing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var network managedblockchain.Network
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConfig_logPublishing(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkExists(ctx, resourceName, &network),
+					resource.TestCheckResourceAttr(resourceName, "member_configuration.0.log_publishing_configuration.0.fabric.0.ca_logs.0.cloudwatch.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"member_configuration.0.framework_configuration.0.fabric.0.admin_password"},
+			},
+			{
+				Config: testAccNetworkConfig_logPublishing(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkExists(ctx, resourceName, &network),
+					resource.TestCheckResourceAttr(resourceName, "member_configuration.0.log_publishing_configuration.0.fabric.0.ca_logs.0.cloudwatch.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainNetwork_tags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var network managedblockchain.Network
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_network.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkExists(ctx, resourceName, &network),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"member_configuration.0.framework_configuration.0.fabric.0.admin_password"},
+			},
+			{
+				Config: testAccNetworkConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkExists(ctx, resourceName, &network),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccNetworkConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkExists(ctx, resourceName, &network),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_managedblockchain_network" {
+				continue
+			}
+
+			_, err := tfmanagedblockchain.FindNetworkByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingDestroyed, tfmanagedblockchain.ResNameNetwork, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkExists(ctx context.Context, name string, network *managedblockchain.Network) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameNetwork, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameNetwork, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn()
+
+		output, err := tfmanagedblockchain.FindNetworkByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameNetwork, rs.Primary.ID, err)
+		}
+
+		*network = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn()
+
+	input := &managedblockchain.ListNetworksInput{}
+	_, err := conn.ListNetworksWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccNetworkConfig_base(rName, memberExtra, networkExtra string) string {
+	return fmt.Sprintf(`
+resource "aws_managedblockchain_network" "test" {
+  name              = %[1]q
+  framework         = "HYPERLEDGER_FABRIC"
+  framework_version = "2.2"
+
+  framework_configuration {
+    fabric {
+      edition = "STARTER"
+    }
+  }
+
+  voting_policy {
+    approval_threshold_policy {
+      proposal_duration_in_hours = 24
+      threshold_comparator       = "GREATER_THAN"
+      threshold_percentage       = 50
+    }
+  }
+
+  member_configuration {
+    name = "org1"
+
+    framework_configuration {
+      fabric {
+        admin_username = "admin"
+        admin_password = "Passw0rd1234"
+      }
+    }
+%[2]s
+  }
+%[3]s
+}
+`, rName, memberExtra, networkExtra)
+}
+
+func testAccNetworkConfig_basic(rName string) string {
+	return testAccNetworkConfig_base(rName, "", "")
+}
+
+func testAccNetworkConfig_logPublishing(rName string, enabled bool) string {
+	return testAccNetworkConfig_base(rName, fmt.Sprintf(`
+    log_publishing_configuration {
+      fabric {
+        ca_logs {
+          cloudwatch {
+            enabled = %[1]t
+          }
+        }
+      }
+    }
+`, enabled), "")
+}
+
+func testAccNetworkConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccNetworkConfig_base(rName, "", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccNetworkConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccNetworkConfig_base(rName, "", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
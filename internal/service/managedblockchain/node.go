@@ -0,0 +1,389 @@
+package managedblockchain
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceNode() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNodeCreate,
+		ReadWithoutTimeout:   resourceNodeRead,
+		UpdateWithoutTimeout: resourceNodeUpdate,
+		DeleteWithoutTimeout: resourceNodeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"http_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_publishing_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fabric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"chaincode_logs": logConfigurationsSchema(),
+									"peer_logs":      logConfigurationsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"member_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"peer_event_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_db": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedblockchain.StateDBType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"websocket_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameNode = "Node"
+)
+
+func resourceNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID := d.Get("network_id").(string)
+	memberID := d.Get("member_id").(string)
+	in := &managedblockchain.CreateNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeConfiguration: &managedblockchain.NodeConfiguration{
+			InstanceType: aws.String(d.Get("instance_type").(string)),
+		},
+	}
+
+	if memberID != "" {
+		in.MemberId = aws.String(memberID)
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		in.NodeConfiguration.AvailabilityZone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_publishing_configuration"); ok {
+		in.NodeConfiguration.LogPublishingConfiguration = expandNodeLogPublishingConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("state_db"); ok {
+		in.NodeConfiguration.StateDB = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateNodeWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionCreating, ResNameNode, networkID, err)
+	}
+
+	nodeID := aws.StringValue(out.NodeId)
+	d.SetId(NodeCreateResourceID(networkID, memberID, nodeID))
+
+	if _, err := waitNodeCreated(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionWaitingForCreation, ResNameNode, d.Id(), err)
+	}
+
+	return resourceNodeRead(ctx, d, meta)
+}
+
+func resourceNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID, memberID, nodeID, err := NodeParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameNode, d.Id(), err)
+	}
+
+	out, err := FindNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Managed Blockchain Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameNode, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("availability_zone", out.AvailabilityZone)
+	d.Set("creation_date", aws.TimeValue(out.CreationDate).Format(time.RFC3339))
+	d.Set("http_endpoint", nil)
+	d.Set("peer_endpoint", nil)
+	d.Set("peer_event_endpoint", nil)
+	d.Set("websocket_endpoint", nil)
+	if v := out.FrameworkAttributes; v != nil {
+		if v := v.Ethereum; v != nil {
+			d.Set("http_endpoint", v.HttpEndpoint)
+			d.Set("websocket_endpoint", v.WebSocketEndpoint)
+		}
+		if v := v.Fabric; v != nil {
+			d.Set("peer_endpoint", v.PeerEndpoint)
+			d.Set("peer_event_endpoint", v.PeerEventEndpoint)
+		}
+	}
+	d.Set("instance_type", out.InstanceType)
+	d.Set("kms_key_arn", out.KmsKeyArn)
+
+	if err := d.Set("log_publishing_configuration", flattenNodeLogPublishingConfiguration(out.LogPublishingConfiguration)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNode, d.Id(), err)
+	}
+
+	d.Set("member_id", out.MemberId)
+	d.Set("network_id", out.NetworkId)
+	d.Set("node_id", out.Id)
+	d.Set("state_db", out.StateDB)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionReading, ResNameNode, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNode, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionSetting, ResNameNode, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID, memberID, nodeID, err := NodeParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameNode, d.Id(), err)
+	}
+
+	if d.HasChange("log_publishing_configuration") {
+		in := &managedblockchain.UpdateNodeInput{
+			LogPublishingConfiguration: expandNodeLogPublishingConfiguration(d.Get("log_publishing_configuration").([]interface{})),
+			NetworkId:                  aws.String(networkID),
+			NodeId:                     aws.String(nodeID),
+		}
+
+		if memberID != "" {
+			in.MemberId = aws.String(memberID)
+		}
+
+		log.Printf("[DEBUG] Updating Managed Blockchain Node (%s): %#v", d.Id(), in)
+		if _, err := conn.UpdateNodeWithContext(ctx, in); err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameNode, d.Id(), err)
+		}
+
+		if _, err := waitNodeUpdated(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionWaitingForUpdate, ResNameNode, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.ManagedBlockchain, create.ErrActionUpdating, ResNameNode, d.Id(), err)
+		}
+	}
+
+	return resourceNodeRead(ctx, d, meta)
+}
+
+func resourceNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ManagedBlockchainConn()
+
+	networkID, memberID, nodeID, err := NodeParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionDeleting, ResNameNode, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Managed Blockchain Node %s", d.Id())
+
+	in := &managedblockchain.DeleteNodeInput{
+		NetworkId: aws.String(networkID),
+		NodeId:    aws.String(nodeID),
+	}
+
+	if memberID != "" {
+		in.MemberId = aws.String(memberID)
+	}
+
+	_, err = conn.DeleteNodeWithContext(ctx, in)
+
+	if tfawserr.ErrCodeEquals(err, managedblockchain.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionDeleting, ResNameNode, d.Id(), err)
+	}
+
+	if _, err := waitNodeDeleted(ctx, conn, networkID, memberID, nodeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.ManagedBlockchain, create.ErrActionWaitingForDeletion, ResNameNode, d.Id(), err)
+	}
+
+	return nil
+}
+
+const nodeIDSeparator = ","
+
+// NodeCreateResourceID returns the ID for a node. The member ID is empty for nodes on Ethereum networks.
+func NodeCreateResourceID(networkID, memberID, nodeID string) string {
+	parts := []string{networkID, memberID, nodeID}
+	id := strings.Join(parts, nodeIDSeparator)
+
+	return id
+}
+
+func NodeParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, nodeIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NETWORK-ID%[2]sMEMBER-ID%[2]sNODE-ID", id, nodeIDSeparator)
+}
+
+func expandNodeLogPublishingConfiguration(tfList []interface{}) *managedblockchain.NodeLogPublishingConfiguration {
+	apiObject := &managedblockchain.NodeLogPublishingConfiguration{}
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return apiObject
+	}
+
+	if v, ok := tfList[0].(map[string]interface{})["fabric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Fabric = &managedblockchain.NodeFabricLogPublishingConfiguration{
+			ChaincodeLogs: expandLogConfigurations(tfMap["chaincode_logs"].([]interface{})),
+			PeerLogs:      expandLogConfigurations(tfMap["peer_logs"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenNodeLogPublishingConfiguration(apiObject *managedblockchain.NodeLogPublishingConfiguration) []interface{} {
+	if apiObject == nil || apiObject.Fabric == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"fabric": []interface{}{
+				map[string]interface{}{
+					"chaincode_logs": flattenLogConfigurations(apiObject.Fabric.ChaincodeLogs),
+					"peer_logs":      flattenLogConfigurations(apiObject.Fabric.PeerLogs),
+				},
+			},
+		},
+	}
+}
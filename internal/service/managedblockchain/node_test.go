@@ -0,0 +1,249 @@
+package managedblockchain_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmanagedblockchain "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccManagedBlockchainNode_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var node managedblockchain.Node
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeExists(ctx, resourceName, &node),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "managedblockchain", regexp.MustCompile(`nodes/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "bc.t3.small"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_id", "aws_managedblockchain_network.test", "member_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_id", "aws_managedblockchain_network.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "node_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_endpoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "peer_event_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "state_db", managedblockchain.StateDBTypeCouchDb),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainNode_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var node managedblockchain.Node
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeExists(ctx, resourceName, &node),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmanagedblockchain.ResourceNode(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccManagedBlockchainNode_logPublishing(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var node managedblockchain.Node
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_managedblockchain_node.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.ManagedBlockchain, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, managedblockchain.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeConfig_logPublishing(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeExists(ctx, resourceName, &node),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_configuration.0.fabric.0.chaincode_logs.0.cloudwatch.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_configuration.0.fabric.0.peer_logs.0.cloudwatch.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNodeConfig_logPublishing(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNodeExists(ctx, resourceName, &node),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_configuration.0.fabric.0.chaincode_logs.0.cloudwatch.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "log_publishing_configuration.0.fabric.0.peer_logs.0.cloudwatch.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNodeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_managedblockchain_node" {
+				continue
+			}
+
+			networkID, memberID, nodeID, err := tfmanagedblockchain.NodeParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmanagedblockchain.FindNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingDestroyed, tfmanagedblockchain.ResNameNode, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNodeExists(ctx context.Context, name string, node *managedblockchain.Node) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameNode, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameNode, name, errors.New("not set"))
+		}
+
+		networkID, memberID, nodeID, err := tfmanagedblockchain.NodeParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ManagedBlockchainConn()
+
+		output, err := tfmanagedblockchain.FindNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+		if err != nil {
+			return create.Error(names.ManagedBlockchain, create.ErrActionCheckingExistence, tfmanagedblockchain.ResNameNode, rs.Primary.ID, err)
+		}
+
+		*node = *output
+
+		return nil
+	}
+}
+
+func testAccNodeConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), testAccNetworkConfig_basic(rName))
+}
+
+func testAccNodeConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccNodeConfig_base(rName), `
+resource "aws_managedblockchain_node" "test" {
+  network_id        = aws_managedblockchain_network.test.id
+  member_id         = aws_managedblockchain_network.test.member_id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "bc.t3.small"
+  state_db          = "CouchDB"
+}
+`)
+}
+
+func testAccNodeConfig_logPublishing(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccNodeConfig_base(rName), fmt.Sprintf(`
+resource "aws_managedblockchain_node" "test" {
+  network_id        = aws_managedblockchain_network.test.id
+  member_id         = aws_managedblockchain_network.test.member_id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_type     = "bc.t3.small"
+
+  log_publishing_configuration {
+    fabric {
+      chaincode_logs {
+        cloudwatch {
+          enabled = %[1]t
+        }
+      }
+
+      peer_logs {
+        cloudwatch {
+          enabled = %[1]t
+        }
+      }
+    }
+  }
+}
+`, enabled))
+}
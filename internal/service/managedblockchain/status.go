@@ -0,0 +1,58 @@
+package managedblockchain
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusNetwork(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindNetworkByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
+
+func statusMember(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindMemberByTwoPartKey(ctx, conn, networkID, memberID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
+
+func statusNode(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindNodeByThreePartKey(ctx, conn, networkID, memberID, nodeID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
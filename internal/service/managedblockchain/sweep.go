@@ -0,0 +1,178 @@
+//go:build sweep
+// +build sweep
+
+package managedblockchain
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_managedblockchain_member", &resource.Sweeper{
+		Name: "aws_managedblockchain_member",
+		F:    sweepMembers,
+		Dependencies: []string{
+			"aws_managedblockchain_node",
+		},
+	})
+
+	resource.AddTestSweepers("aws_managedblockchain_node", &resource.Sweeper{
+		Name: "aws_managedblockchain_node",
+		F:    sweepNodes,
+	})
+}
+
+// sweepMembers deletes the members owned by the account. Networks are deleted
+// by Managed Blockchain once their last member is removed.
+func sweepMembers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ManagedBlockchainConn()
+	input := &managedblockchain.ListNetworksInput{
+		Framework: aws.String(managedblockchain.FrameworkHyperledgerFabric),
+	}
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListNetworksPagesWithContext(ctx, input, func(page *managedblockchain.ListNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Networks {
+			networkID := aws.StringValue(v.Id)
+			input := &managedblockchain.ListMembersInput{
+				IsOwned:   aws.Bool(true),
+				NetworkId: aws.String(networkID),
+			}
+
+			err := conn.ListMembersPagesWithContext(ctx, input, func(page *managedblockchain.ListMembersOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Members {
+					if aws.StringValue(v.Status) == managedblockchain.MemberStatusDeleted {
+						continue
+					}
+
+					r := ResourceMember()
+					d := r.Data(nil)
+					d.SetId(MemberCreateResourceID(networkID, aws.StringValue(v.Id)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Managed Blockchain Members (%s): %w", networkID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Managed Blockchain Member sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Managed Blockchain Networks (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Managed Blockchain Members (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepNodes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ManagedBlockchainConn()
+	input := &managedblockchain.ListNetworksInput{
+		Framework: aws.String(managedblockchain.FrameworkHyperledgerFabric),
+	}
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListNetworksPagesWithContext(ctx, input, func(page *managedblockchain.ListNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Networks {
+			networkID := aws.StringValue(v.Id)
+			member, err := FindOwnedMemberByNetworkID(ctx, conn, networkID)
+
+			if err != nil {
+				continue
+			}
+
+			memberID := aws.StringValue(member.Id)
+			input := &managedblockchain.ListNodesInput{
+				MemberId:  aws.String(memberID),
+				NetworkId: aws.String(networkID),
+			}
+
+			err = conn.ListNodesPagesWithContext(ctx, input, func(page *managedblockchain.ListNodesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Nodes {
+					if aws.StringValue(v.Status) == managedblockchain.NodeStatusDeleted {
+						continue
+					}
+
+					r := ResourceNode()
+					d := r.Data(nil)
+					d.SetId(NodeCreateResourceID(networkID, memberID, aws.StringValue(v.Id)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Managed Blockchain Nodes (%s): %w", networkID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Managed Blockchain Node sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Managed Blockchain Networks (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Managed Blockchain Nodes (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,145 @@
+package managedblockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitNetworkCreated(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string, timeout time.Duration) (*managedblockchain.Network, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.NetworkStatusCreating},
+		Target:  []string{managedblockchain.NetworkStatusAvailable},
+		Refresh: statusNetwork(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Network); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitNetworkDeleted(ctx context.Context, conn *managedblockchain.ManagedBlockchain, id string, timeout time.Duration) (*managedblockchain.Network, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.NetworkStatusAvailable, managedblockchain.NetworkStatusDeleting},
+		Target:  []string{},
+		Refresh: statusNetwork(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Network); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitMemberCreated(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string, timeout time.Duration) (*managedblockchain.Member, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.MemberStatusCreating},
+		Target:  []string{managedblockchain.MemberStatusAvailable},
+		Refresh: statusMember(ctx, conn, networkID, memberID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Member); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitMemberUpdated(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string, timeout time.Duration) (*managedblockchain.Member, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.MemberStatusUpdating},
+		Target:  []string{managedblockchain.MemberStatusAvailable},
+		Refresh: statusMember(ctx, conn, networkID, memberID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Member); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitMemberDeleted(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID string, timeout time.Duration) (*managedblockchain.Member, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.MemberStatusAvailable, managedblockchain.MemberStatusDeleting},
+		Target:  []string{},
+		Refresh: statusMember(ctx, conn, networkID, memberID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Member); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitNodeCreated(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string, timeout time.Duration) (*managedblockchain.Node, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.NodeStatusCreating},
+		Target:  []string{managedblockchain.NodeStatusAvailable},
+		Refresh: statusNode(ctx, conn, networkID, memberID, nodeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Node); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitNodeUpdated(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string, timeout time.Duration) (*managedblockchain.Node, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.NodeStatusUpdating},
+		Target:  []string{managedblockchain.NodeStatusAvailable},
+		Refresh: statusNode(ctx, conn, networkID, memberID, nodeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Node); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitNodeDeleted(ctx context.Context, conn *managedblockchain.ManagedBlockchain, networkID, memberID, nodeID string, timeout time.Duration) (*managedblockchain.Node, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedblockchain.NodeStatusAvailable, managedblockchain.NodeStatusUnhealthy, managedblockchain.NodeStatusFailed, managedblockchain.NodeStatusDeleting},
+		Target:  []string{},
+		Refresh: statusNode(ctx, conn, networkID, memberID, nodeID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*managedblockchain.Node); ok {
+		return out, err
+	}
+
+	return nil, err
+}
@@ -67,6 +67,14 @@ func ResourceQueue() *schema.Resource {
 								mediaconvert.CommitmentOneYear,
 							}, false),
 						},
+						"expires_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"purchased_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"renewal_type": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -79,6 +87,10 @@ func ResourceQueue() *schema.Resource {
 							Type:     schema.TypeInt,
 							Required: true,
 						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -72,6 +72,9 @@ func TestAccMediaConvertQueue_reservationPlanSettings(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.commitment", mediaconvert.CommitmentOneYear),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.renewal_type", mediaconvert.RenewalTypeAutoRenew),
 					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.reserved_slots", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_plan_settings.0.status", mediaconvert.ReservationPlanStatusActive),
+					resource.TestCheckResourceAttrSet(resourceName, "reservation_plan_settings.0.expires_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "reservation_plan_settings.0.purchased_at"),
 				),
 			},
 			{
@@ -1,6 +1,8 @@
 package mediaconvert
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
 )
@@ -32,6 +34,15 @@ func flattenReservationPlan(reservationPlan *mediaconvert.ReservationPlan) []int
 		"commitment":     aws.StringValue(reservationPlan.Commitment),
 		"renewal_type":   aws.StringValue(reservationPlan.RenewalType),
 		"reserved_slots": aws.Int64Value(reservationPlan.ReservedSlots),
+		"status":         aws.StringValue(reservationPlan.Status),
+	}
+
+	if v := reservationPlan.ExpiresAt; v != nil {
+		m["expires_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := reservationPlan.PurchasedAt; v != nil {
+		m["purchased_at"] = aws.TimeValue(v).Format(time.RFC3339)
 	}
 
 	return []interface{}{m}
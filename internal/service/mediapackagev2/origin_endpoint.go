@@ -0,0 +1,824 @@
+package mediapackagev2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceOriginEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOriginEndpointCreate,
+		ReadWithoutTimeout:   resourceOriginEndpointRead,
+		UpdateWithoutTimeout: resourceOriginEndpointUpdate,
+		DeleteWithoutTimeout: resourceOriginEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"channel_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"container_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(mediapackagev2.ContainerType_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"hls_manifests":             hlsManifestsSchema(),
+			"low_latency_hls_manifests": hlsManifestsSchema(),
+			"origin_endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName,
+			},
+			"segment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"constant_initialization_vector": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(32, 32),
+									},
+									"encryption_method": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cmaf_encryption_method": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediapackagev2.CmafEncryptionMethod_Values(), false),
+												},
+												"ts_encryption_method": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(mediapackagev2.TsEncryptionMethod_Values(), false),
+												},
+											},
+										},
+									},
+									"key_rotation_interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(300, 31536000),
+									},
+									"speke_key_provider": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"drm_systems": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(mediapackagev2.DrmSystem_Values(), false),
+													},
+												},
+												"encryption_contract_configuration": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"preset_speke20_audio": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(mediapackagev2.PresetSpeke20Audio_Values(), false),
+															},
+															"preset_speke20_video": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(mediapackagev2.PresetSpeke20Video_Values(), false),
+															},
+														},
+													},
+												},
+												"resource_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"url": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.IsURLWithHTTPS,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"include_iframe_only_streams": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"scte": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scte_filter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(mediapackagev2.ScteFilter_Values(), false),
+										},
+									},
+								},
+							},
+						},
+						"segment_duration_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 30),
+						},
+						"segment_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"ts_include_dvb_subtitles": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"ts_use_audio_rendition_group": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"startover_window_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 1209600),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func hlsManifestsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"child_manifest_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"manifest_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"manifest_window_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(30),
+				},
+				"program_date_time_interval_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 1209600),
+				},
+				"scte_hls": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"ad_marker_hls": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.StringInSlice(mediapackagev2.AdMarkerHls_Values(), false),
+							},
+						},
+					},
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceOriginEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	channelGroupName := d.Get("channel_group_name").(string)
+	channelName := d.Get("channel_name").(string)
+	originEndpointName := d.Get("origin_endpoint_name").(string)
+	id := OriginEndpointCreateResourceID(channelGroupName, channelName, originEndpointName)
+	input := &mediapackagev2.CreateOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		ContainerType:      aws.String(d.Get("container_type").(string)),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hls_manifests"); ok && len(v.([]interface{})) > 0 {
+		input.HlsManifests = expandCreateHLSManifestConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("low_latency_hls_manifests"); ok && len(v.([]interface{})) > 0 {
+		input.LowLatencyHlsManifests = expandCreateLowLatencyHLSManifestConfigurations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Segment = expandSegment(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("startover_window_seconds"); ok {
+		input.StartoverWindowSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateOriginEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating MediaPackage V2 Origin Endpoint (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceOriginEndpointRead(ctx, d, meta)...)
+}
+
+func resourceOriginEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	channelGroupName, channelName, originEndpointName, err := OriginEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaPackage V2 Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindOriginEndpointByThreePartKey(ctx, conn, channelGroupName, channelName, originEndpointName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaPackage V2 Origin Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MediaPackage V2 Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("channel_group_name", output.ChannelGroupName)
+	d.Set("channel_name", output.ChannelName)
+	d.Set("container_type", output.ContainerType)
+	d.Set("description", output.Description)
+	if err := d.Set("hls_manifests", flattenGetHLSManifestConfigurations(output.HlsManifests)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hls_manifests: %s", err)
+	}
+	if err := d.Set("low_latency_hls_manifests", flattenGetLowLatencyHLSManifestConfigurations(output.LowLatencyHlsManifests)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting low_latency_hls_manifests: %s", err)
+	}
+	d.Set("origin_endpoint_name", output.OriginEndpointName)
+	if output.Segment != nil {
+		if err := d.Set("segment", []interface{}{flattenSegment(output.Segment)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting segment: %s", err)
+		}
+	} else {
+		d.Set("segment", nil)
+	}
+	d.Set("startover_window_seconds", output.StartoverWindowSeconds)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceOriginEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		channelGroupName, channelName, originEndpointName, err := OriginEndpointParseResourceID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaPackage V2 Origin Endpoint (%s): %s", d.Id(), err)
+		}
+
+		input := &mediapackagev2.UpdateOriginEndpointInput{
+			ChannelGroupName:   aws.String(channelGroupName),
+			ChannelName:        aws.String(channelName),
+			ContainerType:      aws.String(d.Get("container_type").(string)),
+			OriginEndpointName: aws.String(originEndpointName),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("hls_manifests"); ok && len(v.([]interface{})) > 0 {
+			input.HlsManifests = expandCreateHLSManifestConfigurations(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("low_latency_hls_manifests"); ok && len(v.([]interface{})) > 0 {
+			input.LowLatencyHlsManifests = expandCreateLowLatencyHLSManifestConfigurations(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("segment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Segment = expandSegment(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("startover_window_seconds"); ok {
+			input.StartoverWindowSeconds = aws.Int64(int64(v.(int)))
+		}
+
+		_, err = conn.UpdateOriginEndpointWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaPackage V2 Origin Endpoint (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating MediaPackage V2 Origin Endpoint (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOriginEndpointRead(ctx, d, meta)...)
+}
+
+func resourceOriginEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MediaPackageV2Conn()
+
+	channelGroupName, channelName, originEndpointName, err := OriginEndpointParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaPackage V2 Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting MediaPackage V2 Origin Endpoint: %s", d.Id())
+	_, err = conn.DeleteOriginEndpointWithContext(ctx, &mediapackagev2.DeleteOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting MediaPackage V2 Origin Endpoint (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const originEndpointResourceIDSeparator = ","
+
+func OriginEndpointCreateResourceID(channelGroupName, channelName, originEndpointName string) string {
+	parts := []string{channelGroupName, channelName, originEndpointName}
+	id := strings.Join(parts, originEndpointResourceIDSeparator)
+
+	return id
+}
+
+func OriginEndpointParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, originEndpointResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected channel-group-name%[2]schannel-name%[2]sorigin-endpoint-name", id, originEndpointResourceIDSeparator)
+}
+
+func FindOriginEndpointByThreePartKey(ctx context.Context, conn *mediapackagev2.MediaPackageV2, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointOutput, error) {
+	input := &mediapackagev2.GetOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	output, err := conn.GetOriginEndpointWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, mediapackagev2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandCreateHLSManifestConfigurations(tfList []interface{}) []*mediapackagev2.CreateHlsManifestConfiguration {
+	var apiObjects []*mediapackagev2.CreateHlsManifestConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediapackagev2.CreateHlsManifestConfiguration{
+			ManifestName: aws.String(tfMap["manifest_name"].(string)),
+		}
+
+		if v, ok := tfMap["child_manifest_name"].(string); ok && v != "" {
+			apiObject.ChildManifestName = aws.String(v)
+		}
+
+		if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+			apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+			apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["scte_hls"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ScteHls = expandScteHls(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCreateLowLatencyHLSManifestConfigurations(tfList []interface{}) []*mediapackagev2.CreateLowLatencyHlsManifestConfiguration {
+	var apiObjects []*mediapackagev2.CreateLowLatencyHlsManifestConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &mediapackagev2.CreateLowLatencyHlsManifestConfiguration{
+			ManifestName: aws.String(tfMap["manifest_name"].(string)),
+		}
+
+		if v, ok := tfMap["child_manifest_name"].(string); ok && v != "" {
+			apiObject.ChildManifestName = aws.String(v)
+		}
+
+		if v, ok := tfMap["manifest_window_seconds"].(int); ok && v != 0 {
+			apiObject.ManifestWindowSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["program_date_time_interval_seconds"].(int); ok && v != 0 {
+			apiObject.ProgramDateTimeIntervalSeconds = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["scte_hls"].([]interface{}); ok && len(v) > 0 {
+			apiObject.ScteHls = expandScteHls(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandScteHls(tfList []interface{}) *mediapackagev2.ScteHls {
+	apiObject := &mediapackagev2.ScteHls{}
+
+	if tfMap, ok := tfList[0].(map[string]interface{}); ok {
+		if v, ok := tfMap["ad_marker_hls"].(string); ok && v != "" {
+			apiObject.AdMarkerHls = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandSegment(tfMap map[string]interface{}) *mediapackagev2.Segment {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.Segment{}
+
+	if v, ok := tfMap["encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Encryption = expandEncryption(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["include_iframe_only_streams"].(bool); ok {
+		apiObject.IncludeIframeOnlyStreams = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["scte"].([]interface{}); ok && len(v) > 0 {
+		scte := &mediapackagev2.Scte{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap["scte_filter"].(*schema.Set); ok && v.Len() > 0 {
+				scte.ScteFilter = flex.ExpandStringSet(v)
+			}
+		}
+
+		apiObject.Scte = scte
+	}
+
+	if v, ok := tfMap["segment_duration_seconds"].(int); ok && v != 0 {
+		apiObject.SegmentDurationSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segment_name"].(string); ok && v != "" {
+		apiObject.SegmentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["ts_include_dvb_subtitles"].(bool); ok {
+		apiObject.TsIncludeDvbSubtitles = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["ts_use_audio_rendition_group"].(bool); ok {
+		apiObject.TsUseAudioRenditionGroup = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandEncryption(tfMap map[string]interface{}) *mediapackagev2.Encryption {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &mediapackagev2.Encryption{}
+
+	if v, ok := tfMap["constant_initialization_vector"].(string); ok && v != "" {
+		apiObject.ConstantInitializationVector = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_method"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		encryptionMethod := &mediapackagev2.EncryptionMethod{}
+
+		if v, ok := tfMap["cmaf_encryption_method"].(string); ok && v != "" {
+			encryptionMethod.CmafEncryptionMethod = aws.String(v)
+		}
+
+		if v, ok := tfMap["ts_encryption_method"].(string); ok && v != "" {
+			encryptionMethod.TsEncryptionMethod = aws.String(v)
+		}
+
+		apiObject.EncryptionMethod = encryptionMethod
+	}
+
+	if v, ok := tfMap["key_rotation_interval_seconds"].(int); ok && v != 0 {
+		apiObject.KeyRotationIntervalSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["speke_key_provider"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		spekeKeyProvider := &mediapackagev2.SpekeKeyProvider{
+			DrmSystems: flex.ExpandStringSet(tfMap["drm_systems"].(*schema.Set)),
+			ResourceId: aws.String(tfMap["resource_id"].(string)),
+			RoleArn:    aws.String(tfMap["role_arn"].(string)),
+			Url:        aws.String(tfMap["url"].(string)),
+		}
+
+		if v, ok := tfMap["encryption_contract_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			spekeKeyProvider.EncryptionContractConfiguration = &mediapackagev2.EncryptionContractConfiguration{
+				PresetSpeke20Audio: aws.String(tfMap["preset_speke20_audio"].(string)),
+				PresetSpeke20Video: aws.String(tfMap["preset_speke20_video"].(string)),
+			}
+		}
+
+		apiObject.SpekeKeyProvider = spekeKeyProvider
+	}
+
+	return apiObject
+}
+
+func flattenGetHLSManifestConfigurations(apiObjects []*mediapackagev2.GetHlsManifestConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"child_manifest_name":                aws.StringValue(apiObject.ChildManifestName),
+			"manifest_name":                      aws.StringValue(apiObject.ManifestName),
+			"manifest_window_seconds":            aws.Int64Value(apiObject.ManifestWindowSeconds),
+			"program_date_time_interval_seconds": aws.Int64Value(apiObject.ProgramDateTimeIntervalSeconds),
+			"scte_hls":                           flattenScteHls(apiObject.ScteHls),
+			"url":                                aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
+
+func flattenGetLowLatencyHLSManifestConfigurations(apiObjects []*mediapackagev2.GetLowLatencyHlsManifestConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"child_manifest_name":                aws.StringValue(apiObject.ChildManifestName),
+			"manifest_name":                      aws.StringValue(apiObject.ManifestName),
+			"manifest_window_seconds":            aws.Int64Value(apiObject.ManifestWindowSeconds),
+			"program_date_time_interval_seconds": aws.Int64Value(apiObject.ProgramDateTimeIntervalSeconds),
+			"scte_hls":                           flattenScteHls(apiObject.ScteHls),
+			"url":                                aws.StringValue(apiObject.Url),
+		})
+	}
+
+	return tfList
+}
+
+func flattenScteHls(apiObject *mediapackagev2.ScteHls) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"ad_marker_hls": aws.StringValue(apiObject.AdMarkerHls),
+	}}
+}
+
+func flattenSegment(apiObject *mediapackagev2.Segment) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"include_iframe_only_streams":  aws.BoolValue(apiObject.IncludeIframeOnlyStreams),
+		"segment_duration_seconds":     aws.Int64Value(apiObject.SegmentDurationSeconds),
+		"segment_name":                 aws.StringValue(apiObject.SegmentName),
+		"ts_include_dvb_subtitles":     aws.BoolValue(apiObject.TsIncludeDvbSubtitles),
+		"ts_use_audio_rendition_group": aws.BoolValue(apiObject.TsUseAudioRenditionGroup),
+	}
+
+	if v := apiObject.Encryption; v != nil {
+		tfMap["encryption"] = []interface{}{flattenEncryption(v)}
+	}
+
+	if v := apiObject.Scte; v != nil {
+		tfMap["scte"] = []interface{}{map[string]interface{}{
+			"scte_filter": aws.StringValueSlice(v.ScteFilter),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenEncryption(apiObject *mediapackagev2.Encryption) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"constant_initialization_vector": aws.StringValue(apiObject.ConstantInitializationVector),
+		"key_rotation_interval_seconds":  aws.Int64Value(apiObject.KeyRotationIntervalSeconds),
+	}
+
+	if v := apiObject.EncryptionMethod; v != nil {
+		tfMap["encryption_method"] = []interface{}{map[string]interface{}{
+			"cmaf_encryption_method": aws.StringValue(v.CmafEncryptionMethod),
+			"ts_encryption_method":   aws.StringValue(v.TsEncryptionMethod),
+		}}
+	}
+
+	if v := apiObject.SpekeKeyProvider; v != nil {
+		spekeKeyProvider := map[string]interface{}{
+			"drm_systems": aws.StringValueSlice(v.DrmSystems),
+			"resource_id": aws.StringValue(v.ResourceId),
+			"role_arn":    aws.StringValue(v.RoleArn),
+			"url":         aws.StringValue(v.Url),
+		}
+
+		if v := v.EncryptionContractConfiguration; v != nil {
+			spekeKeyProvider["encryption_contract_configuration"] = []interface{}{map[string]interface{}{
+				"preset_speke20_audio": aws.StringValue(v.PresetSpeke20Audio),
+				"preset_speke20_video": aws.StringValue(v.PresetSpeke20Video),
+			}}
+		}
+
+		tfMap["speke_key_provider"] = []interface{}{spekeKeyProvider}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,306 @@
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mediapackagev2", regexp.MustCompile(`channelGroup/.+/channel/.+/originEndpoint/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_media_packagev2_channel.test", "channel_group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_media_packagev2_channel.test", "channel_name"),
+					resource.TestCheckResourceAttr(resourceName, "container_type", mediapackagev2.ContainerTypeTs),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifests.0.manifest_name", "index"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifests.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "origin_endpoint_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMediaPackageV2OriginEndpoint_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mediapackagev2.GetOriginEndpointOutput
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, mediapackagev2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_segment(rName, 6, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "300"),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_segment(rName, 4, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "4"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, n string, v *mediapackagev2.GetOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaPackage V2 Origin Endpoint ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn()
+
+		channelGroupName, channelName, originEndpointName, err := tfmediapackagev2.OriginEndpointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, channelGroupName, channelName, originEndpointName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagev2_origin_endpoint" {
+				continue
+			}
+
+			channelGroupName, channelName, originEndpointName, err := tfmediapackagev2.OriginEndpointParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, channelGroupName, channelName, originEndpointName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("MediaPackage V2 Origin Endpoint %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_media_packagev2_channel_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_media_packagev2_channel" "test" {
+  channel_group_name = aws_media_packagev2_channel_group.test.name
+  channel_name       = %[1]q
+}
+`, rName)
+}
+
+func testAccOriginEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name   = aws_media_packagev2_channel.test.channel_group_name
+  channel_name         = aws_media_packagev2_channel.test.channel_name
+  origin_endpoint_name = %[1]q
+  container_type       = "TS"
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name   = aws_media_packagev2_channel.test.channel_group_name
+  channel_name         = aws_media_packagev2_channel.test.channel_name
+  origin_endpoint_name = %[1]q
+  container_type       = "TS"
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccOriginEndpointConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name   = aws_media_packagev2_channel.test.channel_group_name
+  channel_name         = aws_media_packagev2_channel.test.channel_name
+  origin_endpoint_name = %[1]q
+  container_type       = "TS"
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccOriginEndpointConfig_segment(rName string, segmentDuration, startoverWindow int) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_base(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_media_packagev2_channel.test.channel_group_name
+  channel_name             = aws_media_packagev2_channel.test.channel_name
+  origin_endpoint_name     = %[1]q
+  container_type           = "TS"
+  startover_window_seconds = %[3]d
+
+  segment {
+    segment_duration_seconds = %[2]d
+  }
+
+  hls_manifests {
+    manifest_name = "index"
+  }
+}
+`, rName, segmentDuration, startoverWindow))
+}
@@ -0,0 +1,227 @@
+//go:build sweep
+// +build sweep
+
+package mediapackagev2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mediapackagev2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_media_packagev2_channel", &resource.Sweeper{
+		Name: "aws_media_packagev2_channel",
+		F:    sweepChannels,
+		Dependencies: []string{
+			"aws_media_packagev2_origin_endpoint",
+		},
+	})
+
+	resource.AddTestSweepers("aws_media_packagev2_channel_group", &resource.Sweeper{
+		Name: "aws_media_packagev2_channel_group",
+		F:    sweepChannelGroups,
+		Dependencies: []string{
+			"aws_media_packagev2_channel",
+		},
+	})
+
+	resource.AddTestSweepers("aws_media_packagev2_origin_endpoint", &resource.Sweeper{
+		Name: "aws_media_packagev2_origin_endpoint",
+		F:    sweepOriginEndpoints,
+	})
+}
+
+func sweepChannelGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MediaPackageV2Conn()
+	input := &mediapackagev2.ListChannelGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListChannelGroupsPagesWithContext(ctx, input, func(page *mediapackagev2.ListChannelGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceChannelGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ChannelGroupName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaPackage V2 Channel Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MediaPackage V2 Channel Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MediaPackage V2 Channel Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepChannels(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MediaPackageV2Conn()
+	input := &mediapackagev2.ListChannelGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListChannelGroupsPagesWithContext(ctx, input, func(page *mediapackagev2.ListChannelGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			channelGroupName := aws.StringValue(v.ChannelGroupName)
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: aws.String(channelGroupName),
+			}
+
+			err := conn.ListChannelsPagesWithContext(ctx, input, func(page *mediapackagev2.ListChannelsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Items {
+					r := ResourceChannel()
+					d := r.Data(nil)
+					d.SetId(ChannelCreateResourceID(channelGroupName, aws.StringValue(v.ChannelName)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channels (%s): %w", channelGroupName, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaPackage V2 Channel sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channel Groups (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaPackage V2 Channels (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepOriginEndpoints(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MediaPackageV2Conn()
+	input := &mediapackagev2.ListChannelGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	err = conn.ListChannelGroupsPagesWithContext(ctx, input, func(page *mediapackagev2.ListChannelGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			channelGroupName := aws.StringValue(v.ChannelGroupName)
+			input := &mediapackagev2.ListChannelsInput{
+				ChannelGroupName: aws.String(channelGroupName),
+			}
+
+			err := conn.ListChannelsPagesWithContext(ctx, input, func(page *mediapackagev2.ListChannelsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.Items {
+					channelName := aws.StringValue(v.ChannelName)
+					input := &mediapackagev2.ListOriginEndpointsInput{
+						ChannelGroupName: aws.String(channelGroupName),
+						ChannelName:      aws.String(channelName),
+					}
+
+					err := conn.ListOriginEndpointsPagesWithContext(ctx, input, func(page *mediapackagev2.ListOriginEndpointsOutput, lastPage bool) bool {
+						if page == nil {
+							return !lastPage
+						}
+
+						for _, v := range page.Items {
+							r := ResourceOriginEndpoint()
+							d := r.Data(nil)
+							d.SetId(OriginEndpointCreateResourceID(channelGroupName, channelName, aws.StringValue(v.OriginEndpointName)))
+
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+						}
+
+						return !lastPage
+					})
+
+					if err != nil {
+						errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Origin Endpoints (%s): %w", ChannelCreateResourceID(channelGroupName, channelName), err))
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channels (%s): %w", channelGroupName, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MediaPackage V2 Origin Endpoint sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MediaPackage V2 Channel Groups (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MediaPackage V2 Origin Endpoints (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
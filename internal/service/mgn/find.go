@@ -0,0 +1,95 @@
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindLaunchConfigurationTemplateByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.LaunchConfigurationTemplate, error) {
+	in := &mgn.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+	var out []*mgn.LaunchConfigurationTemplate
+
+	err := conn.DescribeLaunchConfigurationTemplatesPagesWithContext(ctx, in, func(page *mgn.DescribeLaunchConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				out = append(out, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if count := len(out); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+
+	return out[0], nil
+}
+
+func FindReplicationConfigurationTemplateByID(ctx context.Context, conn *mgn.Mgn, id string) (*mgn.ReplicationConfigurationTemplate, error) {
+	in := &mgn.DescribeReplicationConfigurationTemplatesInput{
+		ReplicationConfigurationTemplateIDs: aws.StringSlice([]string{id}),
+	}
+	var out []*mgn.ReplicationConfigurationTemplate
+
+	err := conn.DescribeReplicationConfigurationTemplatesPagesWithContext(ctx, in, func(page *mgn.DescribeReplicationConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				out = append(out, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out) == 0 {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if count := len(out); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, in)
+	}
+
+	return out[0], nil
+}
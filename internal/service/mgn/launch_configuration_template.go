@@ -0,0 +1,431 @@
+package mgn
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceLaunchConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchConfigurationTemplateCreate,
+		ReadWithoutTimeout:   resourceLaunchConfigurationTemplateRead,
+		UpdateWithoutTimeout: resourceLaunchConfigurationTemplateUpdate,
+		DeleteWithoutTimeout: resourceLaunchConfigurationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_public_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"boot_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.BootMode_Values(), false),
+			},
+			"copy_private_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ec2_launch_template_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enable_map_auto_tagging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"large_volume_conf": launchTemplateDiskConfSchema(),
+			"launch_disposition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.LaunchDisposition_Values(), false),
+			},
+			"licensing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os_byol": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"map_auto_tagging_mpe_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"small_volume_conf": launchTemplateDiskConfSchema(),
+			"small_volume_max_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_instance_type_right_sizing_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(mgn.TargetInstanceTypeRightSizingMethod_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameLaunchConfigurationTemplate = "Launch Configuration Template"
+)
+
+func launchTemplateDiskConfSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"iops": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(100),
+				},
+				"throughput": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(125),
+				},
+				"volume_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(mgn.VolumeType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceLaunchConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	in := &mgn.CreateLaunchConfigurationTemplateInput{}
+
+	if v, ok := d.GetOkExists("associate_public_ip_address"); ok {
+		in.AssociatePublicIpAddress = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("boot_mode"); ok {
+		in.BootMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("copy_private_ip"); ok {
+		in.CopyPrivateIp = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("copy_tags"); ok {
+		in.CopyTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOkExists("enable_map_auto_tagging"); ok {
+		in.EnableMapAutoTagging = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("large_volume_conf"); ok {
+		in.LargeVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("launch_disposition"); ok {
+		in.LaunchDisposition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("licensing"); ok {
+		in.Licensing = expandLicensing(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("map_auto_tagging_mpe_id"); ok {
+		in.MapAutoTaggingMpeID = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("small_volume_conf"); ok {
+		in.SmallVolumeConf = expandLaunchTemplateDiskConf(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("small_volume_max_size"); ok {
+		in.SmallVolumeMaxSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("target_instance_type_right_sizing_method"); ok {
+		in.TargetInstanceTypeRightSizingMethod = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateLaunchConfigurationTemplateWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionCreating, ResNameLaunchConfigurationTemplate, "", err)
+	}
+
+	d.SetId(aws.StringValue(out.LaunchConfigurationTemplateID))
+
+	return resourceLaunchConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceLaunchConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	out, err := FindLaunchConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Migration Service Launch Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionReading, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("associate_public_ip_address", out.AssociatePublicIpAddress)
+	d.Set("boot_mode", out.BootMode)
+	d.Set("copy_private_ip", out.CopyPrivateIp)
+	d.Set("copy_tags", out.CopyTags)
+	d.Set("ec2_launch_template_id", out.Ec2LaunchTemplateID)
+	d.Set("enable_map_auto_tagging", out.EnableMapAutoTagging)
+
+	if err := d.Set("large_volume_conf", flattenLaunchTemplateDiskConf(out.LargeVolumeConf)); err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionSetting, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	d.Set("launch_disposition", out.LaunchDisposition)
+
+	if err := d.Set("licensing", flattenLicensing(out.Licensing)); err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionSetting, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	d.Set("map_auto_tagging_mpe_id", out.MapAutoTaggingMpeID)
+
+	if err := d.Set("small_volume_conf", flattenLaunchTemplateDiskConf(out.SmallVolumeConf)); err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionSetting, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	d.Set("small_volume_max_size", out.SmallVolumeMaxSize)
+	d.Set("target_instance_type_right_sizing_method", out.TargetInstanceTypeRightSizingMethod)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionReading, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionSetting, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionSetting, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceLaunchConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &mgn.UpdateLaunchConfigurationTemplateInput{
+			LaunchConfigurationTemplateID: aws.String(d.Id()),
+		}
+
+		if d.HasChange("associate_public_ip_address") {
+			in.AssociatePublicIpAddress = aws.Bool(d.Get("associate_public_ip_address").(bool))
+		}
+
+		if d.HasChange("boot_mode") {
+			in.BootMode = aws.String(d.Get("boot_mode").(string))
+		}
+
+		if d.HasChange("copy_private_ip") {
+			in.CopyPrivateIp = aws.Bool(d.Get("copy_private_ip").(bool))
+		}
+
+		if d.HasChange("copy_tags") {
+			in.CopyTags = aws.Bool(d.Get("copy_tags").(bool))
+		}
+
+		if d.HasChange("enable_map_auto_tagging") {
+			in.EnableMapAutoTagging = aws.Bool(d.Get("enable_map_auto_tagging").(bool))
+		}
+
+		if d.HasChange("large_volume_conf") {
+			in.LargeVolumeConf = expandLaunchTemplateDiskConf(d.Get("large_volume_conf").([]interface{}))
+		}
+
+		if d.HasChange("launch_disposition") {
+			in.LaunchDisposition = aws.String(d.Get("launch_disposition").(string))
+		}
+
+		if d.HasChange("licensing") {
+			in.Licensing = expandLicensing(d.Get("licensing").([]interface{}))
+		}
+
+		if d.HasChange("map_auto_tagging_mpe_id") {
+			in.MapAutoTaggingMpeID = aws.String(d.Get("map_auto_tagging_mpe_id").(string))
+		}
+
+		if d.HasChange("small_volume_conf") {
+			in.SmallVolumeConf = expandLaunchTemplateDiskConf(d.Get("small_volume_conf").([]interface{}))
+		}
+
+		if d.HasChange("small_volume_max_size") {
+			in.SmallVolumeMaxSize = aws.Int64(int64(d.Get("small_volume_max_size").(int)))
+		}
+
+		if d.HasChange("target_instance_type_right_sizing_method") {
+			in.TargetInstanceTypeRightSizingMethod = aws.String(d.Get("target_instance_type_right_sizing_method").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Application Migration Service Launch Configuration Template (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateLaunchConfigurationTemplateWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Mgn, create.ErrActionUpdating, ResNameLaunchConfigurationTemplate, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Mgn, create.ErrActionUpdating, ResNameLaunchConfigurationTemplate, d.Id(), err)
+		}
+	}
+
+	return resourceLaunchConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceLaunchConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	log.Printf("[INFO] Deleting Application Migration Service Launch Configuration Template %s", d.Id())
+
+	_, err := conn.DeleteLaunchConfigurationTemplateWithContext(ctx, &mgn.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionDeleting, ResNameLaunchConfigurationTemplate, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLaunchTemplateDiskConf(tfList []interface{}) *mgn.LaunchTemplateDiskConf {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &mgn.LaunchTemplateDiskConf{}
+
+	if v, ok := tfMap["iops"].(int); ok && v != 0 {
+		apiObject.Iops = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["throughput"].(int); ok && v != 0 {
+		apiObject.Throughput = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["volume_type"].(string); ok && v != "" {
+		apiObject.VolumeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLicensing(tfList []interface{}) *mgn.Licensing {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &mgn.Licensing{
+		OsByol: aws.Bool(tfMap["os_byol"].(bool)),
+	}
+}
+
+func flattenLaunchTemplateDiskConf(apiObject *mgn.LaunchTemplateDiskConf) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"iops":        aws.Int64Value(apiObject.Iops),
+			"throughput":  aws.Int64Value(apiObject.Throughput),
+			"volume_type": aws.StringValue(apiObject.VolumeType),
+		},
+	}
+}
+
+func flattenLicensing(apiObject *mgn.Licensing) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"os_byol": aws.BoolValue(apiObject.OsByol),
+		},
+	}
+}
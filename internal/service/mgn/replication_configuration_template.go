@@ -0,0 +1,260 @@
+package mgn
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceReplicationConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationConfigurationTemplateCreate,
+		ReadWithoutTimeout:   resourceReplicationConfigurationTemplateRead,
+		UpdateWithoutTimeout: resourceReplicationConfigurationTemplateUpdate,
+		DeleteWithoutTimeout: resourceReplicationConfigurationTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associate_default_security_group": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"bandwidth_throttling": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"create_public_ip": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"data_plane_routing": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(mgn.ReplicationConfigurationDataPlaneRouting_Values(), false),
+			},
+			"default_large_staging_disk_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(mgn.ReplicationConfigurationDefaultLargeStagingDiskType_Values(), false),
+			},
+			"ebs_encryption": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(mgn.ReplicationConfigurationEbsEncryption_Values(), false),
+			},
+			"ebs_encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"replication_server_instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"replication_servers_security_groups_ids": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"staging_area_subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"staging_area_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"use_dedicated_replication_server": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"use_fips_endpoint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameReplicationConfigurationTemplate = "Replication Configuration Template"
+)
+
+func resourceReplicationConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	in := &mgn.CreateReplicationConfigurationTemplateInput{
+		AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+		BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+		CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+		DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+		DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+		EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+		ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+		ReplicationServersSecurityGroupsIDs: flex.ExpandStringList(d.Get("replication_servers_security_groups_ids").([]interface{})),
+		StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+		StagingAreaTags:                     flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{})),
+		UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+		UseFipsEndpoint:                     aws.Bool(d.Get("use_fips_endpoint").(bool)),
+	}
+
+	if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+		in.EbsEncryptionKeyArn = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateReplicationConfigurationTemplateWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionCreating, ResNameReplicationConfigurationTemplate, "", err)
+	}
+
+	d.SetId(aws.StringValue(out.ReplicationConfigurationTemplateID))
+
+	return resourceReplicationConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceReplicationConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	out, err := FindReplicationConfigurationTemplateByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Application Migration Service Replication Configuration Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionReading, ResNameReplicationConfigurationTemplate, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("associate_default_security_group", out.AssociateDefaultSecurityGroup)
+	d.Set("bandwidth_throttling", out.BandwidthThrottling)
+	d.Set("create_public_ip", out.CreatePublicIP)
+	d.Set("data_plane_routing", out.DataPlaneRouting)
+	d.Set("default_large_staging_disk_type", out.DefaultLargeStagingDiskType)
+	d.Set("ebs_encryption", out.EbsEncryption)
+	d.Set("ebs_encryption_key_arn", out.EbsEncryptionKeyArn)
+	d.Set("replication_server_instance_type", out.ReplicationServerInstanceType)
+	d.Set("replication_servers_security_groups_ids", aws.StringValueSlice(out.ReplicationServersSecurityGroupsIDs))
+	d.Set("staging_area_subnet_id", out.StagingAreaSubnetId)
+	d.Set("staging_area_tags", aws.StringValueMap(out.StagingAreaTags))
+	d.Set("use_dedicated_replication_server", out.UseDedicatedReplicationServer)
+	d.Set("use_fips_endpoint", out.UseFipsEndpoint)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionReading, ResNameReplicationConfigurationTemplate, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionSetting, ResNameReplicationConfigurationTemplate, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionSetting, ResNameReplicationConfigurationTemplate, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceReplicationConfigurationTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &mgn.UpdateReplicationConfigurationTemplateInput{
+			AssociateDefaultSecurityGroup:       aws.Bool(d.Get("associate_default_security_group").(bool)),
+			BandwidthThrottling:                 aws.Int64(int64(d.Get("bandwidth_throttling").(int))),
+			CreatePublicIP:                      aws.Bool(d.Get("create_public_ip").(bool)),
+			DataPlaneRouting:                    aws.String(d.Get("data_plane_routing").(string)),
+			DefaultLargeStagingDiskType:         aws.String(d.Get("default_large_staging_disk_type").(string)),
+			EbsEncryption:                       aws.String(d.Get("ebs_encryption").(string)),
+			ReplicationConfigurationTemplateID:  aws.String(d.Id()),
+			ReplicationServerInstanceType:       aws.String(d.Get("replication_server_instance_type").(string)),
+			ReplicationServersSecurityGroupsIDs: flex.ExpandStringList(d.Get("replication_servers_security_groups_ids").([]interface{})),
+			StagingAreaSubnetId:                 aws.String(d.Get("staging_area_subnet_id").(string)),
+			StagingAreaTags:                     flex.ExpandStringMap(d.Get("staging_area_tags").(map[string]interface{})),
+			UseDedicatedReplicationServer:       aws.Bool(d.Get("use_dedicated_replication_server").(bool)),
+			UseFipsEndpoint:                     aws.Bool(d.Get("use_fips_endpoint").(bool)),
+		}
+
+		if v, ok := d.GetOk("ebs_encryption_key_arn"); ok {
+			in.EbsEncryptionKeyArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Application Migration Service Replication Configuration Template (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateReplicationConfigurationTemplateWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Mgn, create.ErrActionUpdating, ResNameReplicationConfigurationTemplate, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Mgn, create.ErrActionUpdating, ResNameReplicationConfigurationTemplate, d.Id(), err)
+		}
+	}
+
+	return resourceReplicationConfigurationTemplateRead(ctx, d, meta)
+}
+
+func resourceReplicationConfigurationTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).MgnConn()
+
+	log.Printf("[INFO] Deleting Application Migration Service Replication Configuration Template %s", d.Id())
+
+	_, err := conn.DeleteReplicationConfigurationTemplateWithContext(ctx, &mgn.DeleteReplicationConfigurationTemplateInput{
+		ReplicationConfigurationTemplateID: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, mgn.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Mgn, create.ErrActionDeleting, ResNameReplicationConfigurationTemplate, d.Id(), err)
+	}
+
+	return nil
+}
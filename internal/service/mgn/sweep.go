@@ -0,0 +1,116 @@
+//go:build sweep
+// +build sweep
+
+package mgn
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/mgn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_mgn_launch_configuration_template", &resource.Sweeper{
+		Name: "aws_mgn_launch_configuration_template",
+		F:    sweepLaunchConfigurationTemplates,
+	})
+
+	resource.AddTestSweepers("aws_mgn_replication_configuration_template", &resource.Sweeper{
+		Name: "aws_mgn_replication_configuration_template",
+		F:    sweepReplicationConfigurationTemplates,
+	})
+}
+
+func sweepLaunchConfigurationTemplates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MgnConn()
+	input := &mgn.DescribeLaunchConfigurationTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeLaunchConfigurationTemplatesPagesWithContext(ctx, input, func(page *mgn.DescribeLaunchConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceLaunchConfigurationTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.LaunchConfigurationTemplateID))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, mgn.ErrCodeUninitializedAccountException) {
+		log.Printf("[WARN] Skipping Application Migration Service Launch Configuration Template sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Application Migration Service Launch Configuration Templates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Application Migration Service Launch Configuration Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepReplicationConfigurationTemplates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).MgnConn()
+	input := &mgn.DescribeReplicationConfigurationTemplatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeReplicationConfigurationTemplatesPagesWithContext(ctx, input, func(page *mgn.DescribeReplicationConfigurationTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceReplicationConfigurationTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ReplicationConfigurationTemplateID))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) || tfawserr.ErrCodeEquals(err, mgn.ErrCodeUninitializedAccountException) {
+		log.Printf("[WARN] Skipping Application Migration Service Replication Configuration Template sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Application Migration Service Replication Configuration Templates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Application Migration Service Replication Configuration Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package omics
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindReferenceStoreByID(ctx context.Context, conn *omics.Omics, id string) (*omics.GetReferenceStoreOutput, error) {
+	in := &omics.GetReferenceStoreInput{
+		Id: aws.String(id),
+	}
+	out, err := conn.GetReferenceStoreWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindRunGroupByID(ctx context.Context, conn *omics.Omics, id string) (*omics.GetRunGroupOutput, error) {
+	in := &omics.GetRunGroupInput{
+		Id: aws.String(id),
+	}
+	out, err := conn.GetRunGroupWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindSequenceStoreByID(ctx context.Context, conn *omics.Omics, id string) (*omics.GetSequenceStoreOutput, error) {
+	in := &omics.GetSequenceStoreInput{
+		Id: aws.String(id),
+	}
+	out, err := conn.GetSequenceStoreWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindWorkflowByID(ctx context.Context, conn *omics.Omics, id string) (*omics.GetWorkflowOutput, error) {
+	in := &omics.GetWorkflowInput{
+		Id: aws.String(id),
+	}
+	out, err := conn.GetWorkflowWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if state := aws.StringValue(out.Status); state == omics.WorkflowStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,234 @@
+package omics
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceReferenceStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReferenceStoreCreate,
+		ReadWithoutTimeout:   resourceReferenceStoreRead,
+		UpdateWithoutTimeout: resourceReferenceStoreUpdate,
+		DeleteWithoutTimeout: resourceReferenceStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"sse_config": sseConfigSchema(),
+			"tags":       tftags.TagsSchema(),
+			"tags_all":   tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameReferenceStore = "Reference Store"
+)
+
+func sseConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice(omics.EncryptionType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceReferenceStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	name := d.Get("name").(string)
+	in := &omics.CreateReferenceStoreInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sse_config"); ok {
+		in.SseConfig = expandSseConfig(v.([]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateReferenceStoreWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionCreating, ResNameReferenceStore, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.Id))
+
+	return resourceReferenceStoreRead(ctx, d, meta)
+}
+
+func resourceReferenceStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	out, err := FindReferenceStoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Reference Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameReferenceStore, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("creation_time", aws.TimeValue(out.CreationTime).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("name", out.Name)
+
+	if err := d.Set("sse_config", flattenSseConfig(out.SseConfig)); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameReferenceStore, d.Id(), err)
+	}
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameReferenceStore, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameReferenceStore, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameReferenceStore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceReferenceStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Omics, create.ErrActionUpdating, ResNameReferenceStore, d.Id(), err)
+		}
+	}
+
+	return resourceReferenceStoreRead(ctx, d, meta)
+}
+
+func resourceReferenceStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	log.Printf("[INFO] Deleting Omics Reference Store %s", d.Id())
+
+	_, err := conn.DeleteReferenceStoreWithContext(ctx, &omics.DeleteReferenceStoreInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionDeleting, ResNameReferenceStore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSseConfig(tfList []interface{}) *omics.SseConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &omics.SseConfig{
+		Type: aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["key_arn"].(string); ok && v != "" {
+		apiObject.KeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSseConfig(apiObject *omics.SseConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_arn": aws.StringValue(apiObject.KeyArn),
+			"type":    aws.StringValue(apiObject.Type),
+		},
+	}
+}
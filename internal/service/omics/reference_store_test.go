@@ -0,0 +1,290 @@
+package omics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/omics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfomics "github.com/hashicorp/terraform-provider-aws/internal/service/omics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOmicsReferenceStore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var referenceStore omics.GetReferenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_reference_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReferenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReferenceStoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReferenceStoreExists(ctx, resourceName, &referenceStore),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "omics", regexp.MustCompile(`referenceStore/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sse_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sse_config.0.type", omics.EncryptionTypeKms),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsReferenceStore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var referenceStore omics.GetReferenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_reference_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReferenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReferenceStoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReferenceStoreExists(ctx, resourceName, &referenceStore),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfomics.ResourceReferenceStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsReferenceStore_sseConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var referenceStore omics.GetReferenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_reference_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReferenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReferenceStoreConfig_sseConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReferenceStoreExists(ctx, resourceName, &referenceStore),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "sse_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sse_config.0.key_arn", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "sse_config.0.type", omics.EncryptionTypeKms),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsReferenceStore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var referenceStore omics.GetReferenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_reference_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReferenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReferenceStoreConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReferenceStoreExists(ctx, resourceName, &referenceStore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReferenceStoreConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReferenceStoreExists(ctx, resourceName, &referenceStore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccReferenceStoreConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReferenceStoreExists(ctx, resourceName, &referenceStore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReferenceStoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_omics_reference_store" {
+				continue
+			}
+
+			_, err := tfomics.FindReferenceStoreByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Omics, create.ErrActionCheckingDestroyed, tfomics.ResNameReferenceStore, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckReferenceStoreExists(ctx context.Context, name string, referenceStore *omics.GetReferenceStoreOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameReferenceStore, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameReferenceStore, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		output, err := tfomics.FindReferenceStoreByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameReferenceStore, rs.Primary.ID, err)
+		}
+
+		*referenceStore = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+	input := &omics.ListReferenceStoresInput{}
+	_, err := conn.ListReferenceStoresWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccReferenceStoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_reference_store" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccReferenceStoreConfig_sseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_omics_reference_store" "test" {
+  name        = %[1]q
+  description = "test"
+
+  sse_config {
+    type    = "KMS"
+    key_arn = aws_kms_key.test.arn
+  }
+}
+`, rName)
+}
+
+func testAccReferenceStoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_reference_store" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccReferenceStoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_reference_store" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
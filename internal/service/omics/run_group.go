@@ -0,0 +1,223 @@
+package omics
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceRunGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRunGroupCreate,
+		ReadWithoutTimeout:   resourceRunGroupRead,
+		UpdateWithoutTimeout: resourceRunGroupUpdate,
+		DeleteWithoutTimeout: resourceRunGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_cpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"max_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"max_gpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"max_runs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameRunGroup = "Run Group"
+)
+
+func resourceRunGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	in := &omics.CreateRunGroupInput{}
+
+	if v, ok := d.GetOk("max_cpus"); ok {
+		in.MaxCpus = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_duration"); ok {
+		in.MaxDuration = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_gpus"); ok {
+		in.MaxGpus = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("max_runs"); ok {
+		in.MaxRuns = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateRunGroupWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionCreating, ResNameRunGroup, d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(out.Id))
+
+	return resourceRunGroupRead(ctx, d, meta)
+}
+
+func resourceRunGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	out, err := FindRunGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Run Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameRunGroup, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("max_cpus", out.MaxCpus)
+	d.Set("max_duration", out.MaxDuration)
+	d.Set("max_gpus", out.MaxGpus)
+	d.Set("max_runs", out.MaxRuns)
+	d.Set("name", out.Name)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameRunGroup, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameRunGroup, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameRunGroup, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceRunGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &omics.UpdateRunGroupInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("max_cpus"); ok {
+			in.MaxCpus = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("max_duration"); ok {
+			in.MaxDuration = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("max_gpus"); ok {
+			in.MaxGpus = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("max_runs"); ok {
+			in.MaxRuns = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := d.GetOk("name"); ok {
+			in.Name = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Omics Run Group (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateRunGroupWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Omics, create.ErrActionUpdating, ResNameRunGroup, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Omics, create.ErrActionUpdating, ResNameRunGroup, d.Id(), err)
+		}
+	}
+
+	return resourceRunGroupRead(ctx, d, meta)
+}
+
+func resourceRunGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	log.Printf("[INFO] Deleting Omics Run Group %s", d.Id())
+
+	_, err := conn.DeleteRunGroupWithContext(ctx, &omics.DeleteRunGroupInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionDeleting, ResNameRunGroup, d.Id(), err)
+	}
+
+	return nil
+}
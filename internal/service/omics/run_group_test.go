@@ -0,0 +1,275 @@
+package omics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/omics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfomics "github.com/hashicorp/terraform-provider-aws/internal/service/omics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOmicsRunGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var runGroup omics.GetRunGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_run_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRunGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunGroupExists(ctx, resourceName, &runGroup),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "omics", regexp.MustCompile(`runGroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "max_cpus", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_duration", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_gpus", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_runs", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsRunGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var runGroup omics.GetRunGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_run_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRunGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunGroupExists(ctx, resourceName, &runGroup),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfomics.ResourceRunGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsRunGroup_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var runGroup omics.GetRunGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_run_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRunGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunGroupConfig_limits(rName, 10, 60, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunGroupExists(ctx, resourceName, &runGroup),
+					resource.TestCheckResourceAttr(resourceName, "max_cpus", "10"),
+					resource.TestCheckResourceAttr(resourceName, "max_duration", "60"),
+					resource.TestCheckResourceAttr(resourceName, "max_runs", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRunGroupConfig_limits(rName, 20, 120, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunGroupExists(ctx, resourceName, &runGroup),
+					resource.TestCheckResourceAttr(resourceName, "max_cpus", "20"),
+					resource.TestCheckResourceAttr(resourceName, "max_duration", "120"),
+					resource.TestCheckResourceAttr(resourceName, "max_runs", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOmicsRunGroup_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var runGroup omics.GetRunGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_run_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRunGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunGroupConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunGroupExists(ctx, resourceName, &runGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRunGroupConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunGroupExists(ctx, resourceName, &runGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRunGroupConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRunGroupExists(ctx, resourceName, &runGroup),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRunGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_omics_run_group" {
+				continue
+			}
+
+			_, err := tfomics.FindRunGroupByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Omics, create.ErrActionCheckingDestroyed, tfomics.ResNameRunGroup, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRunGroupExists(ctx context.Context, name string, runGroup *omics.GetRunGroupOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameRunGroup, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameRunGroup, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		output, err := tfomics.FindRunGroupByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameRunGroup, rs.Primary.ID, err)
+		}
+
+		*runGroup = *output
+
+		return nil
+	}
+}
+
+func testAccRunGroupConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_run_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccRunGroupConfig_limits(rName string, maxCPUs, maxDuration, maxRuns int) string {
+	return fmt.Sprintf(`
+resource "aws_omics_run_group" "test" {
+  name         = %[1]q
+  max_cpus     = %[2]d
+  max_duration = %[3]d
+  max_runs     = %[4]d
+}
+`, rName, maxCPUs, maxDuration, maxRuns)
+}
+
+func testAccRunGroupConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_run_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccRunGroupConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_run_group" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,199 @@
+package omics
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceSequenceStore() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSequenceStoreCreate,
+		ReadWithoutTimeout:   resourceSequenceStoreRead,
+		UpdateWithoutTimeout: resourceSequenceStoreUpdate,
+		DeleteWithoutTimeout: resourceSequenceStoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"e_tag_algorithm_family": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(omics.ETagAlgorithmFamily_Values(), false),
+			},
+			"fallback_location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"sse_config": sseConfigSchema(),
+			"tags":       tftags.TagsSchema(),
+			"tags_all":   tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameSequenceStore = "Sequence Store"
+)
+
+func resourceSequenceStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	name := d.Get("name").(string)
+	in := &omics.CreateSequenceStoreInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("e_tag_algorithm_family"); ok {
+		in.ETagAlgorithmFamily = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("fallback_location"); ok {
+		in.FallbackLocation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sse_config"); ok {
+		in.SseConfig = expandSseConfig(v.([]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateSequenceStoreWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionCreating, ResNameSequenceStore, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.Id))
+
+	return resourceSequenceStoreRead(ctx, d, meta)
+}
+
+func resourceSequenceStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	out, err := FindSequenceStoreByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Sequence Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameSequenceStore, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("creation_time", aws.TimeValue(out.CreationTime).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("e_tag_algorithm_family", out.ETagAlgorithmFamily)
+	d.Set("fallback_location", out.FallbackLocation)
+	d.Set("name", out.Name)
+
+	if err := d.Set("sse_config", flattenSseConfig(out.SseConfig)); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameSequenceStore, d.Id(), err)
+	}
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameSequenceStore, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameSequenceStore, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameSequenceStore, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSequenceStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Omics, create.ErrActionUpdating, ResNameSequenceStore, d.Id(), err)
+		}
+	}
+
+	return resourceSequenceStoreRead(ctx, d, meta)
+}
+
+func resourceSequenceStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	log.Printf("[INFO] Deleting Omics Sequence Store %s", d.Id())
+
+	_, err := conn.DeleteSequenceStoreWithContext(ctx, &omics.DeleteSequenceStoreInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionDeleting, ResNameSequenceStore, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package omics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/omics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfomics "github.com/hashicorp/terraform-provider-aws/internal/service/omics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOmicsSequenceStore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sequenceStore omics.GetSequenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_sequence_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSequenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSequenceStoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSequenceStoreExists(ctx, resourceName, &sequenceStore),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "omics", regexp.MustCompile(`sequenceStore/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "e_tag_algorithm_family"),
+					resource.TestCheckResourceAttr(resourceName, "fallback_location", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sse_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsSequenceStore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sequenceStore omics.GetSequenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_sequence_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSequenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSequenceStoreConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSequenceStoreExists(ctx, resourceName, &sequenceStore),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfomics.ResourceSequenceStore(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsSequenceStore_fallbackLocation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sequenceStore omics.GetSequenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_sequence_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSequenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSequenceStoreConfig_fallbackLocation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSequenceStoreExists(ctx, resourceName, &sequenceStore),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "e_tag_algorithm_family", omics.ETagAlgorithmFamilySha256up),
+					resource.TestCheckResourceAttr(resourceName, "fallback_location", fmt.Sprintf("s3://%s/", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsSequenceStore_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sequenceStore omics.GetSequenceStoreOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_sequence_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSequenceStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSequenceStoreConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSequenceStoreExists(ctx, resourceName, &sequenceStore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSequenceStoreConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSequenceStoreExists(ctx, resourceName, &sequenceStore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSequenceStoreConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSequenceStoreExists(ctx, resourceName, &sequenceStore),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSequenceStoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_omics_sequence_store" {
+				continue
+			}
+
+			_, err := tfomics.FindSequenceStoreByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Omics, create.ErrActionCheckingDestroyed, tfomics.ResNameSequenceStore, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSequenceStoreExists(ctx context.Context, name string, sequenceStore *omics.GetSequenceStoreOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameSequenceStore, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameSequenceStore, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		output, err := tfomics.FindSequenceStoreByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameSequenceStore, rs.Primary.ID, err)
+		}
+
+		*sequenceStore = *output
+
+		return nil
+	}
+}
+
+func testAccSequenceStoreConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_sequence_store" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSequenceStoreConfig_fallbackLocation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_omics_sequence_store" "test" {
+  name                   = %[1]q
+  description            = "test"
+  e_tag_algorithm_family = "SHA256up"
+  fallback_location      = "s3://${aws_s3_bucket.test.bucket}/"
+}
+`, rName)
+}
+
+func testAccSequenceStoreConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_sequence_store" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSequenceStoreConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_sequence_store" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
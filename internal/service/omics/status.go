@@ -0,0 +1,26 @@
+package omics
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusWorkflow(ctx context.Context, conn *omics.Omics, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindWorkflowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
@@ -0,0 +1,215 @@
+//go:build sweep
+// +build sweep
+
+package omics
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_omics_reference_store", &resource.Sweeper{
+		Name: "aws_omics_reference_store",
+		F:    sweepReferenceStores,
+	})
+
+	resource.AddTestSweepers("aws_omics_run_group", &resource.Sweeper{
+		Name: "aws_omics_run_group",
+		F:    sweepRunGroups,
+	})
+
+	resource.AddTestSweepers("aws_omics_sequence_store", &resource.Sweeper{
+		Name: "aws_omics_sequence_store",
+		F:    sweepSequenceStores,
+	})
+
+	resource.AddTestSweepers("aws_omics_workflow", &resource.Sweeper{
+		Name: "aws_omics_workflow",
+		F:    sweepWorkflows,
+	})
+}
+
+func sweepReferenceStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).OmicsConn()
+	input := &omics.ListReferenceStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListReferenceStoresPagesWithContext(ctx, input, func(page *omics.ListReferenceStoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReferenceStores {
+			r := ResourceReferenceStore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Omics Reference Store sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Omics Reference Stores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Omics Reference Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepRunGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).OmicsConn()
+	input := &omics.ListRunGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListRunGroupsPagesWithContext(ctx, input, func(page *omics.ListRunGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceRunGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Omics Run Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Omics Run Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Omics Run Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSequenceStores(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).OmicsConn()
+	input := &omics.ListSequenceStoresInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListSequenceStoresPagesWithContext(ctx, input, func(page *omics.ListSequenceStoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SequenceStores {
+			r := ResourceSequenceStore()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Omics Sequence Store sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Omics Sequence Stores (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Omics Sequence Stores (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepWorkflows(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).OmicsConn()
+	input := &omics.ListWorkflowsInput{
+		Type: aws.String(omics.WorkflowTypePrivate),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListWorkflowsPagesWithContext(ctx, input, func(page *omics.ListWorkflowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			r := ResourceWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Omics Workflow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Omics Workflows (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Omics Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
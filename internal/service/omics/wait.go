@@ -0,0 +1,50 @@
+package omics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitWorkflowCreated(ctx context.Context, conn *omics.Omics, id string, timeout time.Duration) (*omics.GetWorkflowOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{omics.WorkflowStatusCreating},
+		Target:  []string{omics.WorkflowStatusActive},
+		Refresh: statusWorkflow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*omics.GetWorkflowOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitWorkflowUpdated(ctx context.Context, conn *omics.Omics, id string, timeout time.Duration) (*omics.GetWorkflowOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{omics.WorkflowStatusUpdating},
+		Target:  []string{omics.WorkflowStatusActive},
+		Refresh: statusWorkflow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*omics.GetWorkflowOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(out.StatusMessage)))
+
+		return out, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,363 @@
+package omics
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/omics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceWorkflow() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkflowCreate,
+		ReadWithoutTimeout:   resourceWorkflowRead,
+		UpdateWithoutTimeout: resourceWorkflowUpdate,
+		DeleteWithoutTimeout: resourceWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accelerators": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(omics.Accelerators_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"definition_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+				ExactlyOneOf: []string{"definition_uri", "definition_zip"},
+			},
+			"definition_zip": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsBase64,
+				ExactlyOneOf: []string{"definition_uri", "definition_zip"},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(omics.WorkflowEngine_Values(), false),
+			},
+			"main": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"parameter_template": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"optional": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 100000),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameWorkflow = "Workflow"
+)
+
+func resourceWorkflowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	in := &omics.CreateWorkflowInput{}
+
+	if v, ok := d.GetOk("accelerators"); ok {
+		in.Accelerators = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("definition_uri"); ok {
+		in.DefinitionUri = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("definition_zip"); ok {
+		// Validated as base64 in the schema.
+		zip, _ := base64.StdEncoding.DecodeString(v.(string))
+		in.DefinitionZip = zip
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("engine"); ok {
+		in.Engine = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("main"); ok {
+		in.Main = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		in.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameter_template"); ok && v.(*schema.Set).Len() > 0 {
+		in.ParameterTemplate = expandWorkflowParameters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("storage_capacity"); ok {
+		in.StorageCapacity = aws.Int64(int64(v.(int)))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateWorkflowWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionCreating, ResNameWorkflow, d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(out.Id))
+
+	if _, err := waitWorkflowCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionWaitingForCreation, ResNameWorkflow, d.Id(), err)
+	}
+
+	return resourceWorkflowRead(ctx, d, meta)
+}
+
+func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	out, err := FindWorkflowByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Omics Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameWorkflow, d.Id(), err)
+	}
+
+	d.Set("accelerators", out.Accelerators)
+	d.Set("arn", out.Arn)
+	d.Set("creation_time", aws.TimeValue(out.CreationTime).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("digest", out.Digest)
+	d.Set("engine", out.Engine)
+	d.Set("main", out.Main)
+	d.Set("name", out.Name)
+
+	if err := d.Set("parameter_template", flattenWorkflowParameters(out.ParameterTemplate)); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameWorkflow, d.Id(), err)
+	}
+
+	d.Set("status", out.Status)
+	d.Set("storage_capacity", out.StorageCapacity)
+	d.Set("type", out.Type)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionReading, ResNameWorkflow, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameWorkflow, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.Omics, create.ErrActionSetting, ResNameWorkflow, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceWorkflowUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	if d.HasChanges("description", "name") {
+		in := &omics.UpdateWorkflowInput{
+			Id: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			in.Description = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("name"); ok {
+			in.Name = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Omics Workflow (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateWorkflowWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.Omics, create.ErrActionUpdating, ResNameWorkflow, d.Id(), err)
+		}
+
+		if _, err := waitWorkflowUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.Omics, create.ErrActionWaitingForUpdate, ResNameWorkflow, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.Omics, create.ErrActionUpdating, ResNameWorkflow, d.Id(), err)
+		}
+	}
+
+	return resourceWorkflowRead(ctx, d, meta)
+}
+
+func resourceWorkflowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OmicsConn()
+
+	log.Printf("[INFO] Deleting Omics Workflow %s", d.Id())
+
+	_, err := conn.DeleteWorkflowWithContext(ctx, &omics.DeleteWorkflowInput{
+		Id: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, omics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.Omics, create.ErrActionDeleting, ResNameWorkflow, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandWorkflowParameters(tfList []interface{}) map[string]*omics.WorkflowParameter {
+	apiObjects := make(map[string]*omics.WorkflowParameter)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &omics.WorkflowParameter{
+			Optional: aws.Bool(tfMap["optional"].(bool)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenWorkflowParameters(apiObjects map[string]*omics.WorkflowParameter) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"name":        name,
+			"optional":    aws.BoolValue(apiObject.Optional),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,215 @@
+package omics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/omics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfomics "github.com/hashicorp/terraform-provider-aws/internal/service/omics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOmicsWorkflow_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var workflow omics.GetWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, resourceName, &workflow),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "omics", regexp.MustCompile(`workflow/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "digest"),
+					resource.TestCheckResourceAttr(resourceName, "engine", omics.WorkflowEngineWdl),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "parameter_template.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter_template.*", map[string]string{
+						"name":     "name",
+						"optional": "false",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", omics.WorkflowStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", omics.WorkflowTypePrivate),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition_zip"},
+			},
+		},
+	})
+}
+
+func TestAccOmicsWorkflow_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var workflow omics.GetWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, resourceName, &workflow),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfomics.ResourceWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOmicsWorkflow_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var workflow omics.GetWorkflowOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_omics_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.Omics, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, omics.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkflowDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_description(rName, rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccWorkflowConfig_description(rName, rName+"-updated", "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(ctx, resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_omics_workflow" {
+				continue
+			}
+
+			_, err := tfomics.FindWorkflowByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Omics, create.ErrActionCheckingDestroyed, tfomics.ResNameWorkflow, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkflowExists(ctx context.Context, name string, workflow *omics.GetWorkflowOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameWorkflow, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameWorkflow, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OmicsConn()
+
+		output, err := tfomics.FindWorkflowByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.Omics, create.ErrActionCheckingExistence, tfomics.ResNameWorkflow, rs.Primary.ID, err)
+		}
+
+		*workflow = *output
+
+		return nil
+	}
+}
+
+// testAccWorkflowDefinitionZip is a base64-encoded ZIP archive holding a minimal WDL workflow in main.wdl.
+const testAccWorkflowDefinitionZip = "UEsDBBQAAAAIAAAAIVZ1LlBdpQAAAAsBAAAIAAAAbWFpbi53ZGyNjzkOwjAQRXuf4ss1QqS1Qgoa6DmBcQyx4gU5NhGKzNnxInqa2f6bP5qX9ItyFt3+QMjq/HzXbkWQS8BGAGWfsVXANXhlH7DcyNwnkoPgWmOSWjtsjWVVx7GlRDIW+DL/oD8snTHcjuj7viJSTA70UtZ3+GwFTTQrwzAU3EcbVL7U/EYnZukZaLzleWSal09o1cQzMnS1NNI4/85Yh7M60Xo6kS9QSwECFAMUAAAACAAAACFWdS5QXaUAAAALAQAACAAAAAAAAAAAAAAAgAEAAAAAbWFpbi53ZGxQSwUGAAAAAAEAAQA2AAAAywAAAAAA"
+
+func testAccWorkflowConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_workflow" "test" {
+  name           = %[1]q
+  engine         = "WDL"
+  definition_zip = %[2]q
+
+  parameter_template {
+    name        = "name"
+    description = "Name to greet"
+  }
+}
+`, rName, testAccWorkflowDefinitionZip)
+}
+
+func testAccWorkflowConfig_description(rName, name, description string) string {
+	return fmt.Sprintf(`
+resource "aws_omics_workflow" "test" {
+  name           = %[2]q
+  description    = %[3]q
+  engine         = "WDL"
+  definition_zip = %[4]q
+
+  parameter_template {
+    name        = "name"
+    description = "Name to greet"
+  }
+}
+`, rName, name, description, testAccWorkflowDefinitionZip)
+}
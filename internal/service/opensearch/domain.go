@@ -180,8 +180,9 @@ func ResourceDomain() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"cron_expression_for_recurrence": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^cron\(.+\)$`), "must be a cron expression of the form cron(...)"),
 									},
 									"duration": {
 										Type:     schema.TypeList,
@@ -195,8 +196,9 @@ func ResourceDomain() *schema.Resource {
 													ValidateFunc: validation.StringInSlice(opensearchservice.TimeUnit_Values(), false),
 												},
 												"value": {
-													Type:     schema.TypeInt,
-													Required: true,
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(1),
 												},
 											},
 										},
@@ -215,6 +217,11 @@ func ResourceDomain() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice(opensearchservice.RollbackOnDisable_Values(), false),
 						},
+						"use_off_peak_window": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -489,6 +496,53 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"off_peak_window_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"off_peak_window": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"window_start_time": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"hours": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(0, 23),
+												},
+												"minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(0, 59),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"snapshot_options": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -503,6 +557,21 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"software_update_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_software_update_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"vpc_options": {
@@ -671,6 +740,14 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		inputCreateDomain.CognitoOptions = expandCognitoOptions(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("off_peak_window_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		inputCreateDomain.OffPeakWindowOptions = expandOffPeakWindowOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("software_update_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		inputCreateDomain.SoftwareUpdateOptions = expandSoftwareUpdateOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating OpenSearch domain: %s", inputCreateDomain)
 
 	// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform
@@ -836,10 +913,26 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interf
 		}
 	}
 
+	if ds.OffPeakWindowOptions != nil {
+		if err := d.Set("off_peak_window_options", []interface{}{flattenOffPeakWindowOptions(ds.OffPeakWindowOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting off_peak_window_options: %s", err)
+		}
+	} else {
+		d.Set("off_peak_window_options", nil)
+	}
+
 	if err := d.Set("snapshot_options", flattenSnapshotOptions(ds.SnapshotOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snapshot_options: %s", err)
 	}
 
+	if ds.SoftwareUpdateOptions != nil {
+		if err := d.Set("software_update_options", []interface{}{flattenSoftwareUpdateOptions(ds.SoftwareUpdateOptions)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting software_update_options: %s", err)
+		}
+	} else {
+		d.Set("software_update_options", nil)
+	}
+
 	if ds.VPCOptions != nil {
 		if err := d.Set("vpc_options", flattenVPCDerivedInfo(ds.VPCOptions)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting vpc_options: %s", err)
@@ -1010,6 +1103,18 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.LogPublishingOptions = expandLogPublishingOptions(d.Get("log_publishing_options").(*schema.Set))
 		}
 
+		if d.HasChange("off_peak_window_options") {
+			if v, ok := d.GetOk("off_peak_window_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OffPeakWindowOptions = expandOffPeakWindowOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("software_update_options") {
+			if v, ok := d.GetOk("software_update_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SoftwareUpdateOptions = expandSoftwareUpdateOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		_, err := conn.UpdateDomainConfigWithContext(ctx, &input)
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Domain (%s): %s", d.Id(), err)
@@ -61,6 +61,7 @@ func expandAutoTuneOptions(tfMap map[string]interface{}) *opensearchservice.Auto
 
 	options.DesiredState = autoTuneOptionsInput.DesiredState
 	options.MaintenanceSchedules = autoTuneOptionsInput.MaintenanceSchedules
+	options.UseOffPeakWindow = autoTuneOptionsInput.UseOffPeakWindow
 
 	options.RollbackOnDisable = aws.String(tfMap["rollback_on_disable"].(string))
 
@@ -80,6 +81,10 @@ func expandAutoTuneOptionsInput(tfMap map[string]interface{}) *opensearchservice
 		options.MaintenanceSchedules = expandAutoTuneMaintenanceSchedules(v.List())
 	}
 
+	if v, ok := tfMap["use_off_peak_window"].(bool); ok {
+		options.UseOffPeakWindow = aws.Bool(v)
+	}
+
 	return options
 }
 
@@ -115,6 +120,57 @@ func expandAutoTuneMaintenanceScheduleDuration(tfMap map[string]interface{}) *op
 	return autoTuneMaintenanceScheduleDuration
 }
 
+func expandOffPeakWindowOptions(tfMap map[string]interface{}) *opensearchservice.OffPeakWindowOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.OffPeakWindowOptions{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["off_peak_window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OffPeakWindow = expandOffPeakWindow(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandOffPeakWindow(tfMap map[string]interface{}) *opensearchservice.OffPeakWindow {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.OffPeakWindow{}
+
+	if v, ok := tfMap["window_start_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.WindowStartTime = &opensearchservice.WindowStartTime{
+			Hours:   aws.Int64(int64(tfMap["hours"].(int))),
+			Minutes: aws.Int64(int64(tfMap["minutes"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSoftwareUpdateOptions(tfMap map[string]interface{}) *opensearchservice.SoftwareUpdateOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.SoftwareUpdateOptions{}
+
+	if v, ok := tfMap["auto_software_update_enabled"].(bool); ok {
+		apiObject.AutoSoftwareUpdateEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
 func expandESSAMLOptions(data []interface{}) *opensearchservice.SAMLOptionsInput_ {
 	if len(data) == 0 {
 		return nil
@@ -203,6 +259,7 @@ func flattenAutoTuneOptions(autoTuneOptions *opensearchservice.AutoTuneOptions)
 	}
 
 	m["rollback_on_disable"] = aws.StringValue(autoTuneOptions.RollbackOnDisable)
+	m["use_off_peak_window"] = aws.BoolValue(autoTuneOptions.UseOffPeakWindow)
 
 	return m
 }
@@ -238,6 +295,51 @@ func flattenAutoTuneMaintenanceScheduleDuration(autoTuneMaintenanceScheduleDurat
 	return m
 }
 
+func flattenOffPeakWindowOptions(apiObject *opensearchservice.OffPeakWindowOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled": aws.BoolValue(apiObject.Enabled),
+	}
+
+	if v := apiObject.OffPeakWindow; v != nil {
+		tfMap["off_peak_window"] = []interface{}{flattenOffPeakWindow(v)}
+	}
+
+	return tfMap
+}
+
+func flattenOffPeakWindow(apiObject *opensearchservice.OffPeakWindow) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.WindowStartTime; v != nil {
+		tfMap["window_start_time"] = []interface{}{
+			map[string]interface{}{
+				"hours":   aws.Int64Value(v.Hours),
+				"minutes": aws.Int64Value(v.Minutes),
+			},
+		}
+	}
+
+	return tfMap
+}
+
+func flattenSoftwareUpdateOptions(apiObject *opensearchservice.SoftwareUpdateOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"auto_software_update_enabled": aws.BoolValue(apiObject.AutoSoftwareUpdateEnabled),
+	}
+}
+
 func flattenESSAMLOptions(d *schema.ResourceData, samlOptions *opensearchservice.SAMLOptionsOutput_) []interface{} {
 	if samlOptions == nil {
 		return nil
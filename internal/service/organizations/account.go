@@ -271,6 +271,12 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return diags
 	}
 
+	if close {
+		if message := closeAccountLimitExceededMessage(err); message != "" {
+			return sdkdiag.AppendErrorf(diags, "closing AWS Organizations Account (%s): %s: %s", d.Id(), message, err)
+		}
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting AWS Organizations Account (%s): %s", d.Id(), err)
 	}
@@ -284,6 +290,25 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+// closeAccountLimitExceededMessage returns a description of the CloseAccount limit that caused err,
+// or an empty string if err is not the result of exceeding a CloseAccount limit.
+func closeAccountLimitExceededMessage(err error) string {
+	var cve *organizations.ConstraintViolationException
+
+	if !errors.As(err, &cve) {
+		return ""
+	}
+
+	switch aws.StringValue(cve.Reason) {
+	case organizations.ConstraintViolationExceptionReasonCloseAccountQuotaExceeded:
+		return "the CloseAccount quota (10% of member accounts per rolling 30 days) has been reached. Close the account manually or set close_on_deletion to false to only remove it from the organization"
+	case organizations.ConstraintViolationExceptionReasonCloseAccountRequestsLimitExceeded:
+		return "too many accounts are being closed at the same time. Retry once the pending account closures have completed"
+	}
+
+	return ""
+}
+
 func createAccount(ctx context.Context, conn *organizations.Organizations, name, email string, iamUserAccessToBilling, roleName *string, tags []*organizations.Tag, govCloud bool) (*organizations.CreateAccountStatus, error) {
 	if govCloud {
 		input := &organizations.CreateGovCloudAccountInput{
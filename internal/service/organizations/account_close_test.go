@@ -0,0 +1,64 @@
+package organizations
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+func TestCloseAccountLimitExceededMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected string
+	}{
+		"nil": {
+			err: nil,
+		},
+		"other error": {
+			err: errors.New("test"),
+		},
+		"other reason": {
+			err: &organizations.ConstraintViolationException{
+				Reason: aws.String(organizations.ConstraintViolationExceptionReasonAccountNumberLimitExceeded),
+			},
+		},
+		"quota exceeded": {
+			err: &organizations.ConstraintViolationException{
+				Reason: aws.String(organizations.ConstraintViolationExceptionReasonCloseAccountQuotaExceeded),
+			},
+			expected: "10% of member accounts per rolling 30 days",
+		},
+		"requests limit exceeded": {
+			err: &organizations.ConstraintViolationException{
+				Reason: aws.String(organizations.ConstraintViolationExceptionReasonCloseAccountRequestsLimitExceeded),
+			},
+			expected: "closed at the same time",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := closeAccountLimitExceededMessage(testCase.err)
+
+			if testCase.expected == "" {
+				if got != "" {
+					t.Errorf("got %q, expected no message", got)
+				}
+
+				return
+			}
+
+			if !strings.Contains(got, testCase.expected) {
+				t.Errorf("got %q, expected it to contain %q", got, testCase.expected)
+			}
+		})
+	}
+}
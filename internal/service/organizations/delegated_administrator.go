@@ -9,13 +9,19 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	propagationTimeout = 2 * time.Minute
+)
+
 func ResourceDelegatedAdministrator() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDelegatedAdministratorCreate,
@@ -35,7 +41,7 @@ func ResourceDelegatedAdministrator() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(1, 128),
+				ValidateFunc: validDelegatedAdministratorServicePrincipal,
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -70,6 +76,7 @@ func ResourceDelegatedAdministrator() *schema.Resource {
 }
 
 func resourceDelegatedAdministratorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	accountID := d.Get("account_id").(string)
@@ -80,45 +87,47 @@ func resourceDelegatedAdministratorCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	_, err := conn.RegisterDelegatedAdministratorWithContext(ctx, input)
+
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Organizations DelegatedAdministrator (%s): %w", accountID, err))
+		// Delegated administrators can only be registered for services that have trusted access enabled.
+		if enabled, serviceAccessErr := findServiceAccessEnabled(ctx, conn, servicePrincipal); serviceAccessErr == nil && !enabled {
+			return sdkdiag.AppendErrorf(diags, "creating Organizations DelegatedAdministrator (%s): trusted access is not enabled for %s, add it to the organization's aws_service_access_principals: %s", accountID, servicePrincipal, err)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "creating Organizations DelegatedAdministrator (%s): %s", accountID, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", accountID, servicePrincipal))
 
-	return resourceDelegatedAdministratorRead(ctx, d, meta)
+	return append(diags, resourceDelegatedAdministratorRead(ctx, d, meta)...)
 }
 
 func resourceDelegatedAdministratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	accountID, servicePrincipal, err := DecodeOrganizationDelegatedAdministratorID(d.Id())
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error decoding ID AWS Organization (%s) DelegatedAdministrators: %w", d.Id(), err))
-	}
-	input := &organizations.ListDelegatedAdministratorsInput{
-		ServicePrincipal: aws.String(servicePrincipal),
-	}
-	var delegatedAccount *organizations.DelegatedAdministrator
-	err = conn.ListDelegatedAdministratorsPagesWithContext(ctx, input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
-		for _, delegated := range page.DelegatedAdministrators {
-			if aws.StringValue(delegated.Id) == accountID {
-				delegatedAccount = delegated
-			}
-		}
 
-		return !lastPage
-	})
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error listing AWS Organization (%s) DelegatedAdministrators: %w", d.Id(), err))
+		return sdkdiag.AppendErrorf(diags, "decoding ID AWS Organization (%s) DelegatedAdministrators: %s", d.Id(), err)
 	}
 
-	if delegatedAccount == nil {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindDelegatedAdministratorByTwoPartKey(ctx, conn, accountID, servicePrincipal)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] AWS Organization DelegatedAdministrators not found (%s), removing from state", d.Id())
 		d.SetId("")
-		return nil
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AWS Organization (%s) DelegatedAdministrators: %s", d.Id(), err)
 	}
 
+	delegatedAccount := outputRaw.(*organizations.DelegatedAdministrator)
+
 	d.Set("arn", delegatedAccount.Arn)
 	d.Set("delegation_enabled_date", aws.TimeValue(delegatedAccount.DelegationEnabledDate).Format(time.RFC3339))
 	d.Set("email", delegatedAccount.Email)
@@ -129,26 +138,56 @@ func resourceDelegatedAdministratorRead(ctx context.Context, d *schema.ResourceD
 	d.Set("account_id", accountID)
 	d.Set("service_principal", servicePrincipal)
 
-	return nil
+	return diags
 }
 
 func resourceDelegatedAdministratorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsConn()
 
 	accountID, servicePrincipal, err := DecodeOrganizationDelegatedAdministratorID(d.Id())
+
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error decoding ID AWS Organization (%s) DelegatedAdministrators: %w", d.Id(), err))
+		return sdkdiag.AppendErrorf(diags, "decoding ID AWS Organization (%s) DelegatedAdministrators: %s", d.Id(), err)
 	}
-	input := &organizations.DeregisterDelegatedAdministratorInput{
+
+	log.Printf("[DEBUG] Deleting Organizations DelegatedAdministrator: %s", d.Id())
+	_, err = conn.DeregisterDelegatedAdministratorWithContext(ctx, &organizations.DeregisterDelegatedAdministratorInput{
 		AccountId:        aws.String(accountID),
 		ServicePrincipal: aws.String(servicePrincipal),
+	})
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotRegisteredException, organizations.ErrCodeAccountNotFoundException) {
+		return diags
 	}
 
-	_, err = conn.DeregisterDelegatedAdministratorWithContext(ctx, input)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting Organizations DelegatedAdministrator (%s): %w", d.Id(), err))
+		return sdkdiag.AppendErrorf(diags, "deleting Organizations DelegatedAdministrator (%s): %s", d.Id(), err)
 	}
-	return nil
+
+	return diags
+}
+
+func findServiceAccessEnabled(ctx context.Context, conn *organizations.Organizations, servicePrincipal string) (bool, error) {
+	input := &organizations.ListAWSServiceAccessForOrganizationInput{}
+	enabled := false
+
+	err := conn.ListAWSServiceAccessForOrganizationPagesWithContext(ctx, input, func(page *organizations.ListAWSServiceAccessForOrganizationOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EnabledServicePrincipals {
+			if aws.StringValue(v.ServicePrincipal) == servicePrincipal {
+				enabled = true
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return enabled, err
 }
 
 func DecodeOrganizationDelegatedAdministratorID(id string) (string, string, error) {
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func testAccDelegatedAdministrator_basic(t *testing.T) {
@@ -89,28 +89,18 @@ func testAccCheckDelegatedAdministratorDestroy(ctx context.Context) resource.Tes
 			if err != nil {
 				return err
 			}
-			input := &organizations.ListDelegatedAdministratorsInput{
-				ServicePrincipal: aws.String(servicePrincipal),
-			}
 
-			exists := false
-			err = conn.ListDelegatedAdministratorsPagesWithContext(ctx, input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
-				for _, delegated := range page.DelegatedAdministrators {
-					if aws.StringValue(delegated.Id) == accountID {
-						exists = true
-					}
-				}
+			_, err = tforganizations.FindDelegatedAdministratorByTwoPartKey(ctx, conn, accountID, servicePrincipal)
 
-				return !lastPage
-			})
+			if tfresource.NotFound(err) {
+				continue
+			}
 
 			if err != nil {
 				return err
 			}
 
-			if exists {
-				return fmt.Errorf("organization DelegatedAdministrator still exists: %q", rs.Primary.ID)
-			}
+			return fmt.Errorf("organization DelegatedAdministrator still exists: %q", rs.Primary.ID)
 		}
 
 		return nil
@@ -133,32 +123,14 @@ func testAccCheckDelegatedAdministratorExists(ctx context.Context, n string, org
 			return err
 		}
 		conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn()
-		input := &organizations.ListDelegatedAdministratorsInput{
-			ServicePrincipal: aws.String(servicePrincipal),
-		}
 
-		exists := false
-		var resp *organizations.DelegatedAdministrator
-		err = conn.ListDelegatedAdministratorsPagesWithContext(ctx, input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
-			for _, delegated := range page.DelegatedAdministrators {
-				if aws.StringValue(delegated.Id) == accountID {
-					exists = true
-					resp = delegated
-				}
-			}
-
-			return !lastPage
-		})
+		output, err := tforganizations.FindDelegatedAdministratorByTwoPartKey(ctx, conn, accountID, servicePrincipal)
 
 		if err != nil {
 			return err
 		}
 
-		if !exists {
-			return fmt.Errorf("organization DelegatedAdministrator %q does not exist", rs.Primary.ID)
-		}
-
-		*org = *resp
+		*org = *output
 
 		return nil
 	}
@@ -0,0 +1,57 @@
+package organizations
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var servicePrincipalRegexp = regexp.MustCompile(`^([0-9a-z-]+\.)+amazonaws\.com(\.cn)?$`)
+
+// delegatedAdministratorServicePrincipals lists the service principals known to support
+// delegated administration in AWS Organizations.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_integrate_services_list.html.
+var delegatedAdministratorServicePrincipals = map[string]struct{}{
+	"access-analyzer.amazonaws.com":                      {},
+	"account.amazonaws.com":                              {},
+	"auditmanager.amazonaws.com":                         {},
+	"backup.amazonaws.com":                               {},
+	"cloudtrail.amazonaws.com":                           {},
+	"compute-optimizer.amazonaws.com":                    {},
+	"config.amazonaws.com":                               {},
+	"config-multiaccountsetup.amazonaws.com":             {},
+	"detective.amazonaws.com":                            {},
+	"devops-guru.amazonaws.com":                          {},
+	"ds.amazonaws.com":                                   {},
+	"fms.amazonaws.com":                                  {},
+	"guardduty.amazonaws.com":                            {},
+	"health.amazonaws.com":                               {},
+	"inspector2.amazonaws.com":                           {},
+	"ipam.amazonaws.com":                                 {},
+	"license-manager.amazonaws.com":                      {},
+	"macie.amazonaws.com":                                {},
+	"member.org.stacksets.cloudformation.amazonaws.com":  {},
+	"reachabilityanalyzer.networkinsights.amazonaws.com": {},
+	"securityhub.amazonaws.com":                          {},
+	"securitylake.amazonaws.com":                         {},
+	"servicecatalog.amazonaws.com":                       {},
+	"ssm.amazonaws.com":                                  {},
+	"sso.amazonaws.com":                                  {},
+	"storage-lens.s3.amazonaws.com":                      {},
+}
+
+// validDelegatedAdministratorServicePrincipal errors if the value is not a service principal
+// and warns if the service principal is not known to support delegated administration.
+func validDelegatedAdministratorServicePrincipal(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !servicePrincipalRegexp.MatchString(value) || len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q (%s) must be an AWS service principal, e.g. guardduty.amazonaws.com", k, value))
+		return
+	}
+
+	if _, ok := delegatedAdministratorServicePrincipals[value]; !ok {
+		ws = append(ws, fmt.Sprintf("%q (%s) is not a service principal known to support delegated administration", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,50 @@
+package organizations
+
+import (
+	"testing"
+)
+
+func TestValidDelegatedAdministratorServicePrincipal(t *testing.T) {
+	t.Parallel()
+
+	knownPrincipals := []string{
+		"guardduty.amazonaws.com",
+		"member.org.stacksets.cloudformation.amazonaws.com",
+	}
+
+	for _, s := range knownPrincipals {
+		ws, errors := validDelegatedAdministratorServicePrincipal(s, "service_principal")
+		if len(errors) > 0 || len(ws) > 0 {
+			t.Fatalf("%q should be a known delegated administrator service principal: %v %v", s, ws, errors)
+		}
+	}
+
+	unknownPrincipals := []string{
+		"example.amazonaws.com",
+		"example.amazonaws.com.cn",
+	}
+
+	for _, s := range unknownPrincipals {
+		ws, errors := validDelegatedAdministratorServicePrincipal(s, "service_principal")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid service principal: %v", s, errors)
+		}
+		if len(ws) == 0 {
+			t.Fatalf("%q should warn as an unknown delegated administrator service principal", s)
+		}
+	}
+
+	invalidPrincipals := []string{
+		"",
+		"guardduty",
+		"arn:aws:iam::123456789012:root", //lintignore:AWSAT005
+		"GuardDuty.amazonaws.com",
+	}
+
+	for _, s := range invalidPrincipals {
+		_, errors := validDelegatedAdministratorServicePrincipal(s, "service_principal")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid service principal", s)
+		}
+	}
+}
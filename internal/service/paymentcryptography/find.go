@@ -0,0 +1,66 @@
+package paymentcryptography
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindKeyByARN(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string) (*paymentcryptography.Key, error) {
+	in := &paymentcryptography.GetKeyInput{
+		KeyIdentifier: aws.String(arn),
+	}
+	out, err := conn.GetKeyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Key == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	// Keys scheduled for deletion can no longer be used and are gone for all
+	// practical purposes.
+	if state := aws.StringValue(out.Key.KeyState); state == paymentcryptography.KeyStateDeletePending || state == paymentcryptography.KeyStateDeleteComplete {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: in,
+		}
+	}
+
+	return out.Key, nil
+}
+
+func FindKeyAliasByName(ctx context.Context, conn *paymentcryptography.PaymentCryptography, name string) (*paymentcryptography.Alias, error) {
+	in := &paymentcryptography.GetAliasInput{
+		AliasName: aws.String(name),
+	}
+	out, err := conn.GetAliasWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Alias == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Alias, nil
+}
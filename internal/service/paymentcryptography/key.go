@@ -0,0 +1,382 @@
+package paymentcryptography
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyCreate,
+		ReadWithoutTimeout:   resourceKeyRead,
+		UpdateWithoutTimeout: resourceKeyUpdate,
+		DeleteWithoutTimeout: resourceKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_window_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(3, 180),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exportable": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_attributes": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(paymentcryptography.KeyAlgorithm_Values(), false),
+						},
+						"key_class": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(paymentcryptography.KeyClass_Values(), false),
+						},
+						"key_modes_of_use": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"decrypt": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"derive_key": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"encrypt": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"generate": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"no_restrictions": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"sign": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"unwrap": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"verify": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+									"wrap": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+						"key_usage": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(paymentcryptography.KeyUsage_Values(), false),
+						},
+					},
+				},
+			},
+			"key_check_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_check_value_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(paymentcryptography.KeyCheckValueAlgorithm_Values(), false),
+			},
+			"key_origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameKey = "Key"
+)
+
+func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	in := &paymentcryptography.CreateKeyInput{
+		Enabled:       aws.Bool(d.Get("enabled").(bool)),
+		Exportable:    aws.Bool(d.Get("exportable").(bool)),
+		KeyAttributes: expandKeyAttributes(d.Get("key_attributes").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("key_check_value_algorithm"); ok {
+		in.KeyCheckValueAlgorithm = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateKeyWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionCreating, ResNameKey, "", err)
+	}
+
+	d.SetId(aws.StringValue(out.Key.KeyArn))
+
+	if _, err := waitKeyCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionWaitingForCreation, ResNameKey, d.Id(), err)
+	}
+
+	return resourceKeyRead(ctx, d, meta)
+}
+
+func resourceKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	out, err := FindKeyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionReading, ResNameKey, d.Id(), err)
+	}
+
+	d.Set("arn", out.KeyArn)
+	d.Set("enabled", out.Enabled)
+	d.Set("exportable", out.Exportable)
+
+	if err := d.Set("key_attributes", flattenKeyAttributes(out.KeyAttributes)); err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionSetting, ResNameKey, d.Id(), err)
+	}
+
+	d.Set("key_check_value", out.KeyCheckValue)
+	d.Set("key_check_value_algorithm", out.KeyCheckValueAlgorithm)
+	d.Set("key_origin", out.KeyOrigin)
+	d.Set("key_state", out.KeyState)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionReading, ResNameKey, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionSetting, ResNameKey, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionSetting, ResNameKey, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	if d.HasChange("enabled") {
+		var err error
+
+		if d.Get("enabled").(bool) {
+			_, err = conn.StartKeyUsageWithContext(ctx, &paymentcryptography.StartKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+		} else {
+			_, err = conn.StopKeyUsageWithContext(ctx, &paymentcryptography.StopKeyUsageInput{
+				KeyIdentifier: aws.String(d.Id()),
+			})
+		}
+
+		if err != nil {
+			return create.DiagError(names.PaymentCryptography, create.ErrActionUpdating, ResNameKey, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.PaymentCryptography, create.ErrActionUpdating, ResNameKey, d.Id(), err)
+		}
+	}
+
+	return resourceKeyRead(ctx, d, meta)
+}
+
+func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	log.Printf("[INFO] Deleting Payment Cryptography Key %s", d.Id())
+
+	_, err := conn.DeleteKeyWithContext(ctx, &paymentcryptography.DeleteKeyInput{
+		DeleteKeyInDays: aws.Int64(int64(d.Get("deletion_window_in_days").(int))),
+		KeyIdentifier:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionDeleting, ResNameKey, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandKeyAttributes(tfList []interface{}) *paymentcryptography.KeyAttributes {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &paymentcryptography.KeyAttributes{
+		KeyAlgorithm:  aws.String(tfMap["key_algorithm"].(string)),
+		KeyClass:      aws.String(tfMap["key_class"].(string)),
+		KeyModesOfUse: expandKeyModesOfUse(tfMap["key_modes_of_use"].([]interface{})),
+		KeyUsage:      aws.String(tfMap["key_usage"].(string)),
+	}
+
+	return apiObject
+}
+
+func expandKeyModesOfUse(tfList []interface{}) *paymentcryptography.KeyModesOfUse {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return &paymentcryptography.KeyModesOfUse{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &paymentcryptography.KeyModesOfUse{
+		Decrypt:        aws.Bool(tfMap["decrypt"].(bool)),
+		DeriveKey:      aws.Bool(tfMap["derive_key"].(bool)),
+		Encrypt:        aws.Bool(tfMap["encrypt"].(bool)),
+		Generate:       aws.Bool(tfMap["generate"].(bool)),
+		NoRestrictions: aws.Bool(tfMap["no_restrictions"].(bool)),
+		Sign:           aws.Bool(tfMap["sign"].(bool)),
+		Unwrap:         aws.Bool(tfMap["unwrap"].(bool)),
+		Verify:         aws.Bool(tfMap["verify"].(bool)),
+		Wrap:           aws.Bool(tfMap["wrap"].(bool)),
+	}
+}
+
+func flattenKeyAttributes(apiObject *paymentcryptography.KeyAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"key_algorithm": aws.StringValue(apiObject.KeyAlgorithm),
+		"key_class":     aws.StringValue(apiObject.KeyClass),
+		"key_usage":     aws.StringValue(apiObject.KeyUsage),
+	}
+
+	if v := apiObject.KeyModesOfUse; v != nil {
+		tfMap["key_modes_of_use"] = []interface{}{
+			map[string]interface{}{
+				"decrypt":         aws.BoolValue(v.Decrypt),
+				"derive_key":      aws.BoolValue(v.DeriveKey),
+				"encrypt":         aws.BoolValue(v.Encrypt),
+				"generate":        aws.BoolValue(v.Generate),
+				"no_restrictions": aws.BoolValue(v.NoRestrictions),
+				"sign":            aws.BoolValue(v.Sign),
+				"unwrap":          aws.BoolValue(v.Unwrap),
+				"verify":          aws.BoolValue(v.Verify),
+				"wrap":            aws.BoolValue(v.Wrap),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
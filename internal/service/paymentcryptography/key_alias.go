@@ -0,0 +1,138 @@
+package paymentcryptography
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceKeyAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceKeyAliasCreate,
+		ReadWithoutTimeout:   resourceKeyAliasRead,
+		UpdateWithoutTimeout: resourceKeyAliasUpdate,
+		DeleteWithoutTimeout: resourceKeyAliasDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(7, 256),
+					validation.StringMatch(regexp.MustCompile(`^alias/[a-zA-Z0-9/_-]+$`), "must begin with alias/ and contain only alphanumeric characters, forward slashes, underscores or hyphens"),
+				),
+			},
+			"key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	ResNameKeyAlias = "Key Alias"
+)
+
+func resourceKeyAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	name := d.Get("alias_name").(string)
+	in := &paymentcryptography.CreateAliasInput{
+		AliasName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("key_arn"); ok {
+		in.KeyArn = aws.String(v.(string))
+	}
+
+	out, err := conn.CreateAliasWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionCreating, ResNameKeyAlias, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.Alias.AliasName))
+
+	return resourceKeyAliasRead(ctx, d, meta)
+}
+
+func resourceKeyAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	out, err := FindKeyAliasByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Payment Cryptography Key Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionReading, ResNameKeyAlias, d.Id(), err)
+	}
+
+	d.Set("alias_name", out.AliasName)
+	d.Set("key_arn", out.KeyArn)
+
+	return nil
+}
+
+func resourceKeyAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	if d.HasChange("key_arn") {
+		in := &paymentcryptography.UpdateAliasInput{
+			AliasName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("key_arn"); ok {
+			in.KeyArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Payment Cryptography Key Alias (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateAliasWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.PaymentCryptography, create.ErrActionUpdating, ResNameKeyAlias, d.Id(), err)
+		}
+	}
+
+	return resourceKeyAliasRead(ctx, d, meta)
+}
+
+func resourceKeyAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).PaymentCryptographyConn()
+
+	log.Printf("[INFO] Deleting Payment Cryptography Key Alias %s", d.Id())
+
+	_, err := conn.DeleteAliasWithContext(ctx, &paymentcryptography.DeleteAliasInput{
+		AliasName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, paymentcryptography.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.PaymentCryptography, create.ErrActionDeleting, ResNameKeyAlias, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package paymentcryptography_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfpaymentcryptography "github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPaymentCryptographyKeyAlias_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias paymentcryptography.Alias
+	rName := "alias/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.PaymentCryptography, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test.0", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKeyAlias_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias paymentcryptography.Alias
+	rName := "alias/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.PaymentCryptography, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName, &alias),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpaymentcryptography.ResourceKeyAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPaymentCryptographyKeyAlias_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var alias paymentcryptography.Alias
+	rName := "alias/" + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_paymentcryptography_key_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.PaymentCryptography, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, paymentcryptography.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test.0", "arn"),
+				),
+			},
+			{
+				Config: testAccKeyAliasConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyAliasDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_paymentcryptography_key_alias" {
+				continue
+			}
+
+			_, err := tfpaymentcryptography.FindKeyAliasByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.PaymentCryptography, create.ErrActionCheckingDestroyed, tfpaymentcryptography.ResNameKeyAlias, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyAliasExists(ctx context.Context, name string, alias *paymentcryptography.Alias) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.PaymentCryptography, create.ErrActionCheckingExistence, tfpaymentcryptography.ResNameKeyAlias, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.PaymentCryptography, create.ErrActionCheckingExistence, tfpaymentcryptography.ResNameKeyAlias, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyConn()
+
+		output, err := tfpaymentcryptography.FindKeyAliasByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.PaymentCryptography, create.ErrActionCheckingExistence, tfpaymentcryptography.ResNameKeyAlias, rs.Primary.ID, err)
+		}
+
+		*alias = *output
+
+		return nil
+	}
+}
+
+func testAccKeyAliasConfig_base() string {
+	return `
+resource "aws_paymentcryptography_key" "test" {
+  count = 2
+
+  exportable = true
+
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_P0_PIN_ENCRYPTION_KEY"
+
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      wrap    = true
+      unwrap  = true
+    }
+  }
+}
+`
+}
+
+func testAccKeyAliasConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccKeyAliasConfig_base(), fmt.Sprintf(`
+resource "aws_paymentcryptography_key_alias" "test" {
+  alias_name = %[1]q
+  key_arn    = aws_paymentcryptography_key.test[0].arn
+}
+`, rName))
+}
+
+func testAccKeyAliasConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccKeyAliasConfig_base(), fmt.Sprintf(`
+resource "aws_paymentcryptography_key_alias" "test" {
+  alias_name = %[1]q
+  key_arn    = aws_paymentcryptography_key.test[1].arn
+}
+`, rName))
+}
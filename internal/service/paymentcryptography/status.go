@@ -0,0 +1,26 @@
+package paymentcryptography
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusKey(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindKeyByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.KeyState), nil
+	}
+}
@@ -0,0 +1,121 @@
+//go:build sweep
+// +build sweep
+
+package paymentcryptography
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_paymentcryptography_key", &resource.Sweeper{
+		Name: "aws_paymentcryptography_key",
+		F:    sweepKeys,
+		Dependencies: []string{
+			"aws_paymentcryptography_key_alias",
+		},
+	})
+
+	resource.AddTestSweepers("aws_paymentcryptography_key_alias", &resource.Sweeper{
+		Name: "aws_paymentcryptography_key_alias",
+		F:    sweepKeyAliases,
+	})
+}
+
+func sweepKeys(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PaymentCryptographyConn()
+	input := &paymentcryptography.ListKeysInput{
+		KeyState: aws.String(paymentcryptography.KeyStateCreateComplete),
+	}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListKeysPagesWithContext(ctx, input, func(page *paymentcryptography.ListKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Keys {
+			r := ResourceKey()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.KeyArn))
+			d.Set("deletion_window_in_days", 3)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Payment Cryptography Key sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Payment Cryptography Keys (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Payment Cryptography Keys (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepKeyAliases(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PaymentCryptographyConn()
+	input := &paymentcryptography.ListAliasesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAliasesPagesWithContext(ctx, input, func(page *paymentcryptography.ListAliasesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Aliases {
+			r := ResourceKeyAlias()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.AliasName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Payment Cryptography Key Alias sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Payment Cryptography Key Aliases (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Payment Cryptography Key Aliases (%s): %w", region, err)
+	}
+
+	return nil
+}
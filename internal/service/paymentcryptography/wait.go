@@ -0,0 +1,26 @@
+package paymentcryptography
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/paymentcryptography"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitKeyCreated(ctx context.Context, conn *paymentcryptography.PaymentCryptography, arn string, timeout time.Duration) (*paymentcryptography.Key, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{paymentcryptography.KeyStateCreateInProgress},
+		Target:  []string{paymentcryptography.KeyStateCreateComplete},
+		Refresh: statusKey(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*paymentcryptography.Key); ok {
+		return out, err
+	}
+
+	return nil, err
+}
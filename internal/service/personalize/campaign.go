@@ -0,0 +1,399 @@
+package personalize
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCampaign() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCampaignCreate,
+		ReadWithoutTimeout:   resourceCampaignRead,
+		UpdateWithoutTimeout: resourceCampaignUpdate,
+		DeleteWithoutTimeout: resourceCampaignDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_metadata_with_recommendations": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"item_exploration_config": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"sync_with_latest_solution_version": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"min_provisioned_tps": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"solution_version_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCampaignCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &personalize.CreateCampaignInput{
+		MinProvisionedTPS:  aws.Int64(int64(d.Get("min_provisioned_tps").(int))),
+		Name:               aws.String(name),
+		SolutionVersionArn: aws.String(d.Get("solution_version_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("campaign_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CampaignConfig = expandCampaignConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateCampaignWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Personalize Campaign (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CampaignArn))
+
+	if _, err := waitCampaignActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Personalize Campaign (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCampaignRead(ctx, d, meta)...)
+}
+
+func resourceCampaignRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	campaign, err := FindCampaignByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Personalize Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Personalize Campaign (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", campaign.CampaignArn)
+	if campaign.CampaignConfig != nil {
+		if err := d.Set("campaign_config", []interface{}{flattenCampaignConfig(campaign.CampaignConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting campaign_config: %s", err)
+		}
+	} else {
+		d.Set("campaign_config", nil)
+	}
+	d.Set("min_provisioned_tps", campaign.MinProvisionedTPS)
+	d.Set("name", campaign.Name)
+	d.Set("solution_version_arn", campaign.SolutionVersionArn)
+	d.Set("status", campaign.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Personalize Campaign (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceCampaignUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &personalize.UpdateCampaignInput{
+			CampaignArn:        aws.String(d.Id()),
+			MinProvisionedTPS:  aws.Int64(int64(d.Get("min_provisioned_tps").(int))),
+			SolutionVersionArn: aws.String(d.Get("solution_version_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("campaign_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.CampaignConfig = expandCampaignConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateCampaignWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Personalize Campaign (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitCampaignUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Personalize Campaign (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Personalize Campaign (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceCampaignRead(ctx, d, meta)...)
+}
+
+func resourceCampaignDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+
+	log.Printf("[DEBUG] Deleting Personalize Campaign: %s", d.Id())
+	_, err := conn.DeleteCampaignWithContext(ctx, &personalize.DeleteCampaignInput{
+		CampaignArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Personalize Campaign (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitCampaignDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Personalize Campaign (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindCampaignByARN(ctx context.Context, conn *personalize.Personalize, arn string) (*personalize.Campaign, error) {
+	input := &personalize.DescribeCampaignInput{
+		CampaignArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCampaignWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Campaign == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Campaign, nil
+}
+
+func statusCampaign(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCampaignByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusCampaignUpdate(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCampaignByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.LatestCampaignUpdate == nil {
+			return output, aws.StringValue(output.Status), nil
+		}
+
+		return output, aws.StringValue(output.LatestCampaignUpdate.Status), nil
+	}
+}
+
+func waitCampaignActive(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Campaign, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusCampaign(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Campaign); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCampaignUpdated(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Campaign, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress, statusUpdatePending, statusUpdateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusCampaignUpdate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Campaign); ok {
+		if v := output.LatestCampaignUpdate; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCampaignDeleted(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Campaign, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusActive, statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusCampaign(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Campaign); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandCampaignConfig(tfMap map[string]interface{}) *personalize.CampaignConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &personalize.CampaignConfig{}
+
+	if v, ok := tfMap["enable_metadata_with_recommendations"].(bool); ok {
+		apiObject.EnableMetadataWithRecommendations = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["item_exploration_config"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.ItemExplorationConfig = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["sync_with_latest_solution_version"].(bool); ok {
+		apiObject.SyncWithLatestSolutionVersion = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenCampaignConfig(apiObject *personalize.CampaignConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_metadata_with_recommendations": aws.BoolValue(apiObject.EnableMetadataWithRecommendations),
+		"item_exploration_config":              aws.StringValueMap(apiObject.ItemExplorationConfig),
+		"sync_with_latest_solution_version":    aws.BoolValue(apiObject.SyncWithLatestSolutionVersion),
+	}
+
+	return tfMap
+}
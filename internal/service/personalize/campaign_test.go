@@ -0,0 +1,126 @@
+package personalize_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/personalize"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpersonalize "github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Training a solution version takes hours, so the campaign tests
+// require an existing solution version.
+func TestAccPersonalizeCampaign_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "PERSONALIZE_SOLUTION_VERSION_ARN"
+	solutionVersionARN := os.Getenv(key)
+	if solutionVersionARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v personalize.Campaign
+	resourceName := "aws_personalize_campaign.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName, solutionVersionARN, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "personalize", regexp.MustCompile(`campaign/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "min_provisioned_tps", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "solution_version_arn", solutionVersionARN),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCampaignConfig_basic(rName, solutionVersionARN, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "min_provisioned_tps", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCampaignExists(ctx context.Context, n string, v *personalize.Campaign) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Personalize Campaign ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn()
+
+		output, err := tfpersonalize.FindCampaignByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCampaignDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_personalize_campaign" {
+				continue
+			}
+
+			_, err := tfpersonalize.FindCampaignByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Personalize Campaign %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCampaignConfig_basic(rName, solutionVersionARN string, minProvisionedTPS int) string {
+	return fmt.Sprintf(`
+resource "aws_personalize_campaign" "test" {
+  name                 = %[1]q
+  solution_version_arn = %[2]q
+  min_provisioned_tps  = %[3]d
+}
+`, rName, solutionVersionARN, minProvisionedTPS)
+}
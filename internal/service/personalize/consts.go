@@ -0,0 +1,30 @@
+package personalize
+
+const (
+	statusActive           = "ACTIVE"
+	statusCreateFailed     = "CREATE FAILED"
+	statusCreateInProgress = "CREATE IN_PROGRESS"
+	statusCreatePending    = "CREATE PENDING"
+	statusDeleteInProgress = "DELETE IN_PROGRESS"
+	statusDeletePending    = "DELETE PENDING"
+	statusUpdateInProgress = "UPDATE IN_PROGRESS"
+	statusUpdatePending    = "UPDATE PENDING"
+)
+
+const (
+	datasetTypeActionInteractions = "Action_Interactions"
+	datasetTypeActions            = "Actions"
+	datasetTypeInteractions       = "Interactions"
+	datasetTypeItems              = "Items"
+	datasetTypeUsers              = "Users"
+)
+
+func datasetType_Values() []string {
+	return []string{
+		datasetTypeActionInteractions,
+		datasetTypeActions,
+		datasetTypeInteractions,
+		datasetTypeItems,
+		datasetTypeUsers,
+	}
+}
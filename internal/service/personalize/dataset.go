@@ -0,0 +1,328 @@
+package personalize
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDatasetCreate,
+		ReadWithoutTimeout:   resourceDatasetRead,
+		UpdateWithoutTimeout: resourceDatasetUpdate,
+		DeleteWithoutTimeout: resourceDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"dataset_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(datasetType_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"schema_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatasetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &personalize.CreateDatasetInput{
+		DatasetGroupArn: aws.String(d.Get("dataset_group_arn").(string)),
+		DatasetType:     aws.String(d.Get("dataset_type").(string)),
+		Name:            aws.String(name),
+		SchemaArn:       aws.String(d.Get("schema_arn").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateDatasetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Personalize Dataset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DatasetArn))
+
+	if _, err := waitDatasetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Personalize Dataset (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	dataset, err := FindDatasetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Personalize Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Personalize Dataset (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataset.DatasetArn)
+	d.Set("dataset_group_arn", dataset.DatasetGroupArn)
+	d.Set("dataset_type", dataset.DatasetType)
+	d.Set("name", dataset.Name)
+	d.Set("schema_arn", dataset.SchemaArn)
+	d.Set("status", dataset.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Personalize Dataset (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDatasetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+
+	if d.HasChange("schema_arn") {
+		input := &personalize.UpdateDatasetInput{
+			DatasetArn: aws.String(d.Id()),
+			SchemaArn:  aws.String(d.Get("schema_arn").(string)),
+		}
+
+		_, err := conn.UpdateDatasetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Personalize Dataset (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDatasetUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Personalize Dataset (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Personalize Dataset (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDatasetRead(ctx, d, meta)...)
+}
+
+func resourceDatasetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+
+	log.Printf("[DEBUG] Deleting Personalize Dataset: %s", d.Id())
+	_, err := conn.DeleteDatasetWithContext(ctx, &personalize.DeleteDatasetInput{
+		DatasetArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Personalize Dataset (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDatasetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Personalize Dataset (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindDatasetByARN(ctx context.Context, conn *personalize.Personalize, arn string) (*personalize.Dataset, error) {
+	input := &personalize.DescribeDatasetInput{
+		DatasetArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDatasetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dataset == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dataset, nil
+}
+
+func statusDataset(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDatasetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusDatasetUpdate(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDatasetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.LatestDatasetUpdate == nil {
+			return output, aws.StringValue(output.Status), nil
+		}
+
+		return output, aws.StringValue(output.LatestDatasetUpdate.Status), nil
+	}
+}
+
+func waitDatasetActive(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Dataset, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusDataset(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Dataset); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDatasetUpdated(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Dataset, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusUpdatePending, statusUpdateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusDatasetUpdate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Dataset); ok {
+		if v := output.LatestDatasetUpdate; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDatasetDeleted(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Dataset, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusDataset(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Dataset); ok {
+		return output, err
+	}
+
+	return nil, err
+}
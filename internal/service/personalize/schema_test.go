@@ -0,0 +1,152 @@
+package personalize_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/personalize"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpersonalize "github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPersonalizeSchema_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v personalize.DatasetSchema
+	resourceName := "aws_personalize_schema.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "personalize", regexp.MustCompile(`schema/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "domain", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPersonalizeSchema_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v personalize.DatasetSchema
+	resourceName := "aws_personalize_schema.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpersonalize.ResourceSchema(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaExists(ctx context.Context, n string, v *personalize.DatasetSchema) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Personalize Schema ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn()
+
+		output, err := tfpersonalize.FindSchemaByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSchemaDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_personalize_schema" {
+				continue
+			}
+
+			_, err := tfpersonalize.FindSchemaByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Personalize Schema %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSchemaConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_personalize_schema" "test" {
+  name = %[1]q
+
+  schema = jsonencode({
+    type      = "record"
+    name      = "Interactions"
+    namespace = "com.amazonaws.personalize.schema"
+    fields = [
+      {
+        name = "USER_ID"
+        type = "string"
+      },
+      {
+        name = "ITEM_ID"
+        type = "string"
+      },
+      {
+        name = "TIMESTAMP"
+        type = "long"
+      },
+    ]
+    version = "1.0"
+  })
+}
+`, rName)
+}
@@ -0,0 +1,381 @@
+package personalize
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSolution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSolutionCreate,
+		ReadWithoutTimeout:   resourceSolutionRead,
+		UpdateWithoutTimeout: resourceSolutionUpdate,
+		DeleteWithoutTimeout: resourceSolutionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"event_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"perform_auto_ml": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"perform_auto_training": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"perform_hpo": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"recipe_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"solution_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm_hyper_parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"event_value_threshold": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"feature_transformation_parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceSolutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &personalize.CreateSolutionInput{
+		DatasetGroupArn: aws.String(d.Get("dataset_group_arn").(string)),
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("event_type"); ok {
+		input.EventType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("perform_auto_ml"); ok {
+		input.PerformAutoML = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("perform_auto_training"); ok {
+		input.PerformAutoTraining = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("perform_hpo"); ok {
+		input.PerformHPO = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("recipe_arn"); ok {
+		input.RecipeArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("solution_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SolutionConfig = expandSolutionConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateSolutionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Personalize Solution (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SolutionArn))
+
+	if _, err := waitSolutionActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Personalize Solution (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSolutionRead(ctx, d, meta)...)
+}
+
+func resourceSolutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	solution, err := FindSolutionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Personalize Solution (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Personalize Solution (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", solution.SolutionArn)
+	d.Set("dataset_group_arn", solution.DatasetGroupArn)
+	d.Set("event_type", solution.EventType)
+	d.Set("name", solution.Name)
+	d.Set("perform_auto_ml", solution.PerformAutoML)
+	d.Set("perform_auto_training", solution.PerformAutoTraining)
+	d.Set("perform_hpo", solution.PerformHPO)
+	d.Set("recipe_arn", solution.RecipeArn)
+	if solution.SolutionConfig != nil {
+		if err := d.Set("solution_config", []interface{}{flattenSolutionConfig(solution.SolutionConfig)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting solution_config: %s", err)
+		}
+	} else {
+		d.Set("solution_config", nil)
+	}
+	d.Set("status", solution.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Personalize Solution (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSolutionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Personalize Solution (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSolutionRead(ctx, d, meta)...)
+}
+
+func resourceSolutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PersonalizeConn()
+
+	log.Printf("[DEBUG] Deleting Personalize Solution: %s", d.Id())
+	_, err := conn.DeleteSolutionWithContext(ctx, &personalize.DeleteSolutionInput{
+		SolutionArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Personalize Solution (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSolutionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Personalize Solution (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindSolutionByARN(ctx context.Context, conn *personalize.Personalize, arn string) (*personalize.Solution, error) {
+	input := &personalize.DescribeSolutionInput{
+		SolutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSolutionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, personalize.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Solution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Solution, nil
+}
+
+func statusSolution(ctx context.Context, conn *personalize.Personalize, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSolutionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitSolutionActive(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Solution, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusCreatePending, statusCreateInProgress},
+		Target:  []string{statusActive},
+		Refresh: statusSolution(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Solution); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSolutionDeleted(ctx context.Context, conn *personalize.Personalize, arn string, timeout time.Duration) (*personalize.Solution, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{statusDeletePending, statusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusSolution(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*personalize.Solution); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandSolutionConfig(tfMap map[string]interface{}) *personalize.SolutionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &personalize.SolutionConfig{}
+
+	if v, ok := tfMap["algorithm_hyper_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.AlgorithmHyperParameters = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["event_value_threshold"].(string); ok && v != "" {
+		apiObject.EventValueThreshold = aws.String(v)
+	}
+
+	if v, ok := tfMap["feature_transformation_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.FeatureTransformationParameters = flex.ExpandStringMap(v)
+	}
+
+	return apiObject
+}
+
+func flattenSolutionConfig(apiObject *personalize.SolutionConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"algorithm_hyper_parameters":        aws.StringValueMap(apiObject.AlgorithmHyperParameters),
+		"event_value_threshold":             aws.StringValue(apiObject.EventValueThreshold),
+		"feature_transformation_parameters": aws.StringValueMap(apiObject.FeatureTransformationParameters),
+	}
+
+	return tfMap
+}
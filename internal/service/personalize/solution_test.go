@@ -0,0 +1,138 @@
+package personalize_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/personalize"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpersonalize "github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPersonalizeSolution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v personalize.Solution
+	resourceName := "aws_personalize_solution.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSolutionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolutionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolutionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "personalize", regexp.MustCompile(`solution/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "dataset_group_arn", "aws_personalize_dataset_group.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "perform_auto_ml", "false"),
+					resource.TestCheckResourceAttr(resourceName, "perform_hpo", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "recipe_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPersonalizeSolution_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v personalize.Solution
+	resourceName := "aws_personalize_solution.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, personalize.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSolutionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolutionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolutionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfpersonalize.ResourceSolution(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSolutionExists(ctx context.Context, n string, v *personalize.Solution) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Personalize Solution ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn()
+
+		output, err := tfpersonalize.FindSolutionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSolutionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PersonalizeConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_personalize_solution" {
+				continue
+			}
+
+			_, err := tfpersonalize.FindSolutionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Personalize Solution %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSolutionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_personalize_solution" "test" {
+  dataset_group_arn = aws_personalize_dataset.test.dataset_group_arn
+  name              = %[1]q
+  recipe_arn        = "arn:${data.aws_partition.current.partition}:personalize:::recipe/aws-user-personalization"
+}
+`, rName))
+}
@@ -0,0 +1,272 @@
+//go:build sweep
+// +build sweep
+
+package personalize
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_personalize_campaign", &resource.Sweeper{
+		Name: "aws_personalize_campaign",
+		F:    sweepCampaigns,
+	})
+
+	resource.AddTestSweepers("aws_personalize_dataset", &resource.Sweeper{
+		Name: "aws_personalize_dataset",
+		F:    sweepDatasets,
+	})
+
+	resource.AddTestSweepers("aws_personalize_dataset_group", &resource.Sweeper{
+		Name: "aws_personalize_dataset_group",
+		F:    sweepDatasetGroups,
+		Dependencies: []string{
+			"aws_personalize_dataset",
+			"aws_personalize_solution",
+		},
+	})
+
+	resource.AddTestSweepers("aws_personalize_schema", &resource.Sweeper{
+		Name: "aws_personalize_schema",
+		F:    sweepSchemas,
+		Dependencies: []string{
+			"aws_personalize_dataset",
+		},
+	})
+
+	resource.AddTestSweepers("aws_personalize_solution", &resource.Sweeper{
+		Name: "aws_personalize_solution",
+		F:    sweepSolutions,
+		Dependencies: []string{
+			"aws_personalize_campaign",
+		},
+	})
+}
+
+func sweepCampaigns(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PersonalizeConn()
+	input := &personalize.ListCampaignsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCampaignsPagesWithContext(ctx, input, func(page *personalize.ListCampaignsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Campaigns {
+			r := ResourceCampaign()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CampaignArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Personalize Campaign sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Personalize Campaigns (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Personalize Campaigns (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDatasets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PersonalizeConn()
+	input := &personalize.ListDatasetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDatasetsPagesWithContext(ctx, input, func(page *personalize.ListDatasetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Datasets {
+			r := ResourceDataset()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatasetArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Personalize Dataset sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Personalize Datasets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Personalize Datasets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDatasetGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PersonalizeConn()
+	input := &personalize.ListDatasetGroupsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListDatasetGroupsPagesWithContext(ctx, input, func(page *personalize.ListDatasetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DatasetGroups {
+			r := ResourceDatasetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.DatasetGroupArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Personalize Dataset Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Personalize Dataset Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Personalize Dataset Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSchemas(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PersonalizeConn()
+	input := &personalize.ListSchemasInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListSchemasPagesWithContext(ctx, input, func(page *personalize.ListSchemasOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Schemas {
+			r := ResourceSchema()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SchemaArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Personalize Schema sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Personalize Schemas (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Personalize Schemas (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSolutions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).PersonalizeConn()
+	input := &personalize.ListSolutionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListSolutionsPagesWithContext(ctx, input, func(page *personalize.ListSolutionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Solutions {
+			r := ResourceSolution()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.SolutionArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Personalize Solution sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Personalize Solutions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Personalize Solutions (%s): %w", region, err)
+	}
+
+	return nil
+}
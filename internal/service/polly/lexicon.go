@@ -0,0 +1,164 @@
+package polly
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/polly"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceLexicon() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLexiconPut,
+		ReadWithoutTimeout:   resourceLexiconRead,
+		UpdateWithoutTimeout: resourceLexiconPut,
+		DeleteWithoutTimeout: resourceLexiconDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alphabet": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validLexiconContent,
+			},
+			"language_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lexemes_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 20),
+					validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z]+$`), "must contain only alphanumeric characters"),
+				),
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLexiconPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyConn()
+
+	name := d.Get("name").(string)
+	input := &polly.PutLexiconInput{
+		Content: aws.String(d.Get("content").(string)),
+		Name:    aws.String(name),
+	}
+
+	_, err := conn.PutLexiconWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Polly Lexicon (%s): %s", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(name)
+	}
+
+	return append(diags, resourceLexiconRead(ctx, d, meta)...)
+}
+
+func resourceLexiconRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyConn()
+
+	output, err := FindLexiconByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Polly Lexicon (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Polly Lexicon (%s): %s", d.Id(), err)
+	}
+
+	attributes := output.LexiconAttributes
+	d.Set("alphabet", attributes.Alphabet)
+	d.Set("arn", attributes.LexiconArn)
+	d.Set("content", output.Lexicon.Content)
+	d.Set("language_code", attributes.LanguageCode)
+	d.Set("lexemes_count", attributes.LexemesCount)
+	d.Set("name", output.Lexicon.Name)
+	d.Set("size", attributes.Size)
+
+	return diags
+}
+
+func resourceLexiconDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).PollyConn()
+
+	log.Printf("[DEBUG] Deleting Polly Lexicon: %s", d.Id())
+	_, err := conn.DeleteLexiconWithContext(ctx, &polly.DeleteLexiconInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, polly.ErrCodeLexiconNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Polly Lexicon (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindLexiconByName(ctx context.Context, conn *polly.Polly, name string) (*polly.GetLexiconOutput, error) {
+	input := &polly.GetLexiconInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetLexiconWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, polly.ErrCodeLexiconNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Lexicon == nil || output.LexiconAttributes == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,40 @@
+package polly
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// validLexiconContent checks that the value is a well-formed XML document
+// whose root element is a Pronunciation Lexicon Specification (PLS) <lexicon>.
+func validLexiconContent(v interface{}, k string) (ws []string, errs []error) {
+	value := v.(string)
+	decoder := xml.NewDecoder(strings.NewReader(value))
+	var root string
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%q must be a valid XML document: %w", k, err))
+			return
+		}
+
+		if element, ok := token.(xml.StartElement); ok && root == "" {
+			root = element.Name.Local
+		}
+	}
+
+	if root != "lexicon" {
+		errs = append(errs, fmt.Errorf("%q must be a PLS document with a <lexicon> root element", k))
+	}
+
+	return
+}
@@ -0,0 +1,39 @@
+package polly
+
+import (
+	"testing"
+)
+
+func TestValidLexiconContent(t *testing.T) {
+	t.Parallel()
+
+	validContents := []string{
+		`<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>World Wide Web Consortium</alias>
+  </lexeme>
+</lexicon>`,
+		`<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="x-sampa" xml:lang="en-GB"></lexicon>`,
+	}
+	for _, v := range validContents {
+		_, errors := validLexiconContent(v, "content")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be valid Polly Lexicon content: %q", v, errors)
+		}
+	}
+
+	invalidContents := []string{
+		"",
+		"not xml",
+		`<lexicon version="1.0"><lexeme></lexicon>`,
+		`<?xml version="1.0" encoding="UTF-8"?><speak>hello</speak>`,
+	}
+	for _, v := range invalidContents {
+		_, errors := validLexiconContent(v, "content")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be invalid Polly Lexicon content", v)
+		}
+	}
+}
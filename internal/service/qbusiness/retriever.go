@@ -0,0 +1,404 @@
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qbusiness"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRetriever() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRetrieverCreate,
+		ReadWithoutTimeout:   resourceRetrieverRead,
+		UpdateWithoutTimeout: resourceRetrieverUpdate,
+		DeleteWithoutTimeout: resourceRetrieverDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kendra_index_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.kendra_index_configuration", "configuration.0.native_index_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"native_index_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.kendra_index_configuration", "configuration.0.native_index_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"index_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"iam_service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"retriever_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(qbusiness.RetrieverType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRetrieverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("display_name").(string)
+	input := &qbusiness.CreateRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		ClientToken:   aws.String(resource.UniqueId()),
+		Configuration: expandRetrieverConfiguration(d.Get("configuration").([]interface{})),
+		DisplayName:   aws.String(name),
+		Type:          aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("iam_service_role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateRetrieverWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Q Business Retriever (%s): %s", name, err)
+	}
+
+	d.SetId(RetrieverCreateResourceID(applicationID, aws.StringValue(output.RetrieverId)))
+
+	if _, err := waitRetrieverActive(ctx, conn, applicationID, aws.StringValue(output.RetrieverId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Q Business Retriever (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRetrieverRead(ctx, d, meta)...)
+}
+
+func resourceRetrieverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	applicationID, retrieverID, err := RetrieverParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	output, err := FindRetrieverByTwoPartKey(ctx, conn, applicationID, retrieverID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Q Business Retriever (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.RetrieverArn)
+	d.Set("application_id", output.ApplicationId)
+	d.Set("arn", arn)
+	if err := d.Set("configuration", flattenRetrieverConfiguration(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set("display_name", output.DisplayName)
+	d.Set("iam_service_role_arn", output.RoleArn)
+	d.Set("retriever_id", output.RetrieverId)
+	d.Set("status", output.Status)
+	d.Set("type", output.Type)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceRetrieverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn()
+
+	applicationID, retrieverID, err := RetrieverParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &qbusiness.UpdateRetrieverInput{
+			ApplicationId: aws.String(applicationID),
+			DisplayName:   aws.String(d.Get("display_name").(string)),
+			RetrieverId:   aws.String(retrieverID),
+		}
+
+		if d.HasChange("configuration") {
+			input.Configuration = expandRetrieverConfiguration(d.Get("configuration").([]interface{}))
+		}
+
+		if d.HasChange("iam_service_role_arn") {
+			input.RoleArn = aws.String(d.Get("iam_service_role_arn").(string))
+		}
+
+		_, err := conn.UpdateRetrieverWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Q Business Retriever (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Q Business Retriever (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRetrieverRead(ctx, d, meta)...)
+}
+
+func resourceRetrieverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QBusinessConn()
+
+	applicationID, retrieverID, err := RetrieverParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Q Business Retriever: %s", d.Id())
+	_, err = conn.DeleteRetrieverWithContext(ctx, &qbusiness.DeleteRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Q Business Retriever (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const retrieverResourceIDSeparator = ","
+
+func RetrieverCreateResourceID(applicationID, retrieverID string) string {
+	parts := []string{applicationID, retrieverID}
+	id := strings.Join(parts, retrieverResourceIDSeparator)
+
+	return id
+}
+
+func RetrieverParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, retrieverResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected application-id%[2]sretriever-id", id, retrieverResourceIDSeparator)
+}
+
+func FindRetrieverByTwoPartKey(ctx context.Context, conn *qbusiness.QBusiness, applicationID, retrieverID string) (*qbusiness.GetRetrieverOutput, error) {
+	input := &qbusiness.GetRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	}
+
+	output, err := conn.GetRetrieverWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, qbusiness.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRetriever(ctx context.Context, conn *qbusiness.QBusiness, applicationID, retrieverID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRetrieverByTwoPartKey(ctx, conn, applicationID, retrieverID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitRetrieverActive(ctx context.Context, conn *qbusiness.QBusiness, applicationID, retrieverID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{qbusiness.RetrieverStatusCreating},
+		Target:  []string{qbusiness.RetrieverStatusActive},
+		Refresh: statusRetriever(ctx, conn, applicationID, retrieverID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandRetrieverConfiguration(tfList []interface{}) *qbusiness.RetrieverConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &qbusiness.RetrieverConfiguration{}
+
+	if v, ok := tfMap["kendra_index_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KendraIndexConfiguration = &qbusiness.KendraIndexConfiguration{
+			IndexId: aws.String(v[0].(map[string]interface{})["index_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["native_index_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NativeIndexConfiguration = &qbusiness.NativeIndexConfiguration{
+			IndexId: aws.String(v[0].(map[string]interface{})["index_id"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenRetrieverConfiguration(apiObject *qbusiness.RetrieverConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KendraIndexConfiguration; v != nil {
+		tfMap["kendra_index_configuration"] = []interface{}{map[string]interface{}{
+			"index_id": aws.StringValue(v.IndexId),
+		}}
+	}
+
+	if v := apiObject.NativeIndexConfiguration; v != nil {
+		tfMap["native_index_configuration"] = []interface{}{map[string]interface{}{
+			"index_id": aws.StringValue(v.IndexId),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
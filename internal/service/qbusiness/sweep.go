@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package qbusiness
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/qbusiness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_qbusiness_application", &resource.Sweeper{
+		Name: "aws_qbusiness_application",
+		F:    sweepApplications,
+	})
+}
+
+func sweepApplications(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).QBusinessConn()
+	input := &qbusiness.ListApplicationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListApplicationsPagesWithContext(ctx, input, func(page *qbusiness.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Applications {
+			r := ResourceApplication()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ApplicationId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Q Business Application sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Q Business Applications (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Q Business Applications (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -64,9 +64,15 @@ func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier strin
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+
+	return switchoverBlueGreenDeployment(ctx, o.conn, input, timeout)
+}
+
+func switchoverBlueGreenDeployment(ctx context.Context, conn *rds_sdkv2.Client, input *rds_sdkv2.SwitchoverBlueGreenDeploymentInput, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+	identifier := aws.StringValue(input.BlueGreenDeploymentIdentifier)
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
-			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
+			return conn.SwitchoverBlueGreenDeployment(ctx, input)
 		},
 		func(err error) (bool, error) {
 			return errs.IsA[*types.InvalidBlueGreenDeploymentStateFault](err), err
@@ -76,7 +82,7 @@ func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier strin
 		return nil, fmt.Errorf("switching over Blue/Green Deployment: %s", err)
 	}
 
-	dep, err := waitBlueGreenDeploymentSwitchoverCompleted(ctx, o.conn, identifier, timeout)
+	dep, err := waitBlueGreenDeploymentSwitchoverCompleted(ctx, conn, identifier, timeout)
 	if err != nil {
 		return nil, fmt.Errorf("switching over Blue/Green Deployment: waiting for completion: %s", err)
 	}
@@ -0,0 +1,268 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	blueGreenDeploymentStatusSwitchoverCompleted = "SWITCHOVER_COMPLETED"
+)
+
+func ResourceBlueGreenDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBlueGreenDeploymentCreate,
+		ReadWithoutTimeout:   resourceBlueGreenDeploymentRead,
+		UpdateWithoutTimeout: resourceBlueGreenDeploymentUpdate,
+		DeleteWithoutTimeout: resourceBlueGreenDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blue_green_deployment_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delete_target": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"switchover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"switchover_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+			"target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_db_cluster_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_db_instance_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_db_parameter_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBlueGreenDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	// TargetDBInstanceClass is only available in the AWS SDK for Go v1.
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	name := d.Get("blue_green_deployment_name").(string)
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(name),
+		Source:                  aws.String(d.Get("source").(string)),
+	}
+
+	if v, ok := d.GetOk("target_db_cluster_parameter_group_name"); ok {
+		input.TargetDBClusterParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_db_instance_class"); ok {
+		input.TargetDBInstanceClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_db_parameter_group_name"); ok {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_engine_version"); ok {
+		input.TargetEngineVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateBlueGreenDeploymentWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier))
+
+	deadline := NewDeadline(d.Timeout(schema.TimeoutCreate))
+	client := meta.(*conns.AWSClient).RDSClient()
+
+	if _, err := waitBlueGreenDeploymentAvailable(ctx, client, d.Id(), deadline.remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Blue/Green Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("switchover").(bool) {
+		if _, err := switchoverBlueGreenDeployment(ctx, client, expandSwitchoverBlueGreenDeploymentInput(d), deadline.remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient()
+
+	deployment, err := findBlueGreenDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Blue/Green Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("blue_green_deployment_name", deployment.BlueGreenDeploymentName)
+	d.Set("source", deployment.Source)
+	d.Set("status", deployment.Status)
+	d.Set("status_details", deployment.StatusDetails)
+	d.Set("target", deployment.Target)
+	if err := d.Set("tasks", flattenBlueGreenDeploymentTasks(deployment.Tasks)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tasks: %s", err)
+	}
+
+	return diags
+}
+
+func resourceBlueGreenDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient()
+
+	if d.HasChange("switchover") && d.Get("switchover").(bool) && d.Get("status").(string) != blueGreenDeploymentStatusSwitchoverCompleted {
+		if _, err := switchoverBlueGreenDeployment(ctx, conn, expandSwitchoverBlueGreenDeploymentInput(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceBlueGreenDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceBlueGreenDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient()
+
+	input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(d.Id()),
+	}
+
+	// The green environment can only be deleted before switchover.
+	if d.Get("delete_target").(bool) && d.Get("status").(string) != blueGreenDeploymentStatusSwitchoverCompleted {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", d.Id())
+	_, err := conn.DeleteBlueGreenDeployment(ctx, input)
+
+	if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS Blue/Green Deployment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Blue/Green Deployment (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandSwitchoverBlueGreenDeploymentInput(d *schema.ResourceData) *rds_sdkv2.SwitchoverBlueGreenDeploymentInput {
+	return &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(d.Id()),
+		SwitchoverTimeout:             aws.Int32(int32(d.Get("switchover_timeout").(int))),
+	}
+}
+
+func flattenBlueGreenDeploymentTasks(apiObjects []types.BlueGreenDeploymentTask) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"status": aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
@@ -25,6 +25,16 @@ const (
 	ClusterStatusUpgrading                  = "upgrading"
 )
 
+// https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_DBEngineVersion.html.
+const (
+	CustomEngineVersionStatusAvailable         = "available"
+	CustomEngineVersionStatusCreating          = "creating"
+	CustomEngineVersionStatusDeleting          = "deleting"
+	CustomEngineVersionStatusFailed            = "failed"
+	CustomEngineVersionStatusPendingValidation = "pending-validation"
+	CustomEngineVersionStatusValidating        = "validating"
+)
+
 const (
 	storageTypeStandard = "standard"
 	storageTypeGP2      = "gp2"
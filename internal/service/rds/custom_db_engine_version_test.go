@@ -0,0 +1,202 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Creating a custom engine version requires Oracle installation media in S3.
+// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/custom-cev.preparing.html.
+func testAccCustomDBEngineVersionPreCheck(t *testing.T) (string, string) {
+	bucketKey := "RDS_CUSTOM_CEV_S3_BUCKET"
+	bucket := os.Getenv(bucketKey)
+	if bucket == "" {
+		t.Skipf("Environment variable %s is not set", bucketKey)
+	}
+
+	prefixKey := "RDS_CUSTOM_CEV_S3_PREFIX"
+	prefix := os.Getenv(prefixKey)
+	if prefix == "" {
+		t.Skipf("Environment variable %s is not set", prefixKey)
+	}
+
+	return bucket, prefix
+}
+
+func TestAccRDSCustomDBEngineVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	bucket, prefix := testAccCustomDBEngineVersionPreCheck(t)
+	var v rds.DBEngineVersion
+	rName := fmt.Sprintf("19.%s", sdkacctest.RandString(10))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_basic(rName, bucket, prefix, "available"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(fmt.Sprintf(`cev:custom-oracle-ee/%s/.+`, rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttr(resourceName, "database_installation_files_s3_bucket_name", bucket),
+					resource.TestCheckResourceAttr(resourceName, "database_installation_files_s3_prefix", prefix),
+					resource.TestCheckResourceAttr(resourceName, "engine", "custom-oracle-ee"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "image_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_computed"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+			{
+				Config: testAccCustomDBEngineVersionConfig_basic(rName, bucket, prefix, "inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCustomDBEngineVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	bucket, prefix := testAccCustomDBEngineVersionPreCheck(t)
+	var v rds.DBEngineVersion
+	rName := fmt.Sprintf("19.%s", sdkacctest.RandString(10))
+	resourceName := "aws_rds_custom_db_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDBEngineVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDBEngineVersionConfig_basic(rName, bucket, prefix, "available"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDBEngineVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrds.ResourceCustomDBEngineVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomDBEngineVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_custom_db_engine_version" {
+				continue
+			}
+
+			engine, engineVersion, err := tfrds.CustomDBEngineVersionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfrds.FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS Custom DB Engine Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomDBEngineVersionExists(ctx context.Context, n string, v *rds.DBEngineVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Custom DB Engine Version ID is set")
+		}
+
+		engine, engineVersion, err := tfrds.CustomDBEngineVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
+
+		output, err := tfrds.FindCustomDBEngineVersionByTwoPartKey(ctx, conn, engine, engineVersion)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomDBEngineVersionConfig_basic(rName, bucket, prefix, status string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_rds_custom_db_engine_version" "test" {
+  engine                                     = "custom-oracle-ee"
+  engine_version                             = %[1]q
+  database_installation_files_s3_bucket_name = %[2]q
+  database_installation_files_s3_prefix      = %[3]q
+  kms_key_id                                 = aws_kms_key.test.arn
+  status                                     = %[4]q
+
+  manifest = jsonencode({
+    mediaImportTemplateVersion    = "2020-08-14"
+    databaseInstallationFileNames = ["V982063-01.zip"]
+  })
+}
+`, rName, bucket, prefix, status)
+}
@@ -0,0 +1,171 @@
+package redshift
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataShareAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataShareAuthorizationCreate,
+		ReadWithoutTimeout:   resourceDataShareAuthorizationRead,
+		DeleteWithoutTimeout: resourceDataShareAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_writes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"consumer_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	consumerIdentifier := d.Get("consumer_identifier").(string)
+	id := DataShareAuthorizationCreateResourceID(dataShareARN, consumerIdentifier)
+	input := &redshift.AuthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerIdentifier),
+		DataShareArn:       aws.String(dataShareARN),
+	}
+
+	if v, ok := d.GetOk("allow_writes"); ok {
+		input.AllowWrites = aws.Bool(v.(bool))
+	}
+
+	_, err := conn.AuthorizeDataShareWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Authorization (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDataShareAuthorizationRead(ctx, d, meta)...)
+}
+
+func resourceDataShareAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, consumerIdentifier, err := DataShareAuthorizationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	dataShare, err := FindDataShareByARN(ctx, conn, dataShareARN)
+
+	var association *redshift.DataShareAssociation
+	if err == nil {
+		association, err = FindDataShareAuthorizationByTwoPartKey(ctx, conn, dataShareARN, consumerIdentifier)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allow_writes", association.ProducerAllowedWrites)
+	d.Set("consumer_identifier", association.ConsumerIdentifier)
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("managed_by", dataShare.ManagedBy)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return diags
+}
+
+func resourceDataShareAuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, consumerIdentifier, err := DataShareAuthorizationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Authorization: %s", d.Id())
+	_, err = conn.DeauthorizeDataShareWithContext(ctx, &redshift.DeauthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerIdentifier),
+		DataShareArn:       aws.String(dataShareARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Authorization (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// The data share ARN contains colons, so a comma is used to separate the ID parts.
+const dataShareAuthorizationResourceIDSeparator = ","
+
+func DataShareAuthorizationCreateResourceID(dataShareARN, consumerIdentifier string) string {
+	parts := []string{dataShareARN, consumerIdentifier}
+	id := strings.Join(parts, dataShareAuthorizationResourceIDSeparator)
+
+	return id
+}
+
+func DataShareAuthorizationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, dataShareAuthorizationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DATA-SHARE-ARN%[2]sCONSUMER-IDENTIFIER", id, dataShareAuthorizationResourceIDSeparator)
+}
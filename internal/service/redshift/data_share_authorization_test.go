@@ -0,0 +1,166 @@
+package redshift_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Data shares can only be created with SQL, so these tests require an existing
+// data share in the account under test.
+func testAccPreCheckDataShareARN(t *testing.T) string {
+	key := "REDSHIFT_DATA_SHARE_ARN"
+	dataShareARN := os.Getenv(key)
+	if dataShareARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	return dataShareARN
+}
+
+func TestAccRedshiftDataShareAuthorization_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataShareARN := testAccPreCheckDataShareARN(t)
+	var v redshift.DataShareAssociation
+	resourceName := "aws_redshift_data_share_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckDataShareAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_writes", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "consumer_identifier", "data.aws_caller_identity.test", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "data_share_arn", dataShareARN),
+					resource.TestCheckResourceAttrSet(resourceName, "producer_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", redshift.DataShareStatusAuthorized),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareAuthorization_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataShareARN := testAccPreCheckDataShareARN(t)
+	var v redshift.DataShareAssociation
+	resourceName := "aws_redshift_data_share_authorization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, redshift.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckDataShareAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareAuthorizationConfig_basic(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareAuthorizationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfredshift.ResourceDataShareAuthorization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareAuthorizationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_redshift_data_share_authorization" {
+				continue
+			}
+
+			dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfredshift.FindDataShareAuthorizationByTwoPartKey(ctx, conn, dataShareARN, consumerIdentifier)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Redshift Data Share Authorization %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataShareAuthorizationExists(ctx context.Context, n string, v *redshift.DataShareAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Authorization ID is set")
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAuthorizationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+		output, err := tfredshift.FindDataShareAuthorizationByTwoPartKey(ctx, conn, dataShareARN, consumerIdentifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataShareAuthorizationConfig_basic(dataShareARN string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+
+resource "aws_redshift_data_share_authorization" "test" {
+  consumer_identifier = data.aws_caller_identity.test.account_id
+  data_share_arn      = %[1]q
+}
+`, dataShareARN))
+}
@@ -0,0 +1,216 @@
+package redshift
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataShareConsumerAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataShareConsumerAssociationCreate,
+		ReadWithoutTimeout:   resourceDataShareConsumerAssociationRead,
+		DeleteWithoutTimeout: resourceDataShareConsumerAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_writes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"associate_entire_account": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"consumer_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn", "consumer_region"},
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareConsumerAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	associateEntireAccount := d.Get("associate_entire_account").(bool)
+	consumerARN := d.Get("consumer_arn").(string)
+	consumerRegion := d.Get("consumer_region").(string)
+	id := DataShareConsumerAssociationCreateResourceID(dataShareARN, associateEntireAccount, consumerARN, consumerRegion)
+	input := &redshift.AssociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if v, ok := d.GetOk("allow_writes"); ok {
+		input.AllowWrites = aws.Bool(v.(bool))
+	}
+
+	if associateEntireAccount {
+		input.AssociateEntireAccount = aws.Bool(associateEntireAccount)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	if consumerRegion != "" {
+		input.ConsumerRegion = aws.String(consumerRegion)
+	}
+
+	_, err := conn.AssociateDataShareConsumerWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Redshift Data Share Consumer Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDataShareConsumerAssociationRead(ctx, d, meta)...)
+}
+
+func resourceDataShareConsumerAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, associateEntireAccount, consumerARN, consumerRegion, err := DataShareConsumerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	dataShare, err := FindDataShareByARN(ctx, conn, dataShareARN)
+
+	var association *redshift.DataShareAssociation
+	if err == nil {
+		association, err = FindDataShareConsumerAssociationByFourPartKey(ctx, conn, dataShareARN, associateEntireAccount, consumerARN, consumerRegion, meta.(*conns.AWSClient).AccountID)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Consumer Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("allow_writes", association.ConsumerAcceptedWrites)
+	d.Set("associate_entire_account", associateEntireAccount)
+	d.Set("consumer_arn", consumerARN)
+	d.Set("consumer_region", consumerRegion)
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("managed_by", dataShare.ManagedBy)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return diags
+}
+
+func resourceDataShareConsumerAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, associateEntireAccount, consumerARN, consumerRegion, err := DataShareConsumerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &redshift.DisassociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if associateEntireAccount {
+		input.DisassociateEntireAccount = aws.Bool(associateEntireAccount)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	if consumerRegion != "" {
+		input.ConsumerRegion = aws.String(consumerRegion)
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Consumer Association: %s", d.Id())
+	_, err = conn.DisassociateDataShareConsumerWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Redshift Data Share Consumer Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// Unlike the other ID parts, consumer_arn and consumer_region may be empty.
+const dataShareConsumerAssociationResourceIDSeparator = ","
+
+func DataShareConsumerAssociationCreateResourceID(dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion string) string {
+	parts := []string{dataShareARN, strconv.FormatBool(associateEntireAccount), consumerARN, consumerRegion}
+	id := strings.Join(parts, dataShareConsumerAssociationResourceIDSeparator)
+
+	return id
+}
+
+func DataShareConsumerAssociationParseResourceID(id string) (string, bool, string, string, error) {
+	parts := strings.Split(id, dataShareConsumerAssociationResourceIDSeparator)
+
+	if len(parts) == 4 && parts[0] != "" {
+		if associateEntireAccount, err := strconv.ParseBool(parts[1]); err == nil {
+			return parts[0], associateEntireAccount, parts[2], parts[3], nil
+		}
+	}
+
+	return "", false, "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DATA-SHARE-ARN%[2]sASSOCIATE-ENTIRE-ACCOUNT%[2]sCONSUMER-ARN%[2]sCONSUMER-REGION", id, dataShareConsumerAssociationResourceIDSeparator)
+}
@@ -429,3 +429,108 @@ func FindPartnerById(ctx context.Context, conn *redshift.Redshift, id string) (*
 
 	return output.PartnerIntegrationInfoList[0], nil
 }
+
+func FindDataShareByARN(ctx context.Context, conn *redshift.Redshift, arn string) (*redshift.DataShare, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(arn),
+	}
+	var output []*redshift.DataShare
+
+	err := conn.DescribeDataSharesPagesWithContext(ctx, input, func(page *redshift.DescribeDataSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataShares {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindDataShareAuthorizationByTwoPartKey(ctx context.Context, conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShareAssociation, error) {
+	dataShare, err := FindDataShareByARN(ctx, conn, dataShareARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if aws.StringValue(v.ConsumerIdentifier) != consumerIdentifier {
+			continue
+		}
+
+		if status := aws.StringValue(v.Status); status == redshift.DataShareStatusDeauthorized || status == redshift.DataShareStatusRejected {
+			return nil, &resource.NotFoundError{
+				Message: status,
+			}
+		}
+
+		return v, nil
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+func FindDataShareConsumerAssociationByFourPartKey(ctx context.Context, conn *redshift.Redshift, dataShareARN string, associateEntireAccount bool, consumerARN, consumerRegion, accountID string) (*redshift.DataShareAssociation, error) {
+	dataShare, err := FindDataShareByARN(ctx, conn, dataShareARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		consumerIdentifier := aws.StringValue(v.ConsumerIdentifier)
+
+		switch {
+		case associateEntireAccount:
+			if consumerIdentifier != accountID {
+				continue
+			}
+		case consumerARN != "":
+			if consumerIdentifier != consumerARN {
+				continue
+			}
+		case consumerRegion != "":
+			if consumerIdentifier != accountID || aws.StringValue(v.ConsumerRegion) != consumerRegion {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if status := aws.StringValue(v.Status); status != redshift.DataShareStatusActive {
+			return nil, &resource.NotFoundError{
+				Message: status,
+			}
+		}
+
+		return v, nil
+	}
+
+	return nil, &resource.NotFoundError{}
+}
@@ -0,0 +1,691 @@
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProjectVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectVersionCreate,
+		ReadWithoutTimeout:   resourceProjectVersionRead,
+		UpdateWithoutTimeout: resourceProjectVersionUpdate,
+		DeleteWithoutTimeout: resourceProjectVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billable_training_time_in_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"evaluation_result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"f1_score": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"summary": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"s3_object": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     s3ObjectSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"output_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 255),
+						},
+						"s3_key_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1024),
+						},
+					},
+				},
+			},
+			"project_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"testing_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assets": assetsSchema(),
+						"auto_create": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"training_data": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"assets": assetsSchema(),
+					},
+				},
+			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"version_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 255),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func assetsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ground_truth_manifest": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"s3_object": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"bucket": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringLenBetween(3, 255),
+										},
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+										"version": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ForceNew:     true,
+											ValidateFunc: validation.StringLenBetween(1, 1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func s3ObjectSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProjectVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	projectARN := d.Get("project_arn").(string)
+	versionName := d.Get("version_name").(string)
+	id := ProjectVersionCreateResourceID(projectARN, versionName)
+	input := &rekognition.CreateProjectVersionInput{
+		OutputConfig: expandOutputConfig(d.Get("output_config").([]interface{})),
+		ProjectArn:   aws.String(projectARN),
+		VersionName:  aws.String(versionName),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("testing_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TestingData = expandTestingData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("training_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.TrainingData = expandTrainingData(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("version_description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateProjectVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Rekognition Project Version (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitProjectVersionTrainingCompleted(ctx, conn, projectARN, versionName, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project Version (%s) training: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceProjectVersionRead(ctx, d, meta)...)
+}
+
+func resourceProjectVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	projectARN, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	version, err := FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Project Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(version.ProjectVersionArn)
+	d.Set("arn", arn)
+	d.Set("billable_training_time_in_seconds", version.BillableTrainingTimeInSeconds)
+	if err := d.Set("evaluation_result", flattenEvaluationResult(version.EvaluationResult)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting evaluation_result: %s", err)
+	}
+	d.Set("kms_key_id", version.KmsKeyId)
+	if err := d.Set("output_config", flattenOutputConfig(version.OutputConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_config: %s", err)
+	}
+	d.Set("project_arn", projectARN)
+	d.Set("status", version.Status)
+	d.Set("status_message", version.StatusMessage)
+	if v := version.TestingDataResult; v != nil {
+		if err := d.Set("testing_data", flattenTestingData(v.Input)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting testing_data: %s", err)
+		}
+	} else {
+		d.Set("testing_data", nil)
+	}
+	if v := version.TrainingDataResult; v != nil {
+		if err := d.Set("training_data", flattenTrainingData(v.Input)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting training_data: %s", err)
+		}
+	} else {
+		d.Set("training_data", nil)
+	}
+	d.Set("version_description", version.VersionDescription)
+	d.Set("version_name", versionName)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceProjectVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Rekognition Project Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProjectVersionRead(ctx, d, meta)...)
+}
+
+func resourceProjectVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	projectARN, versionName, err := ProjectVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Rekognition Project Version: %s", d.Id())
+	_, err = conn.DeleteProjectVersionWithContext(ctx, &rekognition.DeleteProjectVersionInput{
+		ProjectVersionArn: aws.String(d.Get("arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Rekognition Project Version (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectVersionDeleted(ctx, conn, projectARN, versionName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Project Version (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const projectVersionResourceIDSeparator = ","
+
+func ProjectVersionCreateResourceID(projectARN, versionName string) string {
+	parts := []string{projectARN, versionName}
+	id := strings.Join(parts, projectVersionResourceIDSeparator)
+
+	return id
+}
+
+func ProjectVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, projectVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected project-arn%[2]sversion-name", id, projectVersionResourceIDSeparator)
+}
+
+func FindProjectVersionByTwoPartKey(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) (*rekognition.ProjectVersionDescription, error) {
+	input := &rekognition.DescribeProjectVersionsInput{
+		ProjectArn:   aws.String(projectARN),
+		VersionNames: aws.StringSlice([]string{versionName}),
+	}
+
+	output, err := conn.DescribeProjectVersionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ProjectVersionDescriptions) == 0 || output.ProjectVersionDescriptions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ProjectVersionDescriptions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ProjectVersionDescriptions[0], nil
+}
+
+func statusProjectVersion(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitProjectVersionTrainingCompleted(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{rekognition.ProjectVersionStatusTrainingInProgress},
+		Target:     []string{rekognition.ProjectVersionStatusTrainingCompleted},
+		Refresh:    statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectVersionDeleted(ctx context.Context, conn *rekognition.Rekognition, projectARN, versionName string, timeout time.Duration) (*rekognition.ProjectVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rekognition.ProjectVersionStatusDeleting},
+		Target:  []string{},
+		Refresh: statusProjectVersion(ctx, conn, projectARN, versionName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.ProjectVersionDescription); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOutputConfig(tfList []interface{}) *rekognition.OutputConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.OutputConfig{}
+
+	if v, ok := tfMap["s3_bucket"].(string); ok && v != "" {
+		apiObject.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTestingData(tfMap map[string]interface{}) *rekognition.TestingData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.TestingData{}
+
+	if v, ok := tfMap["assets"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Assets = expandAssets(v)
+	}
+
+	if v, ok := tfMap["auto_create"].(bool); ok && v {
+		apiObject.AutoCreate = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandTrainingData(tfMap map[string]interface{}) *rekognition.TrainingData {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.TrainingData{}
+
+	if v, ok := tfMap["assets"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Assets = expandAssets(v)
+	}
+
+	return apiObject
+}
+
+func expandAssets(tfList []interface{}) []*rekognition.Asset {
+	var apiObjects []*rekognition.Asset
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &rekognition.Asset{}
+
+		if v, ok := tfMap["ground_truth_manifest"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.GroundTruthManifest = &rekognition.GroundTruthManifest{}
+
+			if v, ok := v[0].(map[string]interface{})["s3_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.GroundTruthManifest.S3Object = expandS3Object(v[0].(map[string]interface{}))
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandS3Object(tfMap map[string]interface{}) *rekognition.S3Object {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rekognition.S3Object{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputConfig(apiObject *rekognition.OutputConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"s3_bucket":     aws.StringValue(apiObject.S3Bucket),
+		"s3_key_prefix": aws.StringValue(apiObject.S3KeyPrefix),
+	}}
+}
+
+func flattenEvaluationResult(apiObject *rekognition.EvaluationResult) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"f1_score": aws.Float64Value(apiObject.F1Score),
+	}
+
+	if v := apiObject.Summary; v != nil {
+		tfMap["summary"] = []interface{}{map[string]interface{}{
+			"s3_object": flattenS3Object(v.S3Object),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTestingData(apiObject *rekognition.TestingData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"assets":      flattenAssets(apiObject.Assets),
+		"auto_create": aws.BoolValue(apiObject.AutoCreate),
+	}}
+}
+
+func flattenTrainingData(apiObject *rekognition.TrainingData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"assets": flattenAssets(apiObject.Assets),
+	}}
+}
+
+func flattenAssets(apiObjects []*rekognition.Asset) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.GroundTruthManifest == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"ground_truth_manifest": []interface{}{map[string]interface{}{
+				"s3_object": flattenS3Object(apiObject.GroundTruthManifest.S3Object),
+			}},
+		})
+	}
+
+	return tfList
+}
+
+func flattenS3Object(apiObject *rekognition.S3Object) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"bucket":  aws.StringValue(apiObject.Bucket),
+		"name":    aws.StringValue(apiObject.Name),
+		"version": aws.StringValue(apiObject.Version),
+	}}
+}
@@ -0,0 +1,197 @@
+package rekognition_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrekognition "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRekognitionProjectVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v rekognition.ProjectVersionDescription
+	resourceName := "aws_rekognition_project_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucket, key := testAccPreCheckTrainingManifest(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "rekognition", regexp.MustCompile(`project/.+/version/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_result.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_result.0.summary.0.s3_object.0.bucket", bucket),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_bucket", bucket),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_key_prefix", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "project_arn", "aws_rekognition_project.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "TRAINING_COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "testing_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "testing_data.0.auto_create", "true"),
+					resource.TestCheckResourceAttr(resourceName, "training_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "training_data.0.assets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRekognitionProjectVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v rekognition.ProjectVersionDescription
+	resourceName := "aws_rekognition_project_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucket, key := testAccPreCheckTrainingManifest(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rekognition.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(rName, bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrekognition.ResourceProjectVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccPreCheckTrainingManifest returns the S3 location of a SageMaker Ground Truth
+// manifest file suitable for training a Rekognition Custom Labels model.
+func testAccPreCheckTrainingManifest(t *testing.T) (string, string) {
+	bucket := os.Getenv("REKOGNITION_TRAINING_MANIFEST_BUCKET")
+	if bucket == "" {
+		t.Skip("Environment variable REKOGNITION_TRAINING_MANIFEST_BUCKET is not set")
+	}
+
+	key := os.Getenv("REKOGNITION_TRAINING_MANIFEST_KEY")
+	if key == "" {
+		t.Skip("Environment variable REKOGNITION_TRAINING_MANIFEST_KEY is not set")
+	}
+
+	return bucket, key
+}
+
+func testAccCheckProjectVersionExists(ctx context.Context, n string, v *rekognition.ProjectVersionDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Rekognition Project Version ID is set")
+		}
+
+		projectARN, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		output, err := tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckProjectVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RekognitionConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rekognition_project_version" {
+				continue
+			}
+
+			projectARN, versionName, err := tfrekognition.ProjectVersionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfrekognition.FindProjectVersionByTwoPartKey(ctx, conn, projectARN, versionName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Rekognition Project Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectVersionConfig_basic(rName, bucket, key string) string {
+	return fmt.Sprintf(`
+resource "aws_rekognition_project" "test" {
+  name = %[1]q
+}
+
+resource "aws_rekognition_project_version" "test" {
+  project_arn  = aws_rekognition_project.test.arn
+  version_name = %[1]q
+
+  output_config {
+    s3_bucket     = %[2]q
+    s3_key_prefix = %[1]q
+  }
+
+  testing_data {
+    auto_create = true
+  }
+
+  training_data {
+    assets {
+      ground_truth_manifest {
+        s3_object {
+          bucket = %[2]q
+          name   = %[3]q
+        }
+      }
+    }
+  }
+}
+`, rName, bucket, key)
+}
@@ -0,0 +1,808 @@
+package rekognition
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStreamProcessor() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamProcessorCreate,
+		ReadWithoutTimeout:   resourceStreamProcessorRead,
+		UpdateWithoutTimeout: resourceStreamProcessorUpdate,
+		DeleteWithoutTimeout: resourceStreamProcessorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_sharing_preference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opt_in": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_video_stream": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+				),
+			},
+			"notification_channel": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sns_topic_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kinesis_data_stream": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+							ExactlyOneOf: []string{"output.0.kinesis_data_stream", "output.0.s3_destination"},
+						},
+						"s3_destination": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(0, 1024),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"output.0.kinesis_data_stream", "output.0.s3_destination"},
+						},
+					},
+				},
+			},
+			"regions_of_interest": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bounding_box": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"height": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"left": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"top": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"width": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+								},
+							},
+						},
+						"polygon": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MinItems: 3,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+									"y": {
+										Type:         schema.TypeFloat,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connected_home": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"labels": {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"PERSON", "PET", "PACKAGE", "ALL"}, false),
+										},
+									},
+									"min_confidence": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"settings.0.connected_home", "settings.0.face_search"},
+						},
+						"face_search": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 255),
+											validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`), "must contain only alphanumeric characters, underscores, periods and hyphens"),
+										),
+									},
+									"face_match_threshold": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.FloatBetween(0, 100),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"settings.0.connected_home", "settings.0.face_search"},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stream_processor_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStreamProcessorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &rekognition.CreateStreamProcessorInput{
+		Input:    expandStreamProcessorInput(d.Get("input").([]interface{})),
+		Name:     aws.String(name),
+		Output:   expandStreamProcessorOutput(d.Get("output").([]interface{})),
+		RoleArn:  aws.String(d.Get("role_arn").(string)),
+		Settings: expandStreamProcessorSettings(d.Get("settings").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("data_sharing_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataSharingPreference = expandStreamProcessorDataSharingPreference(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_channel"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NotificationChannel = expandStreamProcessorNotificationChannel(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("regions_of_interest"); ok && len(v.([]interface{})) > 0 {
+		input.RegionsOfInterest = expandRegionsOfInterest(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateStreamProcessorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Rekognition Stream Processor (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceStreamProcessorRead(ctx, d, meta)...)
+}
+
+func resourceStreamProcessorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindStreamProcessorByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Rekognition Stream Processor (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.StreamProcessorArn)
+	d.Set("arn", arn)
+	if err := d.Set("data_sharing_preference", flattenStreamProcessorDataSharingPreference(output.DataSharingPreference)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting data_sharing_preference: %s", err)
+	}
+	if err := d.Set("input", flattenStreamProcessorInput(output.Input)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting input: %s", err)
+	}
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("name", output.Name)
+	if err := d.Set("notification_channel", flattenStreamProcessorNotificationChannel(output.NotificationChannel)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting notification_channel: %s", err)
+	}
+	if err := d.Set("output", flattenStreamProcessorOutput(output.Output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output: %s", err)
+	}
+	if err := d.Set("regions_of_interest", flattenRegionsOfInterest(output.RegionsOfInterest)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting regions_of_interest: %s", err)
+	}
+	d.Set("role_arn", output.RoleArn)
+	if err := d.Set("settings", flattenStreamProcessorSettings(output.Settings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting settings: %s", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("stream_processor_arn", arn)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceStreamProcessorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Rekognition Stream Processor (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStreamProcessorRead(ctx, d, meta)...)
+}
+
+func resourceStreamProcessorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RekognitionConn()
+
+	log.Printf("[DEBUG] Deleting Rekognition Stream Processor: %s", d.Id())
+	_, err := conn.DeleteStreamProcessorWithContext(ctx, &rekognition.DeleteStreamProcessorInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Rekognition Stream Processor (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitStreamProcessorDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Rekognition Stream Processor (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindStreamProcessorByName(ctx context.Context, conn *rekognition.Rekognition, name string) (*rekognition.DescribeStreamProcessorOutput, error) {
+	input := &rekognition.DescribeStreamProcessorInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeStreamProcessorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, rekognition.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStreamProcessor(ctx context.Context, conn *rekognition.Rekognition, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindStreamProcessorByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitStreamProcessorDeleted(ctx context.Context, conn *rekognition.Rekognition, name string, timeout time.Duration) (*rekognition.DescribeStreamProcessorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: rekognition.StreamProcessorStatus_Values(),
+		Target:  []string{},
+		Refresh: statusStreamProcessor(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rekognition.DescribeStreamProcessorOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandStreamProcessorDataSharingPreference(tfList []interface{}) *rekognition.StreamProcessorDataSharingPreference {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &rekognition.StreamProcessorDataSharingPreference{
+		OptIn: aws.Bool(tfMap["opt_in"].(bool)),
+	}
+}
+
+func expandStreamProcessorInput(tfList []interface{}) *rekognition.StreamProcessorInput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.StreamProcessorInput{}
+
+	if v, ok := tfMap["kinesis_video_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisVideoStream = &rekognition.KinesisVideoStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandStreamProcessorNotificationChannel(tfList []interface{}) *rekognition.StreamProcessorNotificationChannel {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &rekognition.StreamProcessorNotificationChannel{
+		SNSTopicArn: aws.String(tfMap["sns_topic_arn"].(string)),
+	}
+}
+
+func expandStreamProcessorOutput(tfList []interface{}) *rekognition.StreamProcessorOutput {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.StreamProcessorOutput{}
+
+	if v, ok := tfMap["kinesis_data_stream"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisDataStream = &rekognition.KinesisDataStream{
+			Arn: aws.String(v[0].(map[string]interface{})["arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3Destination := &rekognition.S3Destination{
+			Bucket: aws.String(tfMap["bucket"].(string)),
+		}
+
+		if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+			s3Destination.KeyPrefix = aws.String(v)
+		}
+
+		apiObject.S3Destination = s3Destination
+	}
+
+	return apiObject
+}
+
+func expandRegionsOfInterest(tfList []interface{}) []*rekognition.RegionOfInterest {
+	var apiObjects []*rekognition.RegionOfInterest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &rekognition.RegionOfInterest{}
+
+		if v, ok := tfMap["bounding_box"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.BoundingBox = &rekognition.BoundingBox{
+				Height: aws.Float64(tfMap["height"].(float64)),
+				Left:   aws.Float64(tfMap["left"].(float64)),
+				Top:    aws.Float64(tfMap["top"].(float64)),
+				Width:  aws.Float64(tfMap["width"].(float64)),
+			}
+		}
+
+		if v, ok := tfMap["polygon"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.Polygon = append(apiObject.Polygon, &rekognition.Point{
+					X: aws.Float64(tfMap["x"].(float64)),
+					Y: aws.Float64(tfMap["y"].(float64)),
+				})
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandStreamProcessorSettings(tfList []interface{}) *rekognition.StreamProcessorSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &rekognition.StreamProcessorSettings{}
+
+	if v, ok := tfMap["connected_home"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		connectedHome := &rekognition.ConnectedHomeSettings{
+			Labels: flex.ExpandStringSet(tfMap["labels"].(*schema.Set)),
+		}
+
+		if v, ok := tfMap["min_confidence"].(float64); ok && v != 0 {
+			connectedHome.MinConfidence = aws.Float64(v)
+		}
+
+		apiObject.ConnectedHome = connectedHome
+	}
+
+	if v, ok := tfMap["face_search"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		faceSearch := &rekognition.FaceSearchSettings{
+			CollectionId: aws.String(tfMap["collection_id"].(string)),
+		}
+
+		if v, ok := tfMap["face_match_threshold"].(float64); ok && v != 0 {
+			faceSearch.FaceMatchThreshold = aws.Float64(v)
+		}
+
+		apiObject.FaceSearch = faceSearch
+	}
+
+	return apiObject
+}
+
+func flattenStreamProcessorDataSharingPreference(apiObject *rekognition.StreamProcessorDataSharingPreference) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"opt_in": aws.BoolValue(apiObject.OptIn),
+	}}
+}
+
+func flattenStreamProcessorInput(apiObject *rekognition.StreamProcessorInput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KinesisVideoStream; v != nil {
+		tfMap["kinesis_video_stream"] = []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(v.Arn),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenStreamProcessorNotificationChannel(apiObject *rekognition.StreamProcessorNotificationChannel) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"sns_topic_arn": aws.StringValue(apiObject.SNSTopicArn),
+	}}
+}
+
+func flattenStreamProcessorOutput(apiObject *rekognition.StreamProcessorOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KinesisDataStream; v != nil {
+		tfMap["kinesis_data_stream"] = []interface{}{map[string]interface{}{
+			"arn": aws.StringValue(v.Arn),
+		}}
+	}
+
+	if v := apiObject.S3Destination; v != nil {
+		tfMap["s3_destination"] = []interface{}{map[string]interface{}{
+			"bucket":     aws.StringValue(v.Bucket),
+			"key_prefix": aws.StringValue(v.KeyPrefix),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRegionsOfInterest(apiObjects []*rekognition.RegionOfInterest) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.BoundingBox; v != nil {
+			tfMap["bounding_box"] = []interface{}{map[string]interface{}{
+				"height": aws.Float64Value(v.Height),
+				"left":   aws.Float64Value(v.Left),
+				"top":    aws.Float64Value(v.Top),
+				"width":  aws.Float64Value(v.Width),
+			}}
+		}
+
+		if len(apiObject.Polygon) > 0 {
+			var polygon []interface{}
+
+			for _, v := range apiObject.Polygon {
+				if v == nil {
+					continue
+				}
+
+				polygon = append(polygon, map[string]interface{}{
+					"x": aws.Float64Value(v.X),
+					"y": aws.Float64Value(v.Y),
+				})
+			}
+
+			tfMap["polygon"] = polygon
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenStreamProcessorSettings(apiObject *rekognition.StreamProcessorSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ConnectedHome; v != nil {
+		tfMap["connected_home"] = []interface{}{map[string]interface{}{
+			"labels":         aws.StringValueSlice(v.Labels),
+			"min_confidence": aws.Float64Value(v.MinConfidence),
+		}}
+	}
+
+	if v := apiObject.FaceSearch; v != nil {
+		tfMap["face_search"] = []interface{}{map[string]interface{}{
+			"collection_id":        aws.StringValue(v.CollectionId),
+			"face_match_threshold": aws.Float64Value(v.FaceMatchThreshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
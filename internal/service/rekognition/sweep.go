@@ -0,0 +1,222 @@
+//go:build sweep
+// +build sweep
+
+package rekognition
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rekognition"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_rekognition_project", &resource.Sweeper{
+		Name: "aws_rekognition_project",
+		F:    sweepProjects,
+		Dependencies: []string{
+			"aws_rekognition_project_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_rekognition_project_version", &resource.Sweeper{
+		Name: "aws_rekognition_project_version",
+		F:    sweepProjectVersions,
+	})
+
+	resource.AddTestSweepers("aws_rekognition_stream_processor", &resource.Sweeper{
+		Name: "aws_rekognition_stream_processor",
+		F:    sweepStreamProcessors,
+	})
+}
+
+func sweepStreamProcessors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RekognitionConn()
+	input := &rekognition.ListStreamProcessorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListStreamProcessorsPagesWithContext(ctx, input, func(page *rekognition.ListStreamProcessorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StreamProcessors {
+			r := ResourceStreamProcessor()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Rekognition Stream Processor sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Rekognition Stream Processors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Rekognition Stream Processors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProjects(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RekognitionConn()
+	input := &rekognition.DescribeProjectsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeProjectsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectDescriptions {
+			projectARN := aws.StringValue(v.ProjectArn)
+			parsedARN, err := arn.Parse(projectARN)
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			// Project ARNs have the form project/<name>/<timestamp>.
+			parts := strings.Split(parsedARN.Resource, "/")
+
+			if len(parts) != 3 {
+				continue
+			}
+
+			r := ResourceProject()
+			d := r.Data(nil)
+			d.SetId(parts[1])
+			d.Set("arn", projectARN)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Rekognition Project sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Rekognition Projects (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Rekognition Projects (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProjectVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).RekognitionConn()
+	input := &rekognition.DescribeProjectsInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.DescribeProjectsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProjectDescriptions {
+			projectARN := aws.StringValue(v.ProjectArn)
+			input := &rekognition.DescribeProjectVersionsInput{
+				ProjectArn: aws.String(projectARN),
+			}
+
+			err := conn.DescribeProjectVersionsPagesWithContext(ctx, input, func(page *rekognition.DescribeProjectVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ProjectVersionDescriptions {
+					versionARN := aws.StringValue(v.ProjectVersionArn)
+					parsedARN, err := arn.Parse(versionARN)
+
+					if err != nil {
+						log.Printf("[WARN] %s", err)
+						continue
+					}
+
+					// Project Version ARNs have the form project/<name>/version/<version-name>/<timestamp>.
+					parts := strings.Split(parsedARN.Resource, "/")
+
+					if len(parts) != 5 {
+						continue
+					}
+
+					r := ResourceProjectVersion()
+					d := r.Data(nil)
+					d.SetId(ProjectVersionCreateResourceID(projectARN, parts[3]))
+					d.Set("arn", versionARN)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Rekognition Project (%s) Versions (%s): %w", projectARN, region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Rekognition Project Version sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Rekognition Projects (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Rekognition Project Versions (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
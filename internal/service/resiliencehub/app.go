@@ -0,0 +1,515 @@
+package resiliencehub
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// draftAppVersion is the app version that template and resource mapping changes are made against.
+	draftAppVersion = "draft"
+)
+
+func ResourceApp() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAppCreate,
+		ReadWithoutTimeout:   resourceAppRead,
+		UpdateWithoutTimeout: resourceAppUpdate,
+		DeleteWithoutTimeout: resourceAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_assessment_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.AppAssessmentScheduleType_Values(), false),
+			},
+			"app_template_body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must be 2 to 60 alphanumeric characters, hyphens or underscores, starting with an alphanumeric character"),
+			},
+			"resiliency_policy_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_registry_app_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"eks_source_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"logical_stack_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mapping_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(resiliencehub.ResourceMappingType_Values(), false),
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_account_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"aws_region": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+									"identifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(resiliencehub.PhysicalIdentifierType_Values(), false),
+									},
+								},
+							},
+						},
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"terraform_source_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameApp = "App"
+)
+
+func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	name := d.Get("name").(string)
+	in := &resiliencehub.CreateAppInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("app_assessment_schedule"); ok {
+		in.AssessmentSchedule = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+		in.PolicyArn = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateAppWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameApp, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.App.AppArn))
+
+	if v, ok := d.GetOk("app_template_body"); ok {
+		if err := putDraftAppVersionTemplate(ctx, conn, d.Id(), v.(string)); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameApp, d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("resource_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		if err := addDraftAppVersionResourceMappings(ctx, conn, d.Id(), v.(*schema.Set).List()); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameApp, d.Id(), err)
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	out, err := FindAppByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameApp, d.Id(), err)
+	}
+
+	d.Set("app_assessment_schedule", out.AssessmentSchedule)
+	d.Set("arn", out.AppArn)
+	d.Set("description", out.Description)
+	d.Set("name", out.Name)
+	d.Set("resiliency_policy_arn", out.PolicyArn)
+	d.Set("status", out.Status)
+
+	templateBody, err := FindAppVersionTemplateByTwoPartKey(ctx, conn, d.Id(), draftAppVersion)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameApp, d.Id(), err)
+	}
+
+	templateBody, err = structure.NormalizeJsonString(templateBody)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameApp, d.Id(), err)
+	}
+
+	d.Set("app_template_body", templateBody)
+
+	mappings, err := FindAppVersionResourceMappingsByTwoPartKey(ctx, conn, d.Id(), draftAppVersion)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameApp, d.Id(), err)
+	}
+
+	if err := d.Set("resource_mapping", flattenResourceMappings(mappings)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameApp, d.Id(), err)
+	}
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameApp, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameApp, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameApp, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	if d.HasChanges("app_assessment_schedule", "description", "resiliency_policy_arn") {
+		in := &resiliencehub.UpdateAppInput{
+			AppArn:      aws.String(d.Id()),
+			Description: aws.String(d.Get("description").(string)),
+		}
+
+		if v, ok := d.GetOk("app_assessment_schedule"); ok {
+			in.AssessmentSchedule = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("resiliency_policy_arn"); ok {
+			in.PolicyArn = aws.String(v.(string))
+		} else {
+			in.ClearResiliencyPolicyArn = aws.Bool(true)
+		}
+
+		log.Printf("[DEBUG] Updating Resilience Hub App (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateAppWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameApp, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("app_template_body") {
+		if err := putDraftAppVersionTemplate(ctx, conn, d.Id(), d.Get("app_template_body").(string)); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameApp, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("resource_mapping") {
+		o, n := d.GetChange("resource_mapping")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			if err := removeDraftAppVersionResourceMappings(ctx, conn, d.Id(), del); err != nil {
+				return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameApp, d.Id(), err)
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			if err := addDraftAppVersionResourceMappings(ctx, conn, d.Id(), add); err != nil {
+				return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameApp, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameApp, d.Id(), err)
+		}
+	}
+
+	return resourceAppRead(ctx, d, meta)
+}
+
+func resourceAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	log.Printf("[INFO] Deleting Resilience Hub App %s", d.Id())
+
+	_, err := conn.DeleteAppWithContext(ctx, &resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(d.Id()),
+		ForceDelete: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionDeleting, ResNameApp, d.Id(), err)
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionWaitingForDeletion, ResNameApp, d.Id(), err)
+	}
+
+	return nil
+}
+
+func putDraftAppVersionTemplate(ctx context.Context, conn *resiliencehub.ResilienceHub, arn, body string) error {
+	_, err := conn.PutDraftAppVersionTemplateWithContext(ctx, &resiliencehub.PutDraftAppVersionTemplateInput{
+		AppArn:          aws.String(arn),
+		AppTemplateBody: aws.String(body),
+	})
+
+	return err
+}
+
+func addDraftAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, tfList []interface{}) error {
+	_, err := conn.AddDraftAppVersionResourceMappingsWithContext(ctx, &resiliencehub.AddDraftAppVersionResourceMappingsInput{
+		AppArn:           aws.String(arn),
+		ResourceMappings: expandResourceMappings(tfList),
+	})
+
+	return err
+}
+
+func removeDraftAppVersionResourceMappings(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string, tfList []interface{}) error {
+	in := &resiliencehub.RemoveDraftAppVersionResourceMappingsInput{
+		AppArn: aws.String(arn),
+	}
+
+	// Mappings are removed by the name that identifies them for their mapping type.
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch tfMap["mapping_type"].(string) {
+		case resiliencehub.ResourceMappingTypeAppRegistryApp:
+			in.AppRegistryAppNames = append(in.AppRegistryAppNames, aws.String(tfMap["app_registry_app_name"].(string)))
+		case resiliencehub.ResourceMappingTypeCfnStack:
+			in.LogicalStackNames = append(in.LogicalStackNames, aws.String(tfMap["logical_stack_name"].(string)))
+		case resiliencehub.ResourceMappingTypeEks:
+			in.EksSourceNames = append(in.EksSourceNames, aws.String(tfMap["eks_source_name"].(string)))
+		case resiliencehub.ResourceMappingTypeResource:
+			in.ResourceNames = append(in.ResourceNames, aws.String(tfMap["resource_name"].(string)))
+		case resiliencehub.ResourceMappingTypeResourceGroup:
+			in.ResourceGroupNames = append(in.ResourceGroupNames, aws.String(tfMap["resource_group_name"].(string)))
+		case resiliencehub.ResourceMappingTypeTerraform:
+			in.TerraformSourceNames = append(in.TerraformSourceNames, aws.String(tfMap["terraform_source_name"].(string)))
+		}
+	}
+
+	_, err := conn.RemoveDraftAppVersionResourceMappingsWithContext(ctx, in)
+
+	return err
+}
+
+func expandResourceMappings(tfList []interface{}) []*resiliencehub.ResourceMapping {
+	var apiObjects []*resiliencehub.ResourceMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &resiliencehub.ResourceMapping{
+			MappingType:        aws.String(tfMap["mapping_type"].(string)),
+			PhysicalResourceId: expandPhysicalResourceID(tfMap["physical_resource_id"].([]interface{})),
+		}
+
+		if v, ok := tfMap["app_registry_app_name"].(string); ok && v != "" {
+			apiObject.AppRegistryAppName = aws.String(v)
+		}
+
+		if v, ok := tfMap["eks_source_name"].(string); ok && v != "" {
+			apiObject.EksSourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["logical_stack_name"].(string); ok && v != "" {
+			apiObject.LogicalStackName = aws.String(v)
+		}
+
+		if v, ok := tfMap["resource_group_name"].(string); ok && v != "" {
+			apiObject.ResourceGroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["resource_name"].(string); ok && v != "" {
+			apiObject.ResourceName = aws.String(v)
+		}
+
+		if v, ok := tfMap["terraform_source_name"].(string); ok && v != "" {
+			apiObject.TerraformSourceName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPhysicalResourceID(tfList []interface{}) *resiliencehub.PhysicalResourceId {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &resiliencehub.PhysicalResourceId{
+		Identifier: aws.String(tfMap["identifier"].(string)),
+		Type:       aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["aws_account_id"].(string); ok && v != "" {
+		apiObject.AwsAccountId = aws.String(v)
+	}
+
+	if v, ok := tfMap["aws_region"].(string); ok && v != "" {
+		apiObject.AwsRegion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenResourceMappings(apiObjects []*resiliencehub.ResourceMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"app_registry_app_name": aws.StringValue(apiObject.AppRegistryAppName),
+			"eks_source_name":       aws.StringValue(apiObject.EksSourceName),
+			"logical_stack_name":    aws.StringValue(apiObject.LogicalStackName),
+			"mapping_type":          aws.StringValue(apiObject.MappingType),
+			"physical_resource_id":  flattenPhysicalResourceID(apiObject.PhysicalResourceId),
+			"resource_group_name":   aws.StringValue(apiObject.ResourceGroupName),
+			"resource_name":         aws.StringValue(apiObject.ResourceName),
+			"terraform_source_name": aws.StringValue(apiObject.TerraformSourceName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenPhysicalResourceID(apiObject *resiliencehub.PhysicalResourceId) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"aws_account_id": aws.StringValue(apiObject.AwsAccountId),
+			"aws_region":     aws.StringValue(apiObject.AwsRegion),
+			"identifier":     aws.StringValue(apiObject.Identifier),
+			"type":           aws.StringValue(apiObject.Type),
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package resiliencehub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindResiliencyPolicyByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.ResiliencyPolicy, error) {
+	in := &resiliencehub.DescribeResiliencyPolicyInput{
+		PolicyArn: aws.String(arn),
+	}
+	out, err := conn.DescribeResiliencyPolicyWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Policy, nil
+}
+
+func FindAppByARN(ctx context.Context, conn *resiliencehub.ResilienceHub, arn string) (*resiliencehub.App, error) {
+	in := &resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+	out, err := conn.DescribeAppWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.App == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.App, nil
+}
+
+func FindAppVersionTemplateByTwoPartKey(ctx context.Context, conn *resiliencehub.ResilienceHub, arn, version string) (string, error) {
+	in := &resiliencehub.DescribeAppVersionTemplateInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(version),
+	}
+	out, err := conn.DescribeAppVersionTemplateWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if out == nil || out.AppTemplateBody == nil {
+		return "", tfresource.NewEmptyResultError(in)
+	}
+
+	return aws.StringValue(out.AppTemplateBody), nil
+}
+
+func FindAppVersionResourceMappingsByTwoPartKey(ctx context.Context, conn *resiliencehub.ResilienceHub, arn, version string) ([]*resiliencehub.ResourceMapping, error) {
+	in := &resiliencehub.ListAppVersionResourceMappingsInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(version),
+	}
+	var out []*resiliencehub.ResourceMapping
+
+	err := conn.ListAppVersionResourceMappingsPagesWithContext(ctx, in, func(page *resiliencehub.ListAppVersionResourceMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceMappings {
+			if v != nil {
+				out = append(out, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,302 @@
+package resiliencehub
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resiliencehub"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceResiliencyPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResiliencyPolicyCreate,
+		ReadWithoutTimeout:   resourceResiliencyPolicyRead,
+		UpdateWithoutTimeout: resourceResiliencyPolicyUpdate,
+		DeleteWithoutTimeout: resourceResiliencyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_location_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.DataLocationConstraint_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"estimated_cost_tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_\-]{1,59}$`), "must be 2 to 60 alphanumeric characters, hyphens or underscores, starting with an alphanumeric character"),
+			},
+			"policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"az":       failurePolicySchema(true),
+						"hardware": failurePolicySchema(true),
+						"region":   failurePolicySchema(false),
+						"software": failurePolicySchema(true),
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(resiliencehub.ResiliencyPolicyTier_Values(), false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func failurePolicySchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"rpo_in_secs": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"rto_in_secs": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameResiliencyPolicy = "Resiliency Policy"
+)
+
+func resourceResiliencyPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	name := d.Get("name").(string)
+	in := &resiliencehub.CreateResiliencyPolicyInput{
+		Policy:     expandFailurePolicies(d.Get("policy").([]interface{})),
+		PolicyName: aws.String(name),
+		Tier:       aws.String(d.Get("tier").(string)),
+	}
+
+	if v, ok := d.GetOk("data_location_constraint"); ok {
+		in.DataLocationConstraint = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.PolicyDescription = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateResiliencyPolicyWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionCreating, ResNameResiliencyPolicy, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.Policy.PolicyArn))
+
+	return resourceResiliencyPolicyRead(ctx, d, meta)
+}
+
+func resourceResiliencyPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	out, err := FindResiliencyPolicyByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resilience Hub Resiliency Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	d.Set("arn", out.PolicyArn)
+	d.Set("data_location_constraint", out.DataLocationConstraint)
+	d.Set("description", out.PolicyDescription)
+	d.Set("estimated_cost_tier", out.EstimatedCostTier)
+	d.Set("name", out.PolicyName)
+
+	if err := d.Set("policy", flattenFailurePolicies(out.Policy)); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	d.Set("tier", out.Tier)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionReading, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionSetting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceResiliencyPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	if d.HasChanges("data_location_constraint", "description", "name", "policy", "tier") {
+		in := &resiliencehub.UpdateResiliencyPolicyInput{
+			Policy:            expandFailurePolicies(d.Get("policy").([]interface{})),
+			PolicyArn:         aws.String(d.Id()),
+			PolicyDescription: aws.String(d.Get("description").(string)),
+			PolicyName:        aws.String(d.Get("name").(string)),
+			Tier:              aws.String(d.Get("tier").(string)),
+		}
+
+		if v, ok := d.GetOk("data_location_constraint"); ok {
+			in.DataLocationConstraint = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating Resilience Hub Resiliency Policy (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateResiliencyPolicyWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameResiliencyPolicy, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.ResilienceHub, create.ErrActionUpdating, ResNameResiliencyPolicy, d.Id(), err)
+		}
+	}
+
+	return resourceResiliencyPolicyRead(ctx, d, meta)
+}
+
+func resourceResiliencyPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResilienceHubConn()
+
+	log.Printf("[INFO] Deleting Resilience Hub Resiliency Policy %s", d.Id())
+
+	_, err := conn.DeleteResiliencyPolicyWithContext(ctx, &resiliencehub.DeleteResiliencyPolicyInput{
+		PolicyArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, resiliencehub.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ResilienceHub, create.ErrActionDeleting, ResNameResiliencyPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+// failurePolicyDisruptionTypes maps the schema block names to the API's disruption types.
+var failurePolicyDisruptionTypes = map[string]string{
+	"az":       resiliencehub.DisruptionTypeAz,
+	"hardware": resiliencehub.DisruptionTypeHardware,
+	"region":   resiliencehub.DisruptionTypeRegion,
+	"software": resiliencehub.DisruptionTypeSoftware,
+}
+
+func expandFailurePolicies(tfList []interface{}) map[string]*resiliencehub.FailurePolicy {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := make(map[string]*resiliencehub.FailurePolicy)
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		v, ok := tfMap[k].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		m := v[0].(map[string]interface{})
+		apiObject[disruptionType] = &resiliencehub.FailurePolicy{
+			RpoInSecs: aws.Int64(int64(m["rpo_in_secs"].(int))),
+			RtoInSecs: aws.Int64(int64(m["rto_in_secs"].(int))),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenFailurePolicies(apiObject map[string]*resiliencehub.FailurePolicy) []interface{} {
+	if len(apiObject) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for k, disruptionType := range failurePolicyDisruptionTypes {
+		v, ok := apiObject[disruptionType]
+		if !ok || v == nil {
+			continue
+		}
+
+		tfMap[k] = []interface{}{
+			map[string]interface{}{
+				"rpo_in_secs": aws.Int64Value(v.RpoInSecs),
+				"rto_in_secs": aws.Int64Value(v.RtoInSecs),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
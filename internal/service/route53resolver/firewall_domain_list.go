@@ -3,6 +3,7 @@ package route53resolver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -35,10 +37,17 @@ func ResourceFirewallDomainList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"domain_file_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 1024),
+				ConflictsWith: []string{"domains"},
+			},
 			"domains": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"domain_file_url"},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -93,6 +102,12 @@ func resourceFirewallDomainListCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if v, ok := d.GetOk("domain_file_url"); ok {
+		if err := importFirewallDomains(ctx, conn, d.Id(), v.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceFirewallDomainListRead(ctx, d, meta)
 }
 
@@ -117,27 +132,17 @@ func resourceFirewallDomainListRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("arn", arn)
 	d.Set("name", firewallDomainList.Name)
 
-	input := &route53resolver.ListFirewallDomainsInput{
-		FirewallDomainListId: aws.String(d.Id()),
-	}
-	var output []*string
+	// Domains imported from a file are managed by the file, not inline.
+	if _, ok := d.GetOk("domain_file_url"); !ok {
+		domains, err := findFirewallDomainsByListID(ctx, conn, d.Id())
 
-	err = conn.ListFirewallDomainsPagesWithContext(ctx, input, func(page *route53resolver.ListFirewallDomainsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+		if err != nil {
+			return diag.Errorf("listing Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
 		}
 
-		output = append(output, page.Domains...)
-
-		return !lastPage
-	})
-
-	if err != nil {
-		return diag.Errorf("listing Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
+		d.Set("domains", aws.StringValueSlice(domains))
 	}
 
-	d.Set("domains", aws.StringValueSlice(output))
-
 	tags, err := ListTags(ctx, conn, arn)
 
 	if err != nil {
@@ -161,7 +166,39 @@ func resourceFirewallDomainListRead(ctx context.Context, d *schema.ResourceData,
 func resourceFirewallDomainListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
-	if d.HasChange("domains") {
+	if d.HasChange("domain_file_url") {
+		if v, ok := d.GetOk("domain_file_url"); ok {
+			// The import replaces all of the list's domains, including any previously configured inline.
+			if err := importFirewallDomains(ctx, conn, d.Id(), v.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if d.Get("domains").(*schema.Set).Len() == 0 {
+			// The domain file no longer manages the list and no inline domains replace it, so remove the imported domains.
+			domains, err := findFirewallDomainsByListID(ctx, conn, d.Id())
+
+			if err != nil {
+				return diag.Errorf("listing Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
+			}
+
+			if len(domains) > 0 {
+				_, err := conn.UpdateFirewallDomainsWithContext(ctx, &route53resolver.UpdateFirewallDomainsInput{
+					FirewallDomainListId: aws.String(d.Id()),
+					Domains:              domains,
+					Operation:            aws.String(route53resolver.FirewallDomainUpdateOperationRemove),
+				})
+
+				if err != nil {
+					return diag.Errorf("updating Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
+				}
+
+				if _, err = waitFirewallDomainListUpdated(ctx, conn, d.Id()); err != nil {
+					return diag.Errorf("waiting for Route53 Resolver Firewall Domain List (%s) update: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
+	if _, ok := d.GetOk("domain_file_url"); !ok && d.HasChange("domains") {
 		o, n := d.GetChange("domains")
 		if o == nil {
 			o = new(schema.Set)
@@ -229,6 +266,47 @@ func resourceFirewallDomainListDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+func importFirewallDomains(ctx context.Context, conn *route53resolver.Route53Resolver, id, domainFileURL string) error {
+	_, err := conn.ImportFirewallDomainsWithContext(ctx, &route53resolver.ImportFirewallDomainsInput{
+		DomainFileUrl:        aws.String(domainFileURL),
+		FirewallDomainListId: aws.String(id),
+		Operation:            aws.String(route53resolver.FirewallDomainImportOperationReplace),
+	})
+
+	if err != nil {
+		return fmt.Errorf("importing Route53 Resolver Firewall Domain List (%s) domains from %s: %w", id, domainFileURL, err)
+	}
+
+	if _, err := waitFirewallDomainListUpdated(ctx, conn, id); err != nil {
+		return fmt.Errorf("waiting for Route53 Resolver Firewall Domain List (%s) import: %w", id, err)
+	}
+
+	return nil
+}
+
+func findFirewallDomainsByListID(ctx context.Context, conn *route53resolver.Route53Resolver, id string) ([]*string, error) {
+	input := &route53resolver.ListFirewallDomainsInput{
+		FirewallDomainListId: aws.String(id),
+	}
+	var output []*string
+
+	err := conn.ListFirewallDomainsPagesWithContext(ctx, input, func(page *route53resolver.ListFirewallDomainsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.Domains...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindFirewallDomainListByID(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.FirewallDomainList, error) {
 	input := &route53resolver.GetFirewallDomainListInput{
 		FirewallDomainListId: aws.String(id),
@@ -288,8 +366,9 @@ func waitFirewallDomainListUpdated(ctx context.Context, conn *route53resolver.Ro
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*route53resolver.FirewallDomainList); ok {
-		if status := aws.StringValue(output.Status); status == route53resolver.FirewallDomainListStatusCompleteImportFailed {
-			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		// COMPLETE_IMPORT_FAILED is a terminal state, so the waiter itself reports no error.
+		if status := aws.StringValue(output.Status); err == nil && status == route53resolver.FirewallDomainListStatusCompleteImportFailed {
+			err = errors.New(aws.StringValue(output.StatusMessage))
 		}
 
 		return output, err
@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func DataSourceFirewallDomainList() *schema.Resource {
@@ -31,12 +33,16 @@ func DataSourceFirewallDomainList() *schema.Resource {
 				Computed: true,
 			},
 			"firewall_domain_list_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"firewall_domain_list_id", "name"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"firewall_domain_list_id", "name"},
 			},
 			"managed_owner_name": {
 				Type:     schema.TypeString,
@@ -62,6 +68,18 @@ func dataSourceFirewallDomainListRead(ctx context.Context, d *schema.ResourceDat
 	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	id := d.Get("firewall_domain_list_id").(string)
+
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		metadata, err := findFirewallDomainListMetadataByName(ctx, conn, name)
+
+		if err != nil {
+			return diag.FromErr(tfresource.SingularDataSourceFindError("Route53 Resolver Firewall Domain List", err))
+		}
+
+		id = aws.StringValue(metadata.Id)
+	}
+
 	firewallDomainList, err := FindFirewallDomainListByID(ctx, conn, id)
 
 	if err != nil {
@@ -82,3 +100,36 @@ func dataSourceFirewallDomainListRead(ctx context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+func findFirewallDomainListMetadataByName(ctx context.Context, conn *route53resolver.Route53Resolver, name string) (*route53resolver.FirewallDomainListMetadata, error) {
+	input := &route53resolver.ListFirewallDomainListsInput{}
+	var output []*route53resolver.FirewallDomainListMetadata
+
+	err := conn.ListFirewallDomainListsPagesWithContext(ctx, input, func(page *route53resolver.ListFirewallDomainListsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FirewallDomainLists {
+			if aws.StringValue(v.Name) == name {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
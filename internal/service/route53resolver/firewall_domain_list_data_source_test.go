@@ -39,6 +39,54 @@ func TestAccRoute53ResolverFirewallDomainListDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallDomainListDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_route53_resolver_firewall_domain_list.test"
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+	domainName := acctest.RandomFQDomainName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListDataSourceConfig_name(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_count", resourceName, "domains.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firewall_domain_list_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverFirewallDomainListDataSource_managedName(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_resolver_firewall_domain_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListDataSourceConfig_managedName,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "domain_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "firewall_domain_list_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "managed_owner_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "AWSManagedDomainsMalwareDomainList"),
+				),
+			},
+		},
+	})
+}
+
 func testAccFirewallDomainListDataSourceConfig_basic(rName, domain string) string {
 	return acctest.ConfigCompose(testAccFirewallDomainListConfig_domains(rName, domain), `
 data "aws_route53_resolver_firewall_domain_list" "test" {
@@ -46,3 +94,17 @@ data "aws_route53_resolver_firewall_domain_list" "test" {
 }
 `)
 }
+
+func testAccFirewallDomainListDataSourceConfig_name(rName, domain string) string {
+	return acctest.ConfigCompose(testAccFirewallDomainListConfig_domains(rName, domain), `
+data "aws_route53_resolver_firewall_domain_list" "test" {
+  name = aws_route53_resolver_firewall_domain_list.test.name
+}
+`)
+}
+
+const testAccFirewallDomainListDataSourceConfig_managedName = `
+data "aws_route53_resolver_firewall_domain_list" "test" {
+  name = "AWSManagedDomainsMalwareDomainList"
+}
+`
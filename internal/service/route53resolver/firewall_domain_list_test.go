@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -95,6 +96,107 @@ func TestAccRoute53ResolverFirewallDomainList_domains(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallDomainList_domainFileURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.FirewallDomainList
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+	domainName1 := acctest.RandomFQDomainName()
+	domainName2 := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDomainListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListConfig_domainFileURL(rName, domainName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					testAccCheckFirewallDomainListDomainCount(&v, 1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_file_url"),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"domain_file_url", "domains"},
+			},
+			{
+				Config: testAccFirewallDomainListConfig_domainFileURL(rName, domainName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					testAccCheckFirewallDomainListDomainCount(&v, 1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_file_url"),
+				),
+			},
+			{
+				Config: testAccFirewallDomainListConfig_domainFileURLRemoved(rName, domainName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					testAccCheckFirewallDomainListDomainCount(&v, 0),
+					resource.TestCheckNoResourceAttr(resourceName, "domain_file_url"),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "0"),
+				),
+			},
+			{
+				Config:   testAccFirewallDomainListConfig_domainFileURLRemoved(rName, domainName2),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverFirewallDomainList_domainsToDomainFileURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.FirewallDomainList
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+	domainName := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDomainListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListConfig_domains(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					testAccCheckFirewallDomainListDomainCount(&v, 1),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+				),
+			},
+			{
+				// The domain file contains the same domain as the inline list.
+				Config: testAccFirewallDomainListConfig_domainFileURL(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					testAccCheckFirewallDomainListDomainCount(&v, 1),
+					resource.TestCheckResourceAttrSet(resourceName, "domain_file_url"),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "0"),
+				),
+			},
+			{
+				Config: testAccFirewallDomainListConfig_domains(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					testAccCheckFirewallDomainListDomainCount(&v, 1),
+					resource.TestCheckNoResourceAttr(resourceName, "domain_file_url"),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", domainName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallDomainList_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53resolver.FirewallDomainList
@@ -219,6 +321,16 @@ func testAccCheckFirewallDomainListExists(ctx context.Context, n string, v *rout
 	}
 }
 
+func testAccCheckFirewallDomainListDomainCount(v *route53resolver.FirewallDomainList, want int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.Int64Value(v.DomainCount); got != want {
+			return fmt.Errorf("Route53 Resolver Firewall Domain List (%s) domain count = %d, want %d", aws.StringValue(v.Id), got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccFirewallDomainListConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_resolver_firewall_domain_list" "test" {
@@ -236,6 +348,34 @@ resource "aws_route53_resolver_firewall_domain_list" "test" {
 `, rName, domain)
 }
 
+func testAccFirewallDomainListConfig_domainFileBase(rName, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "domains-${md5(%[2]q)}.txt"
+  content = %[2]q
+}
+`, rName, domain)
+}
+
+func testAccFirewallDomainListConfig_domainFileURL(rName, domain string) string {
+	return acctest.ConfigCompose(testAccFirewallDomainListConfig_domainFileBase(rName, domain), fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name            = %[1]q
+  domain_file_url = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+}
+`, rName))
+}
+
+func testAccFirewallDomainListConfig_domainFileURLRemoved(rName, domain string) string {
+	return acctest.ConfigCompose(testAccFirewallDomainListConfig_domainFileBase(rName, domain), testAccFirewallDomainListConfig_basic(rName))
+}
+
 func testAccFirewallDomainListConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_resolver_firewall_domain_list" "test" {
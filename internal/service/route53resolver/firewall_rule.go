@@ -60,6 +60,12 @@ func ResourceFirewallRule() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"firewall_domain_redirection_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(route53resolver.FirewallDomainRedirectionAction_Values(), false),
+			},
 			"firewall_rule_group_id": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
@@ -75,6 +81,12 @@ func ResourceFirewallRule() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"q_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 16),
+			},
 		},
 	}
 }
@@ -84,7 +96,8 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 
 	firewallDomainListID := d.Get("firewall_domain_list_id").(string)
 	firewallRuleGroupID := d.Get("firewall_rule_group_id").(string)
-	id := FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID)
+	qType := d.Get("q_type").(string)
+	id := FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID, qType)
 	name := d.Get("name").(string)
 	input := &route53resolver.CreateFirewallRuleInput{
 		Action:               aws.String(d.Get("action").(string)),
@@ -111,6 +124,14 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 		input.BlockResponse = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("firewall_domain_redirection_action"); ok {
+		input.FirewallDomainRedirectionAction = aws.String(v.(string))
+	}
+
+	if qType != "" {
+		input.Qtype = aws.String(qType)
+	}
+
 	_, err := conn.CreateFirewallRuleWithContext(ctx, input)
 
 	if err != nil {
@@ -125,13 +146,13 @@ func resourceFirewallRuleCreate(ctx context.Context, d *schema.ResourceData, met
 func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
-	firewallRuleGroupID, firewallDomainListID, err := FirewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, firewallDomainListID, qType, err := FirewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	firewallRule, err := FindFirewallRuleByTwoPartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID)
+	firewallRule, err := FindFirewallRuleByThreePartKey(ctx, conn, firewallRuleGroupID, firewallDomainListID, qType)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Route53 Resolver Firewall Rule (%s) not found, removing from state", d.Id())
@@ -150,8 +171,10 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("block_response", firewallRule.BlockResponse)
 	d.Set("firewall_rule_group_id", firewallRule.FirewallRuleGroupId)
 	d.Set("firewall_domain_list_id", firewallRule.FirewallDomainListId)
+	d.Set("firewall_domain_redirection_action", firewallRule.FirewallDomainRedirectionAction)
 	d.Set("name", firewallRule.Name)
 	d.Set("priority", firewallRule.Priority)
+	d.Set("q_type", firewallRule.Qtype)
 
 	return nil
 }
@@ -159,7 +182,7 @@ func resourceFirewallRuleRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
-	firewallRuleGroupID, firewallDomainListID, err := FirewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, firewallDomainListID, qType, err := FirewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -189,6 +212,14 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 		input.BlockResponse = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("firewall_domain_redirection_action"); ok {
+		input.FirewallDomainRedirectionAction = aws.String(v.(string))
+	}
+
+	if qType != "" {
+		input.Qtype = aws.String(qType)
+	}
+
 	_, err = conn.UpdateFirewallRuleWithContext(ctx, input)
 
 	if err != nil {
@@ -201,17 +232,23 @@ func resourceFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
-	firewallRuleGroupID, firewallDomainListID, err := FirewallRuleParseResourceID(d.Id())
+	firewallRuleGroupID, firewallDomainListID, qType, err := FirewallRuleParseResourceID(d.Id())
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	log.Printf("[DEBUG] Deleting Route53 Resolver Firewall Rule: %s", d.Id())
-	_, err = conn.DeleteFirewallRuleWithContext(ctx, &route53resolver.DeleteFirewallRuleInput{
+	input := &route53resolver.DeleteFirewallRuleInput{
 		FirewallDomainListId: aws.String(firewallDomainListID),
 		FirewallRuleGroupId:  aws.String(firewallRuleGroupID),
-	})
+	}
+
+	if qType != "" {
+		input.Qtype = aws.String(qType)
+	}
+
+	log.Printf("[DEBUG] Deleting Route53 Resolver Firewall Rule: %s", d.Id())
+	_, err = conn.DeleteFirewallRuleWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
 		return nil
@@ -226,26 +263,41 @@ func resourceFirewallRuleDelete(ctx context.Context, d *schema.ResourceData, met
 
 const firewallRuleIDSeparator = ":"
 
-func FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID string) string {
+// FirewallRuleCreateResourceID returns the resource ID for a firewall rule.
+// The query type is only included in the ID when it is set so that the IDs of
+// existing rules are unchanged.
+func FirewallRuleCreateResourceID(firewallRuleGroupID, firewallDomainListID, qType string) string {
 	parts := []string{firewallRuleGroupID, firewallDomainListID}
+
+	if qType != "" {
+		parts = append(parts, qType)
+	}
+
 	id := strings.Join(parts, firewallRuleIDSeparator)
 
 	return id
 }
 
-func FirewallRuleParseResourceID(id string) (string, string, error) {
-	parts := strings.SplitN(id, firewallRuleIDSeparator, 2)
+func FirewallRuleParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, firewallRuleIDSeparator)
 
-	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
-		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id", id, firewallRuleIDSeparator)
+	switch {
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], "", nil
+	case len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "":
+		return parts[0], parts[1], parts[2], nil
 	}
 
-	return parts[0], parts[1], nil
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected firewall_rule_group_id%[2]sfirewall_domain_list_id or firewall_rule_group_id%[2]sfirewall_domain_list_id%[2]sq_type", id, firewallRuleIDSeparator)
 }
 
-func FindFirewallRuleByTwoPartKey(ctx context.Context, conn *route53resolver.Route53Resolver, firewallRuleGroupID, firewallDomainListID string) (*route53resolver.FirewallRule, error) {
+func FindFirewallRuleByThreePartKey(ctx context.Context, conn *route53resolver.Route53Resolver, firewallRuleGroupID, firewallDomainListID, qType string) (*route53resolver.FirewallRule, error) {
 	output, err := findFirewallRules(ctx, conn, firewallRuleGroupID, func(rule *route53resolver.FirewallRule) bool {
-		return aws.StringValue(rule.FirewallDomainListId) == firewallDomainListID
+		if aws.StringValue(rule.FirewallDomainListId) != firewallDomainListID {
+			return false
+		}
+
+		return aws.StringValue(rule.Qtype) == qType
 	})
 
 	if err != nil {
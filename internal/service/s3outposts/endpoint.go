@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -28,6 +29,13 @@ func ResourceEndpoint() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"access_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(s3outposts.EndpointAccessType_Values(), false),
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -40,6 +48,12 @@ func ResourceEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"customer_owned_ipv4_pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^ipv4pool-coip-([0-9a-f]{17})$`), "must be a valid customer-owned IPv4 pool ID"),
+			},
 			"network_interfaces": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -84,6 +98,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		SubnetId:        aws.String(d.Get("subnet_id").(string)),
 	}
 
+	if v, ok := d.GetOk("access_type"); ok {
+		input.AccessType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_owned_ipv4_pool"); ok {
+		input.CustomerOwnedIpv4Pool = aws.String(v.(string))
+	}
+
 	output, err := conn.CreateEndpointWithContext(ctx, input)
 
 	if err != nil {
@@ -123,6 +145,7 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	d.Set("access_type", endpoint.AccessType)
 	d.Set("arn", endpoint.EndpointArn)
 	d.Set("cidr_block", endpoint.CidrBlock)
 
@@ -130,6 +153,8 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("creation_time", aws.TimeValue(endpoint.CreationTime).Format(time.RFC3339))
 	}
 
+	d.Set("customer_owned_ipv4_pool", endpoint.CustomerOwnedIpv4Pool)
+
 	if err := d.Set("network_interfaces", flattenNetworkInterfaces(endpoint.NetworkInterfaces)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting network_interfaces: %s", err)
 	}
@@ -32,7 +32,9 @@ func TestAccS3OutpostsEndpoint_basic(t *testing.T) {
 					testAccCheckEndpointExists(ctx, resourceName),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "s3-outposts", regexp.MustCompile(`outpost/[^/]+/endpoint/[a-z0-9]+`)),
 					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "access_type", "Private"),
 					resource.TestCheckResourceAttrPair(resourceName, "cidr_block", "aws_vpc.test", "cidr_block"),
+					resource.TestCheckResourceAttr(resourceName, "customer_owned_ipv4_pool", ""),
 					resource.TestCheckResourceAttr(resourceName, "network_interfaces.#", "4"),
 					resource.TestCheckResourceAttrPair(resourceName, "outpost_id", "data.aws_outposts_outpost.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", "id"),
@@ -72,6 +74,35 @@ func TestAccS3OutpostsEndpoint_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3OutpostsEndpoint_customerOwnedIPv4Pool(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3outposts_endpoint.test"
+	rInt := sdkacctest.RandIntRange(0, 255)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOutpostsOutposts(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3outposts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointConfig_customerOwnedIPv4Pool(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_type", "CustomerOwnedIp"),
+					resource.TestMatchResourceAttr(resourceName, "customer_owned_ipv4_pool", regexp.MustCompile(`^ipv4pool-coip-.+$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccEndpointImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3OutpostsConn()
@@ -164,3 +195,38 @@ resource "aws_s3outposts_endpoint" "test" {
 }
 `, rInt)
 }
+
+func testAccEndpointConfig_customerOwnedIPv4Pool(rInt int) string {
+	return fmt.Sprintf(`
+data "aws_outposts_outposts" "test" {}
+
+data "aws_outposts_outpost" "test" {
+  id = tolist(data.aws_outposts_outposts.test.ids)[0]
+}
+
+data "aws_ec2_coip_pools" "test" {}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.%[1]d.0.0/16"
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_outposts_outpost.test.availability_zone
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+  outpost_arn       = data.aws_outposts_outpost.test.arn
+  vpc_id            = aws_vpc.test.id
+}
+
+resource "aws_s3outposts_endpoint" "test" {
+  outpost_id               = data.aws_outposts_outpost.test.id
+  security_group_id        = aws_security_group.test.id
+  subnet_id                = aws_subnet.test.id
+  access_type              = "CustomerOwnedIp"
+  customer_owned_ipv4_pool = tolist(data.aws_ec2_coip_pools.test.pool_ids)[0]
+}
+`, rInt)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -41,42 +42,38 @@ func ResourceAppImageConfig() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
 				),
 			},
+			"code_editor_app_image_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"jupyter_lab_image_config", "kernel_gateway_image_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_config":   appImageConfigContainerConfigSchema(),
+						"file_system_config": appImageConfigFileSystemConfigSchema(),
+					},
+				},
+			},
+			"jupyter_lab_image_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"code_editor_app_image_config", "kernel_gateway_image_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_config":   appImageConfigContainerConfigSchema(),
+						"file_system_config": appImageConfigFileSystemConfigSchema(),
+					},
+				},
+			},
 			"kernel_gateway_image_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"code_editor_app_image_config", "jupyter_lab_image_config"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"file_system_config": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"default_gid": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      100,
-										ValidateFunc: validation.IntInSlice([]int{0, 100}),
-									},
-									"default_uid": {
-										Type:         schema.TypeInt,
-										Optional:     true,
-										Default:      1000,
-										ValidateFunc: validation.IntInSlice([]int{0, 1000}),
-									},
-									"mount_path": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "/home/sagemaker-user",
-										ValidateFunc: validation.All(
-											validation.StringLenBetween(1, 1024),
-											validation.StringMatch(regexp.MustCompile(`^\/.*`), "Must start with `/`."),
-										),
-									},
-								},
-							},
-						},
+						"file_system_config": appImageConfigFileSystemConfigSchema(),
 						"kernel_spec": {
 							Type:     schema.TypeList,
 							Required: true,
@@ -106,6 +103,68 @@ func ResourceAppImageConfig() *schema.Resource {
 	}
 }
 
+func appImageConfigContainerConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"container_arguments": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 50,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"container_entrypoint": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"container_environment_variables": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func appImageConfigFileSystemConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default_gid": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      100,
+					ValidateFunc: validation.IntInSlice([]int{0, 100}),
+				},
+				"default_uid": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1000,
+					ValidateFunc: validation.IntInSlice([]int{0, 1000}),
+				},
+				"mount_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "/home/sagemaker-user",
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 1024),
+						validation.StringMatch(regexp.MustCompile(`^\/.*`), "Must start with `/`."),
+					),
+				},
+			},
+		},
+	}
+}
+
 func resourceAppImageConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn()
@@ -121,6 +180,14 @@ func resourceAppImageConfigCreate(ctx context.Context, d *schema.ResourceData, m
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if v, ok := d.GetOk("code_editor_app_image_config"); ok && len(v.([]interface{})) > 0 {
+		input.CodeEditorAppImageConfig = expandAppImageConfigCodeEditorAppImageConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("jupyter_lab_image_config"); ok && len(v.([]interface{})) > 0 {
+		input.JupyterLabAppImageConfig = expandAppImageConfigJupyterLabAppImageConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("kernel_gateway_image_config"); ok && len(v.([]interface{})) > 0 {
 		input.KernelGatewayImageConfig = expandAppImageConfigKernelGatewayImageConfig(v.([]interface{}))
 	}
@@ -155,6 +222,14 @@ func resourceAppImageConfigRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("app_image_config_name", image.AppImageConfigName)
 	d.Set("arn", arn)
 
+	if err := d.Set("code_editor_app_image_config", flattenAppImageConfigCodeEditorAppImageConfig(image.CodeEditorAppImageConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting code_editor_app_image_config: %s", err)
+	}
+
+	if err := d.Set("jupyter_lab_image_config", flattenAppImageConfigJupyterLabAppImageConfig(image.JupyterLabAppImageConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting jupyter_lab_image_config: %s", err)
+	}
+
 	if err := d.Set("kernel_gateway_image_config", flattenAppImageConfigKernelGatewayImageConfig(image.KernelGatewayImageConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting kernel_gateway_image_config: %s", err)
 	}
@@ -191,11 +266,19 @@ func resourceAppImageConfigUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	if d.HasChange("kernel_gateway_image_config") {
+	if d.HasChanges("code_editor_app_image_config", "jupyter_lab_image_config", "kernel_gateway_image_config") {
 		input := &sagemaker.UpdateAppImageConfigInput{
 			AppImageConfigName: aws.String(d.Id()),
 		}
 
+		if v, ok := d.GetOk("code_editor_app_image_config"); ok && len(v.([]interface{})) > 0 {
+			input.CodeEditorAppImageConfig = expandAppImageConfigCodeEditorAppImageConfig(v.([]interface{}))
+		}
+
+		if v, ok := d.GetOk("jupyter_lab_image_config"); ok && len(v.([]interface{})) > 0 {
+			input.JupyterLabAppImageConfig = expandAppImageConfigJupyterLabAppImageConfig(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("kernel_gateway_image_config"); ok && len(v.([]interface{})) > 0 {
 			input.KernelGatewayImageConfig = expandAppImageConfigKernelGatewayImageConfig(v.([]interface{}))
 		}
@@ -228,6 +311,70 @@ func resourceAppImageConfigDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func expandAppImageConfigCodeEditorAppImageConfig(l []interface{}) *sagemaker.CodeEditorAppImageConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.CodeEditorAppImageConfig{}
+
+	if v, ok := m["container_config"].([]interface{}); ok && len(v) > 0 {
+		config.ContainerConfig = expandAppImageConfigContainerConfig(v)
+	}
+
+	if v, ok := m["file_system_config"].([]interface{}); ok && len(v) > 0 {
+		config.FileSystemConfig = expandAppImageConfigKernelGatewayImageConfigFileSystemConfig(v)
+	}
+
+	return config
+}
+
+func expandAppImageConfigJupyterLabAppImageConfig(l []interface{}) *sagemaker.JupyterLabAppImageConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.JupyterLabAppImageConfig{}
+
+	if v, ok := m["container_config"].([]interface{}); ok && len(v) > 0 {
+		config.ContainerConfig = expandAppImageConfigContainerConfig(v)
+	}
+
+	if v, ok := m["file_system_config"].([]interface{}); ok && len(v) > 0 {
+		config.FileSystemConfig = expandAppImageConfigKernelGatewayImageConfigFileSystemConfig(v)
+	}
+
+	return config
+}
+
+func expandAppImageConfigContainerConfig(l []interface{}) *sagemaker.ContainerConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.ContainerConfig{}
+
+	if v, ok := m["container_arguments"].([]interface{}); ok && len(v) > 0 {
+		config.ContainerArguments = flex.ExpandStringList(v)
+	}
+
+	if v, ok := m["container_entrypoint"].([]interface{}); ok && len(v) > 0 {
+		config.ContainerEntrypoint = flex.ExpandStringList(v)
+	}
+
+	if v, ok := m["container_environment_variables"].(map[string]interface{}); ok && len(v) > 0 {
+		config.ContainerEnvironmentVariables = flex.ExpandStringMap(v)
+	}
+
+	return config
+}
+
 func expandAppImageConfigKernelGatewayImageConfig(l []interface{}) *sagemaker.KernelGatewayImageConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -292,6 +439,56 @@ func expandAppImageConfigKernelGatewayImageConfigKernelSpecs(tfList []interface{
 	return kernelSpecs
 }
 
+func flattenAppImageConfigCodeEditorAppImageConfig(config *sagemaker.CodeEditorAppImageConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.ContainerConfig != nil {
+		m["container_config"] = flattenAppImageConfigContainerConfig(config.ContainerConfig)
+	}
+
+	if config.FileSystemConfig != nil {
+		m["file_system_config"] = flattenAppImageConfigKernelGatewayImageConfigFileSystemConfig(config.FileSystemConfig)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenAppImageConfigJupyterLabAppImageConfig(config *sagemaker.JupyterLabAppImageConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.ContainerConfig != nil {
+		m["container_config"] = flattenAppImageConfigContainerConfig(config.ContainerConfig)
+	}
+
+	if config.FileSystemConfig != nil {
+		m["file_system_config"] = flattenAppImageConfigKernelGatewayImageConfigFileSystemConfig(config.FileSystemConfig)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenAppImageConfigContainerConfig(config *sagemaker.ContainerConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"container_arguments":             aws.StringValueSlice(config.ContainerArguments),
+		"container_entrypoint":            aws.StringValueSlice(config.ContainerEntrypoint),
+		"container_environment_variables": aws.StringValueMap(config.ContainerEnvironmentVariables),
+	}
+
+	return []map[string]interface{}{m}
+}
+
 func flattenAppImageConfigKernelGatewayImageConfig(config *sagemaker.KernelGatewayImageConfig) []map[string]interface{} {
 	if config == nil {
 		return []map[string]interface{}{}
@@ -34,6 +34,8 @@ func TestAccSageMakerAppImageConfig_basic(t *testing.T) {
 					testAccCheckAppImageExistsConfig(ctx, resourceName, &config),
 					resource.TestCheckResourceAttr(resourceName, "app_image_config_name", rName),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("app-image-config/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "code_editor_app_image_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "kernel_gateway_image_config.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
@@ -138,6 +140,79 @@ func TestAccSageMakerAppImageConfig_KernelGatewayImage_fileSystem(t *testing.T)
 	})
 }
 
+func TestAccSageMakerAppImageConfig_JupyterLabImage_containerConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config sagemaker.DescribeAppImageConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_app_image_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppImageDestroyConfig(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppImageConfigConfig_jupyterLabContainerConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppImageExistsConfig(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.0.container_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.0.container_config.0.container_arguments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.0.container_config.0.container_arguments.0", "--debug"),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.0.container_config.0.container_environment_variables.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.0.container_config.0.container_environment_variables.KEY1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "kernel_gateway_image_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAppImageConfigConfig_jupyterLabContainerConfig(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppImageExistsConfig(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "jupyter_lab_image_config.0.container_config.0.container_environment_variables.KEY1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerAppImageConfig_CodeEditorAppImage_fileSystem(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config sagemaker.DescribeAppImageConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_app_image_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppImageDestroyConfig(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppImageConfigConfig_codeEditorFileSystem(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppImageExistsConfig(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "code_editor_app_image_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "code_editor_app_image_config.0.file_system_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "code_editor_app_image_config.0.file_system_config.0.default_gid", "100"),
+					resource.TestCheckResourceAttr(resourceName, "code_editor_app_image_config.0.file_system_config.0.default_uid", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "code_editor_app_image_config.0.file_system_config.0.mount_path", "/home/sagemaker-user"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSageMakerAppImageConfig_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app sagemaker.DescribeAppImageConfigOutput
@@ -332,6 +407,36 @@ resource "aws_sagemaker_app_image_config" "test" {
 `, rName)
 }
 
+func testAccAppImageConfigConfig_jupyterLabContainerConfig(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_app_image_config" "test" {
+  app_image_config_name = %[1]q
+
+  jupyter_lab_image_config {
+    container_config {
+      container_arguments = ["--debug"]
+
+      container_environment_variables = {
+        KEY1 = %[2]q
+      }
+    }
+  }
+}
+`, rName, value)
+}
+
+func testAccAppImageConfigConfig_codeEditorFileSystem(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_app_image_config" "test" {
+  app_image_config_name = %[1]q
+
+  code_editor_app_image_config {
+    file_system_config {}
+  }
+}
+`, rName)
+}
+
 func testAccAppImageConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_sagemaker_app_image_config" "test" {
@@ -157,13 +157,18 @@ func ResourceFeatureGroup() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"table_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sagemaker.TableFormatGlue,
+							ValidateFunc: validation.StringInSlice(sagemaker.TableFormat_Values(), false),
+						},
 					},
 				},
 			},
 			"online_store_config": {
 				Type:         schema.TypeList,
 				Optional:     true,
-				ForceNew:     true,
 				MaxItems:     1,
 				AtLeastOneOf: []string{"offline_store_config", "online_store_config"},
 				Elem: &schema.Resource{
@@ -171,6 +176,7 @@ func ResourceFeatureGroup() *schema.Resource {
 						"security_config": {
 							Type:     schema.TypeList,
 							Optional: true,
+							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -185,13 +191,66 @@ func ResourceFeatureGroup() *schema.Resource {
 						"enable_online_store": {
 							Type:     schema.TypeBool,
 							Optional: true,
+							ForceNew: true,
 							Default:  false,
 						},
+						"storage_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.StorageType_Values(), false),
+						},
+						"ttl_duration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sagemaker.TtlDurationUnit_Values(), false),
+									},
+									"value": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"throughput_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provisioned_read_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 10000000),
+						},
+						"provisioned_write_capacity_units": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 10000000),
+						},
+						"throughput_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.ThroughputMode_Values(), false),
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -230,6 +289,10 @@ func resourceFeatureGroupCreate(ctx context.Context, d *schema.ResourceData, met
 		input.OnlineStoreConfig = expandFeatureGroupOnlineStoreConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("throughput_config"); ok {
+		input.ThroughputConfig = expandFeatureGroupThroughputConfig(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] SageMaker Feature Group create config: %#v", *input)
 	err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
 		_, err := conn.CreateFeatureGroupWithContext(ctx, input)
@@ -300,6 +363,10 @@ func resourceFeatureGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting offline_store_config for SageMaker Feature Group (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("throughput_config", flattenFeatureGroupThroughputConfig(output.ThroughputConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting throughput_config for SageMaker Feature Group (%s): %s", d.Id(), err)
+	}
+
 	tags, err := ListTags(ctx, conn, arn)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "listing tags for SageMaker Feature Group (%s): %s", d.Id(), err)
@@ -323,6 +390,30 @@ func resourceFeatureGroupUpdate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn()
 
+	if d.HasChanges("online_store_config", "throughput_config") {
+		input := &sagemaker.UpdateFeatureGroupInput{
+			FeatureGroupName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("online_store_config") {
+			input.OnlineStoreConfig = expandFeatureGroupOnlineStoreConfigUpdate(d.Get("online_store_config").([]interface{}))
+		}
+
+		if d.HasChange("throughput_config") {
+			input.ThroughputConfig = expandFeatureGroupThroughputConfigUpdate(d.Get("throughput_config").([]interface{}))
+		}
+
+		_, err := conn.UpdateFeatureGroupWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Feature Group (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitFeatureGroupUpdated(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Feature Group (%s) to update: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -405,6 +496,30 @@ func expandFeatureGroupOnlineStoreConfig(l []interface{}) *sagemaker.OnlineStore
 		config.SecurityConfig = expandFeatureGroupOnlineStoreConfigSecurityConfig(v)
 	}
 
+	if v, ok := m["storage_type"].(string); ok && v != "" {
+		config.StorageType = aws.String(v)
+	}
+
+	if v, ok := m["ttl_duration"].([]interface{}); ok && len(v) > 0 {
+		config.TtlDuration = expandFeatureGroupOnlineStoreConfigTTLDuration(v)
+	}
+
+	return config
+}
+
+func expandFeatureGroupOnlineStoreConfigUpdate(l []interface{}) *sagemaker.OnlineStoreConfigUpdate {
+	config := &sagemaker.OnlineStoreConfigUpdate{}
+
+	if len(l) == 0 || l[0] == nil {
+		return config
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["ttl_duration"].([]interface{}); ok && len(v) > 0 {
+		config.TtlDuration = expandFeatureGroupOnlineStoreConfigTTLDuration(v)
+	}
+
 	return config
 }
 
@@ -421,6 +536,47 @@ func flattenFeatureGroupOnlineStoreConfig(config *sagemaker.OnlineStoreConfig) [
 		m["security_config"] = flattenFeatureGroupOnlineStoreConfigSecurityConfig(config.SecurityConfig)
 	}
 
+	if config.StorageType != nil {
+		m["storage_type"] = aws.StringValue(config.StorageType)
+	}
+
+	if config.TtlDuration != nil {
+		m["ttl_duration"] = flattenFeatureGroupOnlineStoreConfigTTLDuration(config.TtlDuration)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandFeatureGroupOnlineStoreConfigTTLDuration(l []interface{}) *sagemaker.TtlDuration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.TtlDuration{}
+
+	if v, ok := m["unit"].(string); ok && v != "" {
+		config.Unit = aws.String(v)
+	}
+
+	if v, ok := m["value"].(int); ok && v != 0 {
+		config.Value = aws.Int64(int64(v))
+	}
+
+	return config
+}
+
+func flattenFeatureGroupOnlineStoreConfigTTLDuration(config *sagemaker.TtlDuration) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"unit":  aws.StringValue(config.Unit),
+		"value": aws.Int64Value(config.Value),
+	}
+
 	return []map[string]interface{}{m}
 }
 
@@ -471,6 +627,10 @@ func expandFeatureGroupOfflineStoreConfig(l []interface{}) *sagemaker.OfflineSto
 		config.DisableGlueTableCreation = aws.Bool(v)
 	}
 
+	if v, ok := m["table_format"].(string); ok && v != "" {
+		config.TableFormat = aws.String(v)
+	}
+
 	return config
 }
 
@@ -481,6 +641,7 @@ func flattenFeatureGroupOfflineStoreConfig(config *sagemaker.OfflineStoreConfig)
 
 	m := map[string]interface{}{
 		"disable_glue_table_creation": aws.BoolValue(config.DisableGlueTableCreation),
+		"table_format":                aws.StringValue(config.TableFormat),
 	}
 
 	if config.DataCatalogConfig != nil {
@@ -557,3 +718,65 @@ func flattenFeatureGroupOfflineStoreConfigDataCatalogConfig(config *sagemaker.Da
 
 	return []map[string]interface{}{m}
 }
+
+func expandFeatureGroupThroughputConfig(l []interface{}) *sagemaker.ThroughputConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.ThroughputConfig{}
+
+	if v, ok := m["provisioned_read_capacity_units"].(int); ok && v != 0 {
+		config.ProvisionedReadCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["provisioned_write_capacity_units"].(int); ok && v != 0 {
+		config.ProvisionedWriteCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["throughput_mode"].(string); ok && v != "" {
+		config.ThroughputMode = aws.String(v)
+	}
+
+	return config
+}
+
+func expandFeatureGroupThroughputConfigUpdate(l []interface{}) *sagemaker.ThroughputConfigUpdate {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.ThroughputConfigUpdate{}
+
+	if v, ok := m["provisioned_read_capacity_units"].(int); ok && v != 0 {
+		config.ProvisionedReadCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["provisioned_write_capacity_units"].(int); ok && v != 0 {
+		config.ProvisionedWriteCapacityUnits = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["throughput_mode"].(string); ok && v != "" {
+		config.ThroughputMode = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenFeatureGroupThroughputConfig(config *sagemaker.ThroughputConfigDescription) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"provisioned_read_capacity_units":  aws.Int64Value(config.ProvisionedReadCapacityUnits),
+		"provisioned_write_capacity_units": aws.Int64Value(config.ProvisionedWriteCapacityUnits),
+		"throughput_mode":                  aws.StringValue(config.ThroughputMode),
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -28,7 +28,9 @@ func TestAccSageMakerFeatureGroup_serial(t *testing.T) {
 		"offlineConfig_createCatalog":   testAccFeatureGroup_offlineConfig_createCatalog,
 		"offlineConfig_providedCatalog": TestAccSageMakerFeatureGroup_Offline_providedCatalog,
 		"onlineConfigSecurityConfig":    testAccFeatureGroup_onlineConfigSecurityConfig,
+		"onlineConfigTTLDuration":       testAccFeatureGroup_onlineConfigTTLDuration,
 		"tags":                          testAccFeatureGroup_tags,
+		"throughputConfig":              testAccFeatureGroup_throughputConfig,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -214,6 +216,88 @@ func testAccFeatureGroup_onlineConfigSecurityConfig(t *testing.T) {
 	})
 }
 
+func testAccFeatureGroup_onlineConfigTTLDuration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var featureGroup sagemaker.DescribeFeatureGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_feature_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureGroupConfig_onlineTTLDuration(rName, "Hours", 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureGroupExists(ctx, resourceName, &featureGroup),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.enable_online_store", "true"),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.storage_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.ttl_duration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.ttl_duration.0.unit", "Hours"),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.ttl_duration.0.value", "12"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeatureGroupConfig_onlineTTLDuration(rName, "Days", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureGroupExists(ctx, resourceName, &featureGroup),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.ttl_duration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.ttl_duration.0.unit", "Days"),
+					resource.TestCheckResourceAttr(resourceName, "online_store_config.0.ttl_duration.0.value", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFeatureGroup_throughputConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var featureGroup sagemaker.DescribeFeatureGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_feature_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureGroupConfig_throughputProvisioned(rName, 10, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureGroupExists(ctx, resourceName, &featureGroup),
+					resource.TestCheckResourceAttr(resourceName, "throughput_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "throughput_config.0.throughput_mode", "Provisioned"),
+					resource.TestCheckResourceAttr(resourceName, "throughput_config.0.provisioned_read_capacity_units", "10"),
+					resource.TestCheckResourceAttr(resourceName, "throughput_config.0.provisioned_write_capacity_units", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeatureGroupConfig_throughputProvisioned(rName, 20, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureGroupExists(ctx, resourceName, &featureGroup),
+					resource.TestCheckResourceAttr(resourceName, "throughput_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "throughput_config.0.provisioned_read_capacity_units", "20"),
+					resource.TestCheckResourceAttr(resourceName, "throughput_config.0.provisioned_write_capacity_units", "30"),
+				),
+			},
+		},
+	})
+}
+
 func testAccFeatureGroup_offlineConfig_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var featureGroup sagemaker.DescribeFeatureGroupOutput
@@ -548,6 +632,58 @@ resource "aws_sagemaker_feature_group" "test" {
 `, rName))
 }
 
+func testAccFeatureGroupConfig_onlineTTLDuration(rName, unit string, value int) string {
+	return acctest.ConfigCompose(testAccFeatureGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_feature_group" "test" {
+  feature_group_name             = %[1]q
+  record_identifier_feature_name = %[1]q
+  event_time_feature_name        = %[1]q
+  role_arn                       = aws_iam_role.test.arn
+
+  feature_definition {
+    feature_name = %[1]q
+    feature_type = "String"
+  }
+
+  online_store_config {
+    enable_online_store = true
+    storage_type        = "Standard"
+
+    ttl_duration {
+      unit  = %[2]q
+      value = %[3]d
+    }
+  }
+}
+`, rName, unit, value))
+}
+
+func testAccFeatureGroupConfig_throughputProvisioned(rName string, readCapacity, writeCapacity int) string {
+	return acctest.ConfigCompose(testAccFeatureGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sagemaker_feature_group" "test" {
+  feature_group_name             = %[1]q
+  record_identifier_feature_name = %[1]q
+  event_time_feature_name        = %[1]q
+  role_arn                       = aws_iam_role.test.arn
+
+  feature_definition {
+    feature_name = %[1]q
+    feature_type = "String"
+  }
+
+  online_store_config {
+    enable_online_store = true
+  }
+
+  throughput_config {
+    throughput_mode                  = "Provisioned"
+    provisioned_read_capacity_units  = %[2]d
+    provisioned_write_capacity_units = %[3]d
+  }
+}
+`, rName, readCapacity, writeCapacity))
+}
+
 func testAccFeatureGroupConfig_offlineBasic(rName string) string {
 	return acctest.ConfigCompose(
 		testAccFeatureGroupBaseConfig(rName),
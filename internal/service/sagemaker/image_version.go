@@ -3,12 +3,15 @@ package sagemaker
 import (
 	"context"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
@@ -17,11 +20,19 @@ func ResourceImageVersion() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceImageVersionCreate,
 		ReadWithoutTimeout:   resourceImageVersionRead,
+		UpdateWithoutTimeout: resourceImageVersionUpdate,
 		DeleteWithoutTimeout: resourceImageVersionDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		// UpdateImageVersion cannot unset these attributes, so removing one replaces the image version.
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("job_type", imageVersionAttributeRemoved),
+			customdiff.ForceNewIfChange("ml_framework", imageVersionAttributeRemoved),
+			customdiff.ForceNewIfChange("release_notes", imageVersionAttributeRemoved),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -36,6 +47,10 @@ func ResourceImageVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"horovod": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"image_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -45,6 +60,24 @@ func ResourceImageVersion() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.JobType_Values(), false),
+			},
+			"ml_framework": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z]+ ?\d+\.\d+(\.\d+)?$`), "must be a framework name and version, e.g. `TensorFlow 2.1`"),
+				),
+			},
+			"release_notes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -63,6 +96,22 @@ func resourceImageVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		BaseImage: aws.String(d.Get("base_image").(string)),
 	}
 
+	if v, ok := d.GetOk("horovod"); ok {
+		input.Horovod = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("job_type"); ok {
+		input.JobType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ml_framework"); ok {
+		input.MLFramework = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("release_notes"); ok {
+		input.ReleaseNotes = aws.String(v.(string))
+	}
+
 	_, err := conn.CreateImageVersionWithContext(ctx, input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SageMaker Image Version %s: %s", name, err)
@@ -95,12 +144,54 @@ func resourceImageVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("base_image", image.BaseImage)
 	d.Set("image_arn", image.ImageArn)
 	d.Set("container_image", image.ContainerImage)
+	d.Set("horovod", image.Horovod)
+	d.Set("job_type", image.JobType)
+	d.Set("ml_framework", image.MLFramework)
+	d.Set("release_notes", image.ReleaseNotes)
 	d.Set("version", image.Version)
 	d.Set("image_name", d.Id())
 
 	return diags
 }
 
+func resourceImageVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	input := &sagemaker.UpdateImageVersionInput{
+		ImageName: aws.String(d.Id()),
+		Version:   aws.Int64(int64(d.Get("version").(int))),
+	}
+
+	if d.HasChange("horovod") {
+		input.Horovod = aws.Bool(d.Get("horovod").(bool))
+	}
+
+	if d.HasChange("job_type") {
+		if v, ok := d.GetOk("job_type"); ok {
+			input.JobType = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("ml_framework") {
+		if v, ok := d.GetOk("ml_framework"); ok {
+			input.MLFramework = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("release_notes") {
+		if v, ok := d.GetOk("release_notes"); ok {
+			input.ReleaseNotes = aws.String(v.(string))
+		}
+	}
+
+	if _, err := conn.UpdateImageVersionWithContext(ctx, input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SageMaker Image Version (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceImageVersionRead(ctx, d, meta)...)
+}
+
 func resourceImageVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn()
@@ -126,3 +217,7 @@ func resourceImageVersionDelete(ctx context.Context, d *schema.ResourceData, met
 
 	return diags
 }
+
+func imageVersionAttributeRemoved(_ context.Context, old, new, _ interface{}) bool {
+	return old.(string) != "" && new.(string) == ""
+}
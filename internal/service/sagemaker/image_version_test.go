@@ -44,6 +44,10 @@ func TestAccSageMakerImageVersion_basic(t *testing.T) {
 					acctest.CheckResourceAttrRegionalARN(resourceName, "image_arn", "sagemaker", fmt.Sprintf("image/%s", rName)),
 					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("image-version/%s/1", rName)),
 					resource.TestCheckResourceAttrSet(resourceName, "container_image"),
+					resource.TestCheckResourceAttr(resourceName, "horovod", "false"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "ml_framework", ""),
+					resource.TestCheckResourceAttr(resourceName, "release_notes", ""),
 				),
 			},
 			{
@@ -55,6 +59,61 @@ func TestAccSageMakerImageVersion_basic(t *testing.T) {
 	})
 }
 
+func TestAccSageMakerImageVersion_metadata(t *testing.T) {
+	ctx := acctest.Context(t)
+	if os.Getenv("SAGEMAKER_IMAGE_VERSION_BASE_IMAGE") == "" {
+		t.Skip("Environment variable SAGEMAKER_IMAGE_VERSION_BASE_IMAGE is not set")
+	}
+
+	var image sagemaker.DescribeImageVersionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_image_version.test"
+	baseImage := os.Getenv("SAGEMAKER_IMAGE_VERSION_BASE_IMAGE")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageVersionConfig_metadata(rName, baseImage, "TRAINING", "first release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageVersionExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "horovod", "true"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", "TRAINING"),
+					resource.TestCheckResourceAttr(resourceName, "ml_framework", "TensorFlow 2.1"),
+					resource.TestCheckResourceAttr(resourceName, "release_notes", "first release"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImageVersionConfig_metadata(rName, baseImage, "INFERENCE", "second release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageVersionExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "job_type", "INFERENCE"),
+					resource.TestCheckResourceAttr(resourceName, "release_notes", "second release"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccImageVersionConfig_basic(rName, baseImage),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageVersionExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "horovod", "false"),
+					resource.TestCheckResourceAttr(resourceName, "job_type", ""),
+					resource.TestCheckResourceAttr(resourceName, "ml_framework", ""),
+					resource.TestCheckResourceAttr(resourceName, "release_notes", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSageMakerImageVersion_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if os.Getenv("SAGEMAKER_IMAGE_VERSION_BASE_IMAGE") == "" {
@@ -164,7 +223,7 @@ func testAccCheckImageVersionExists(ctx context.Context, n string, image *sagema
 	}
 }
 
-func testAccImageVersionConfig_basic(rName, baseImage string) string {
+func testAccImageVersionConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
 
@@ -195,10 +254,27 @@ resource "aws_sagemaker_image" "test" {
 
   depends_on = [aws_iam_role_policy_attachment.test]
 }
+`, rName)
+}
 
+func testAccImageVersionConfig_basic(rName, baseImage string) string {
+	return acctest.ConfigCompose(testAccImageVersionConfig_base(rName), fmt.Sprintf(`
 resource "aws_sagemaker_image_version" "test" {
   image_name = aws_sagemaker_image.test.id
-  base_image = %[2]q
+  base_image = %[1]q
+}
+`, baseImage))
+}
+
+func testAccImageVersionConfig_metadata(rName, baseImage, jobType, releaseNotes string) string {
+	return acctest.ConfigCompose(testAccImageVersionConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_image_version" "test" {
+  image_name    = aws_sagemaker_image.test.id
+  base_image    = %[1]q
+  horovod       = true
+  job_type      = %[2]q
+  ml_framework  = "TensorFlow 2.1"
+  release_notes = %[3]q
 }
-`, rName, baseImage)
+`, baseImage, jobType, releaseNotes))
 }
@@ -0,0 +1,274 @@
+package sagemaker
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceModelCard() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelCardCreate,
+		ReadWithoutTimeout:   resourceModelCardRead,
+		UpdateWithoutTimeout: resourceModelCardUpdate,
+		DeleteWithoutTimeout: resourceModelCardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(0, 100000), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"model_card_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9]){0,62}$`),
+						"Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"model_card_status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.ModelCardStatus_Values(), false),
+			},
+			"model_card_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"security_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 2048),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceModelCardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	content, err := structure.NormalizeJsonString(d.Get("content").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "content (%s) is invalid JSON: %s", d.Get("content").(string), err)
+	}
+
+	name := d.Get("model_card_name").(string)
+	input := &sagemaker.CreateModelCardInput{
+		Content:         aws.String(content),
+		ModelCardName:   aws.String(name),
+		ModelCardStatus: aws.String(d.Get("model_card_status").(string)),
+	}
+
+	if v, ok := d.GetOk("security_config"); ok && len(v.([]interface{})) > 0 {
+		input.SecurityConfig = expandModelCardSecurityConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err = conn.CreateModelCardWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Model Card (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceModelCardRead(ctx, d, meta)...)
+}
+
+func resourceModelCardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	modelCard, err := FindModelCardByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Model Card (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Model Card (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(modelCard.ModelCardArn)
+	d.Set("arn", arn)
+
+	content, err := structure.NormalizeJsonString(aws.StringValue(modelCard.Content))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Model Card (%s): content (%s) is invalid JSON: %s", d.Id(), aws.StringValue(modelCard.Content), err)
+	}
+
+	d.Set("content", content)
+	d.Set("model_card_name", modelCard.ModelCardName)
+	d.Set("model_card_status", modelCard.ModelCardStatus)
+	d.Set("model_card_version", modelCard.ModelCardVersion)
+
+	if err := d.Set("security_config", flattenModelCardSecurityConfig(modelCard.SecurityConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting security_config: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SageMaker Model Card (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceModelCardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	if d.HasChanges("content", "model_card_status") {
+		input := &sagemaker.UpdateModelCardInput{
+			ModelCardName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("content") {
+			content, err := structure.NormalizeJsonString(d.Get("content").(string))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "content (%s) is invalid JSON: %s", d.Get("content").(string), err)
+			}
+
+			input.Content = aws.String(content)
+		}
+
+		if d.HasChange("model_card_status") {
+			input.ModelCardStatus = aws.String(d.Get("model_card_status").(string))
+		}
+
+		_, err := conn.UpdateModelCardWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Model Card (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Model Card (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceModelCardRead(ctx, d, meta)...)
+}
+
+func resourceModelCardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	log.Printf("[DEBUG] Deleting SageMaker Model Card: %s", d.Id())
+	_, err := conn.DeleteModelCardWithContext(ctx, &sagemaker.DeleteModelCardInput{
+		ModelCardName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Model Card (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitModelCardDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Model Card (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandModelCardSecurityConfig(l []interface{}) *sagemaker.ModelCardSecurityConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.ModelCardSecurityConfig{}
+
+	if v, ok := m["kms_key_id"].(string); ok && v != "" {
+		config.KmsKeyId = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenModelCardSecurityConfig(config *sagemaker.ModelCardSecurityConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.KmsKeyId != nil {
+		m["kms_key_id"] = aws.StringValue(config.KmsKeyId)
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,286 @@
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSageMakerModelCard_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribeModelCardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_card.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelCardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelCardConfig_basic(rName, "Draft", "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelCardExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("model-card/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "content"),
+					resource.TestCheckResourceAttr(resourceName, "model_card_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "model_card_status", "Draft"),
+					resource.TestCheckResourceAttr(resourceName, "model_card_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccModelCardConfig_basic(rName, "PendingReview", "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelCardExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "model_card_status", "PendingReview"),
+					resource.TestCheckResourceAttr(resourceName, "model_card_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerModelCard_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribeModelCardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_card.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelCardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelCardConfig_basic(rName, "Draft", "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelCardExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourceModelCard(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerModelCard_securityConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribeModelCardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_card.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelCardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelCardConfig_securityConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelCardExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "security_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_config.0.kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerModelCard_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v sagemaker.DescribeModelCardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_card.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelCardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelCardConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelCardExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccModelCardConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelCardExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccModelCardConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelCardExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckModelCardDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_model_card" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindModelCardByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker Model Card %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckModelCardExists(ctx context.Context, n string, v *sagemaker.DescribeModelCardOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Model Card ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
+
+		output, err := tfsagemaker.FindModelCardByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccModelCardConfig_basic(rName, status, description string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_model_card" "test" {
+  model_card_name   = %[1]q
+  model_card_status = %[2]q
+
+  content = jsonencode({
+    model_overview = {
+      model_description = %[3]q
+    }
+  })
+}
+`, rName, status, description)
+}
+
+func testAccModelCardConfig_securityConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_sagemaker_model_card" "test" {
+  model_card_name   = %[1]q
+  model_card_status = "Draft"
+
+  content = jsonencode({
+    model_overview = {
+      model_description = "test"
+    }
+  })
+
+  security_config {
+    kms_key_id = aws_kms_key.test.arn
+  }
+}
+`, rName)
+}
+
+func testAccModelCardConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_model_card" "test" {
+  model_card_name   = %[1]q
+  model_card_status = "Draft"
+
+  content = jsonencode({
+    model_overview = {
+      model_description = "test"
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccModelCardConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_model_card" "test" {
+  model_card_name   = %[1]q
+  model_card_status = "Draft"
+
+  content = jsonencode({
+    model_overview = {
+      model_description = "test"
+    }
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
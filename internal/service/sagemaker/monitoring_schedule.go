@@ -0,0 +1,369 @@
+package sagemaker
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceMonitoringSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMonitoringScheduleCreate,
+		ReadWithoutTimeout:   resourceMonitoringScheduleRead,
+		UpdateWithoutTimeout: resourceMonitoringScheduleUpdate,
+		DeleteWithoutTimeout: resourceMonitoringScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_monitoring_execution_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitoring_execution_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"processing_job_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scheduled_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"monitoring_schedule_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitoring_job_definition_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 63),
+								validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`),
+									"Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+							),
+						},
+						"monitoring_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.MonitoringType_Values(), false),
+						},
+						"schedule_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"schedule_expression": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"monitoring_schedule_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`),
+						"Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceMonitoringScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &sagemaker.CreateMonitoringScheduleInput{
+		MonitoringScheduleConfig: expandMonitoringScheduleConfig(d.Get("monitoring_schedule_config").([]interface{})),
+		MonitoringScheduleName:   aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateMonitoringScheduleWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SageMaker Monitoring Schedule (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := WaitMonitoringScheduleScheduled(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Monitoring Schedule (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceMonitoringScheduleRead(ctx, d, meta)...)
+}
+
+func resourceMonitoringScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	monitoringSchedule, err := FindMonitoringScheduleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Monitoring Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SageMaker Monitoring Schedule (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(monitoringSchedule.MonitoringScheduleArn)
+	d.Set("arn", arn)
+	if err := d.Set("last_monitoring_execution_summary", flattenMonitoringExecutionSummary(monitoringSchedule.LastMonitoringExecutionSummary)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting last_monitoring_execution_summary: %s", err)
+	}
+	if err := d.Set("monitoring_schedule_config", flattenMonitoringScheduleConfig(monitoringSchedule.MonitoringScheduleConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting monitoring_schedule_config: %s", err)
+	}
+	d.Set("monitoring_schedule_status", monitoringSchedule.MonitoringScheduleStatus)
+	d.Set("name", monitoringSchedule.MonitoringScheduleName)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SageMaker Monitoring Schedule (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceMonitoringScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	if d.HasChange("monitoring_schedule_config") {
+		input := &sagemaker.UpdateMonitoringScheduleInput{
+			MonitoringScheduleConfig: expandMonitoringScheduleConfig(d.Get("monitoring_schedule_config").([]interface{})),
+			MonitoringScheduleName:   aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateMonitoringScheduleWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Monitoring Schedule (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitMonitoringScheduleScheduled(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Monitoring Schedule (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SageMaker Monitoring Schedule (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMonitoringScheduleRead(ctx, d, meta)...)
+}
+
+func resourceMonitoringScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SageMakerConn()
+
+	log.Printf("[DEBUG] Deleting SageMaker Monitoring Schedule: %s", d.Id())
+	_, err := conn.DeleteMonitoringScheduleWithContext(ctx, &sagemaker.DeleteMonitoringScheduleInput{
+		MonitoringScheduleName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SageMaker Monitoring Schedule (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitMonitoringScheduleDeleted(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Monitoring Schedule (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandMonitoringScheduleConfig(l []interface{}) *sagemaker.MonitoringScheduleConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.MonitoringScheduleConfig{}
+
+	if v, ok := m["monitoring_job_definition_name"].(string); ok && v != "" {
+		config.MonitoringJobDefinitionName = aws.String(v)
+	}
+
+	if v, ok := m["monitoring_type"].(string); ok && v != "" {
+		config.MonitoringType = aws.String(v)
+	}
+
+	if v, ok := m["schedule_config"].([]interface{}); ok && len(v) > 0 {
+		config.ScheduleConfig = expandMonitoringScheduleScheduleConfig(v)
+	}
+
+	return config
+}
+
+func expandMonitoringScheduleScheduleConfig(l []interface{}) *sagemaker.ScheduleConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.ScheduleConfig{}
+
+	if v, ok := m["schedule_expression"].(string); ok && v != "" {
+		config.ScheduleExpression = aws.String(v)
+	}
+
+	return config
+}
+
+func flattenMonitoringScheduleConfig(config *sagemaker.MonitoringScheduleConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.MonitoringJobDefinitionName != nil {
+		m["monitoring_job_definition_name"] = aws.StringValue(config.MonitoringJobDefinitionName)
+	}
+
+	if config.MonitoringType != nil {
+		m["monitoring_type"] = aws.StringValue(config.MonitoringType)
+	}
+
+	if config.ScheduleConfig != nil {
+		m["schedule_config"] = flattenMonitoringScheduleScheduleConfig(config.ScheduleConfig)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenMonitoringScheduleScheduleConfig(config *sagemaker.ScheduleConfig) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.ScheduleExpression != nil {
+		m["schedule_expression"] = aws.StringValue(config.ScheduleExpression)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenMonitoringExecutionSummary(config *sagemaker.MonitoringExecutionSummary) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"endpoint_name":               aws.StringValue(config.EndpointName),
+		"failure_reason":              aws.StringValue(config.FailureReason),
+		"monitoring_execution_status": aws.StringValue(config.MonitoringExecutionStatus),
+		"processing_job_arn":          aws.StringValue(config.ProcessingJobArn),
+	}
+
+	if config.CreationTime != nil {
+		m["creation_time"] = aws.TimeValue(config.CreationTime).Format(time.RFC3339)
+	}
+
+	if config.LastModifiedTime != nil {
+		m["last_modified_time"] = aws.TimeValue(config.LastModifiedTime).Format(time.RFC3339)
+	}
+
+	if config.ScheduledTime != nil {
+		m["scheduled_time"] = aws.TimeValue(config.ScheduledTime).Format(time.RFC3339)
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,256 @@
+package sagemaker_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// A data quality job definition must already exist; its name is read from
+// SAGEMAKER_MONITORING_JOB_DEFINITION_NAME.
+func testAccPreCheckMonitoringJobDefinition(t *testing.T) string {
+	jobDefinitionName := os.Getenv("SAGEMAKER_MONITORING_JOB_DEFINITION_NAME")
+
+	if jobDefinitionName == "" {
+		t.Skip("Environment variable SAGEMAKER_MONITORING_JOB_DEFINITION_NAME is not set")
+	}
+
+	return jobDefinitionName
+}
+
+func TestAccSageMakerMonitoringSchedule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	jobDefinitionName := testAccPreCheckMonitoringJobDefinition(t)
+	var v sagemaker.DescribeMonitoringScheduleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_monitoring_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitoringScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringScheduleConfig_basic(rName, jobDefinitionName, "cron(0 * ? * * *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitoringScheduleExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("monitoring-schedule/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_schedule_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_schedule_config.0.monitoring_job_definition_name", jobDefinitionName),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_schedule_config.0.monitoring_type", "DataQuality"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_schedule_config.0.schedule_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_schedule_config.0.schedule_config.0.schedule_expression", "cron(0 * ? * * *)"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_schedule_status", "Scheduled"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMonitoringScheduleConfig_basic(rName, jobDefinitionName, "cron(0 0 ? * * *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitoringScheduleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_schedule_config.0.schedule_config.0.schedule_expression", "cron(0 0 ? * * *)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerMonitoringSchedule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	jobDefinitionName := testAccPreCheckMonitoringJobDefinition(t)
+	var v sagemaker.DescribeMonitoringScheduleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_monitoring_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitoringScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringScheduleConfig_basic(rName, jobDefinitionName, "cron(0 * ? * * *)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitoringScheduleExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsagemaker.ResourceMonitoringSchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerMonitoringSchedule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	jobDefinitionName := testAccPreCheckMonitoringJobDefinition(t)
+	var v sagemaker.DescribeMonitoringScheduleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_monitoring_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMonitoringScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringScheduleConfig_tags1(rName, jobDefinitionName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitoringScheduleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMonitoringScheduleConfig_tags2(rName, jobDefinitionName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitoringScheduleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccMonitoringScheduleConfig_tags1(rName, jobDefinitionName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMonitoringScheduleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMonitoringScheduleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sagemaker_monitoring_schedule" {
+				continue
+			}
+
+			_, err := tfsagemaker.FindMonitoringScheduleByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SageMaker Monitoring Schedule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMonitoringScheduleExists(ctx context.Context, n string, v *sagemaker.DescribeMonitoringScheduleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Monitoring Schedule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn()
+
+		output, err := tfsagemaker.FindMonitoringScheduleByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMonitoringScheduleConfig_basic(rName, jobDefinitionName, scheduleExpression string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_monitoring_schedule" "test" {
+  name = %[1]q
+
+  monitoring_schedule_config {
+    monitoring_job_definition_name = %[2]q
+    monitoring_type                = "DataQuality"
+
+    schedule_config {
+      schedule_expression = %[3]q
+    }
+  }
+}
+`, rName, jobDefinitionName, scheduleExpression)
+}
+
+func testAccMonitoringScheduleConfig_tags1(rName, jobDefinitionName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_monitoring_schedule" "test" {
+  name = %[1]q
+
+  monitoring_schedule_config {
+    monitoring_job_definition_name = %[2]q
+    monitoring_type                = "DataQuality"
+
+    schedule_config {
+      schedule_expression = "cron(0 * ? * * *)"
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, jobDefinitionName, tagKey1, tagValue1)
+}
+
+func testAccMonitoringScheduleConfig_tags2(rName, jobDefinitionName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_monitoring_schedule" "test" {
+  name = %[1]q
+
+  monitoring_schedule_config {
+    monitoring_job_definition_name = %[2]q
+    monitoring_type                = "DataQuality"
+
+    schedule_config {
+      schedule_expression = "cron(0 * ? * * *)"
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, jobDefinitionName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
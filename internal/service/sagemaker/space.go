@@ -55,6 +55,102 @@ func ResourceSpace() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"app_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.AppType_Values(), false),
+						},
+						"code_editor_app_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_resource_spec": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+												},
+												"lifecycle_config_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_version_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"jupyter_lab_app_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_repository": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"repository_url": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+											},
+										},
+									},
+									"default_resource_spec": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"instance_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+												},
+												"lifecycle_config_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"sagemaker_image_version_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						"jupyter_server_app_settings": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -182,6 +278,46 @@ func ResourceSpace() *schema.Resource {
 								},
 							},
 						},
+						"space_storage_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ebs_storage_settings": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"ebs_volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(5, 16384),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"space_sharing_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sharing_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.SharingType_Values(), false),
+						},
 					},
 				},
 			},
@@ -191,6 +327,30 @@ func ResourceSpace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ownership_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner_user_profile_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"space_display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 64),
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -210,10 +370,22 @@ func resourceSpaceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		DomainId:  aws.String(domainId),
 	}
 
+	if v, ok := d.GetOk("ownership_settings"); ok && len(v.([]interface{})) > 0 {
+		input.OwnershipSettings = expandOwnershipSettings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("space_display_name"); ok {
+		input.SpaceDisplayName = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("space_settings"); ok && len(v.([]interface{})) > 0 {
 		input.SpaceSettings = expandSpaceSettings(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("space_sharing_settings"); ok && len(v.([]interface{})) > 0 {
+		input.SpaceSharingSettings = expandSpaceSharingSettings(v.([]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -259,11 +431,21 @@ func resourceSpaceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("domain_id", Space.DomainId)
 	d.Set("arn", arn)
 	d.Set("home_efs_file_system_uid", Space.HomeEfsFileSystemUid)
+	d.Set("space_display_name", Space.SpaceDisplayName)
+	d.Set("url", Space.Url)
+
+	if err := d.Set("ownership_settings", flattenOwnershipSettings(Space.OwnershipSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ownership_settings for SageMaker Space (%s): %s", d.Id(), err)
+	}
 
 	if err := d.Set("space_settings", flattenSpaceSettings(Space.SpaceSettings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting space_settings for SageMaker Space (%s): %s", d.Id(), err)
 	}
 
+	if err := d.Set("space_sharing_settings", flattenSpaceSharingSettings(Space.SpaceSharingSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting space_sharing_settings for SageMaker Space (%s): %s", d.Id(), err)
+	}
+
 	tags, err := ListTags(ctx, conn, arn)
 
 	if err != nil {
@@ -288,14 +470,21 @@ func resourceSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerConn()
 
-	if d.HasChange("space_settings") {
+	if d.HasChanges("space_display_name", "space_settings") {
 		domainID := d.Get("domain_id").(string)
 		name := d.Get("space_name").(string)
 
 		input := &sagemaker.UpdateSpaceInput{
-			SpaceName:     aws.String(name),
-			DomainId:      aws.String(domainID),
-			SpaceSettings: expandSpaceSettings(d.Get("space_settings").([]interface{})),
+			SpaceName: aws.String(name),
+			DomainId:  aws.String(domainID),
+		}
+
+		if d.HasChange("space_display_name") {
+			input.SpaceDisplayName = aws.String(d.Get("space_display_name").(string))
+		}
+
+		if d.HasChange("space_settings") {
+			input.SpaceSettings = expandSpaceSettings(d.Get("space_settings").([]interface{}))
 		}
 
 		log.Printf("[DEBUG] SageMaker Space update config: %#v", *input)
@@ -375,6 +564,18 @@ func expandSpaceSettings(l []interface{}) *sagemaker.SpaceSettings {
 
 	config := &sagemaker.SpaceSettings{}
 
+	if v, ok := m["app_type"].(string); ok && v != "" {
+		config.AppType = aws.String(v)
+	}
+
+	if v, ok := m["code_editor_app_settings"].([]interface{}); ok && len(v) > 0 {
+		config.CodeEditorAppSettings = expandSpaceCodeEditorAppSettings(v)
+	}
+
+	if v, ok := m["jupyter_lab_app_settings"].([]interface{}); ok && len(v) > 0 {
+		config.JupyterLabAppSettings = expandSpaceJupyterLabAppSettings(v)
+	}
+
 	if v, ok := m["jupyter_server_app_settings"].([]interface{}); ok && len(v) > 0 {
 		config.JupyterServerAppSettings = expandDomainJupyterServerAppSettings(v)
 	}
@@ -383,6 +584,10 @@ func expandSpaceSettings(l []interface{}) *sagemaker.SpaceSettings {
 		config.KernelGatewayAppSettings = expandDomainKernelGatewayAppSettings(v)
 	}
 
+	if v, ok := m["space_storage_settings"].([]interface{}); ok && len(v) > 0 {
+		config.SpaceStorageSettings = expandSpaceStorageSettings(v)
+	}
+
 	return config
 }
 
@@ -393,6 +598,18 @@ func flattenSpaceSettings(config *sagemaker.SpaceSettings) []map[string]interfac
 
 	m := map[string]interface{}{}
 
+	if config.AppType != nil {
+		m["app_type"] = aws.StringValue(config.AppType)
+	}
+
+	if config.CodeEditorAppSettings != nil {
+		m["code_editor_app_settings"] = flattenSpaceCodeEditorAppSettings(config.CodeEditorAppSettings)
+	}
+
+	if config.JupyterLabAppSettings != nil {
+		m["jupyter_lab_app_settings"] = flattenSpaceJupyterLabAppSettings(config.JupyterLabAppSettings)
+	}
+
 	if config.JupyterServerAppSettings != nil {
 		m["jupyter_server_app_settings"] = flattenDomainJupyterServerAppSettings(config.JupyterServerAppSettings)
 	}
@@ -401,5 +618,163 @@ func flattenSpaceSettings(config *sagemaker.SpaceSettings) []map[string]interfac
 		m["kernel_gateway_app_settings"] = flattenDomainKernelGatewayAppSettings(config.KernelGatewayAppSettings)
 	}
 
+	if config.SpaceStorageSettings != nil {
+		m["space_storage_settings"] = flattenSpaceStorageSettings(config.SpaceStorageSettings)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandSpaceCodeEditorAppSettings(l []interface{}) *sagemaker.SpaceCodeEditorAppSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceCodeEditorAppSettings{}
+
+	if v, ok := m["default_resource_spec"].([]interface{}); ok && len(v) > 0 {
+		config.DefaultResourceSpec = expandDomainDefaultResourceSpec(v)
+	}
+
+	return config
+}
+
+func expandSpaceJupyterLabAppSettings(l []interface{}) *sagemaker.SpaceJupyterLabAppSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceJupyterLabAppSettings{}
+
+	if v, ok := m["code_repository"].(*schema.Set); ok && v.Len() > 0 {
+		config.CodeRepositories = expandCodeRepositories(v.List())
+	}
+
+	if v, ok := m["default_resource_spec"].([]interface{}); ok && len(v) > 0 {
+		config.DefaultResourceSpec = expandDomainDefaultResourceSpec(v)
+	}
+
+	return config
+}
+
+func expandSpaceStorageSettings(l []interface{}) *sagemaker.SpaceStorageSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceStorageSettings{}
+
+	if v, ok := m["ebs_storage_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		config.EbsStorageSettings = &sagemaker.EbsStorageSettings{
+			EbsVolumeSizeInGb: aws.Int64(int64(v[0].(map[string]interface{})["ebs_volume_size_in_gb"].(int))),
+		}
+	}
+
+	return config
+}
+
+func expandOwnershipSettings(l []interface{}) *sagemaker.OwnershipSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.OwnershipSettings{
+		OwnerUserProfileName: aws.String(m["owner_user_profile_name"].(string)),
+	}
+
+	return config
+}
+
+func expandSpaceSharingSettings(l []interface{}) *sagemaker.SpaceSharingSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &sagemaker.SpaceSharingSettings{
+		SharingType: aws.String(m["sharing_type"].(string)),
+	}
+
+	return config
+}
+
+func flattenSpaceCodeEditorAppSettings(config *sagemaker.SpaceCodeEditorAppSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.DefaultResourceSpec != nil {
+		m["default_resource_spec"] = flattenDomainDefaultResourceSpec(config.DefaultResourceSpec)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSpaceJupyterLabAppSettings(config *sagemaker.SpaceJupyterLabAppSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.CodeRepositories != nil {
+		m["code_repository"] = flattenCodeRepositories(config.CodeRepositories)
+	}
+
+	if config.DefaultResourceSpec != nil {
+		m["default_resource_spec"] = flattenDomainDefaultResourceSpec(config.DefaultResourceSpec)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSpaceStorageSettings(config *sagemaker.SpaceStorageSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.EbsStorageSettings != nil {
+		m["ebs_storage_settings"] = []map[string]interface{}{{
+			"ebs_volume_size_in_gb": aws.Int64Value(config.EbsStorageSettings.EbsVolumeSizeInGb),
+		}}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenOwnershipSettings(config *sagemaker.OwnershipSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"owner_user_profile_name": aws.StringValue(config.OwnerUserProfileName),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSpaceSharingSettings(config *sagemaker.SpaceSharingSettings) []map[string]interface{} {
+	if config == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"sharing_type": aws.StringValue(config.SharingType),
+	}
+
 	return []map[string]interface{}{m}
 }
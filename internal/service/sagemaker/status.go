@@ -250,3 +250,72 @@ func StatusSpace(ctx context.Context, conn *sagemaker.SageMaker, domainId, name
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func StatusModelCardProcessing(ctx context.Context, conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindModelCardByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ModelCardProcessingStatus), nil
+	}
+}
+
+func StatusMonitoringSchedule(ctx context.Context, conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMonitoringScheduleByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.MonitoringScheduleStatus), nil
+	}
+}
+
+// StatusPipeline fetches the Pipeline and its Status
+func StatusPipeline(ctx context.Context, conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPipelineByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PipelineStatus), nil
+	}
+}
+
+func StatusFeatureGroupUpdate(ctx context.Context, conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFeatureGroupByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.LastUpdateStatus == nil {
+			return output, sagemaker.LastUpdateStatusValueSuccessful, nil
+		}
+
+		return output, aws.StringValue(output.LastUpdateStatus.Status), nil
+	}
+}
@@ -85,6 +85,11 @@ func init() {
 		F:    sweepImages,
 	})
 
+	resource.AddTestSweepers("aws_sagemaker_model_card", &resource.Sweeper{
+		Name: "aws_sagemaker_model_card",
+		F:    sweepModelCards,
+	})
+
 	resource.AddTestSweepers("aws_sagemaker_model_package_group", &resource.Sweeper{
 		Name: "aws_sagemaker_model_package_group",
 		F:    sweepModelPackageGroups,
@@ -95,6 +100,11 @@ func init() {
 		F:    sweepModels,
 	})
 
+	resource.AddTestSweepers("aws_sagemaker_monitoring_schedule", &resource.Sweeper{
+		Name: "aws_sagemaker_monitoring_schedule",
+		F:    sweepMonitoringSchedules,
+	})
+
 	resource.AddTestSweepers("aws_sagemaker_notebook_instance_lifecycle_configuration", &resource.Sweeper{
 		Name: "aws_sagemaker_notebook_instance_lifecycle_configuration",
 		F:    sweepNotebookInstanceLifecycleConfiguration,
@@ -108,6 +118,11 @@ func init() {
 		F:    sweepNotebookInstances,
 	})
 
+	resource.AddTestSweepers("aws_sagemaker_pipeline", &resource.Sweeper{
+		Name: "aws_sagemaker_pipeline",
+		F:    sweepPipelines,
+	})
+
 	resource.AddTestSweepers("aws_sagemaker_studio_lifecycle_config", &resource.Sweeper{
 		Name: "aws_sagemaker_studio_lifecycle_config",
 		F:    sweepStudioLifecyclesConfig,
@@ -635,6 +650,44 @@ func sweepImages(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepModelCards(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SageMakerConn()
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListModelCardsPagesWithContext(ctx, &sagemaker.ListModelCardsInput{}, func(page *sagemaker.ListModelCardsOutput, lastPage bool) bool {
+		for _, modelCard := range page.ModelCardSummaries {
+			r := ResourceModelCard()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(modelCard.ModelCardName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Model Card sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Model Cards: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Model Cards: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepModelPackageGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
@@ -711,6 +764,44 @@ func sweepModels(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepMonitoringSchedules(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).SageMakerConn()
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListMonitoringSchedulesPagesWithContext(ctx, &sagemaker.ListMonitoringSchedulesInput{}, func(page *sagemaker.ListMonitoringSchedulesOutput, lastPage bool) bool {
+		for _, monitoringSchedule := range page.MonitoringScheduleSummaries {
+			r := ResourceMonitoringSchedule()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(monitoringSchedule.MonitoringScheduleName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Monitoring Schedule sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("retrieving SageMaker Monitoring Schedules: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("sweeping SageMaker Monitoring Schedules: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepNotebookInstanceLifecycleConfiguration(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
@@ -991,3 +1082,46 @@ func sweepProjects(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepPipelines(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).SageMakerConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPipelinesPagesWithContext(ctx, &sagemaker.ListPipelinesInput{}, func(page *sagemaker.ListPipelinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PipelineSummaries {
+			r := ResourcePipeline()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PipelineName))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Pipeline sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing SageMaker Pipelines (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping SageMaker Pipelines (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -12,31 +12,36 @@ import (
 )
 
 const (
-	NotebookInstanceInServiceTimeout  = 60 * time.Minute
-	NotebookInstanceStoppedTimeout    = 10 * time.Minute
-	NotebookInstanceDeletedTimeout    = 10 * time.Minute
-	ModelPackageGroupCompletedTimeout = 10 * time.Minute
-	ModelPackageGroupDeletedTimeout   = 10 * time.Minute
-	ImageCreatedTimeout               = 10 * time.Minute
-	ImageDeletedTimeout               = 10 * time.Minute
-	ImageVersionCreatedTimeout        = 10 * time.Minute
-	ImageVersionDeletedTimeout        = 10 * time.Minute
-	DomainInServiceTimeout            = 10 * time.Minute
-	DomainDeletedTimeout              = 10 * time.Minute
-	FeatureGroupCreatedTimeout        = 10 * time.Minute
-	FeatureGroupDeletedTimeout        = 10 * time.Minute
-	UserProfileInServiceTimeout       = 10 * time.Minute
-	UserProfileDeletedTimeout         = 10 * time.Minute
-	AppInServiceTimeout               = 10 * time.Minute
-	AppDeletedTimeout                 = 10 * time.Minute
-	FlowDefinitionActiveTimeout       = 2 * time.Minute
-	FlowDefinitionDeletedTimeout      = 2 * time.Minute
-	ProjectCreatedTimeout             = 15 * time.Minute
-	ProjectDeletedTimeout             = 15 * time.Minute
-	WorkforceActiveTimeout            = 10 * time.Minute
-	WorkforceDeletedTimeout           = 10 * time.Minute
-	SpaceDeletedTimeout               = 10 * time.Minute
-	SpaceInServiceTimeout             = 10 * time.Minute
+	NotebookInstanceInServiceTimeout   = 60 * time.Minute
+	NotebookInstanceStoppedTimeout     = 10 * time.Minute
+	NotebookInstanceDeletedTimeout     = 10 * time.Minute
+	ModelPackageGroupCompletedTimeout  = 10 * time.Minute
+	ModelPackageGroupDeletedTimeout    = 10 * time.Minute
+	ImageCreatedTimeout                = 10 * time.Minute
+	ImageDeletedTimeout                = 10 * time.Minute
+	ImageVersionCreatedTimeout         = 10 * time.Minute
+	ImageVersionDeletedTimeout         = 10 * time.Minute
+	DomainInServiceTimeout             = 10 * time.Minute
+	DomainDeletedTimeout               = 10 * time.Minute
+	FeatureGroupCreatedTimeout         = 10 * time.Minute
+	FeatureGroupDeletedTimeout         = 10 * time.Minute
+	FeatureGroupUpdatedTimeout         = 10 * time.Minute
+	UserProfileInServiceTimeout        = 10 * time.Minute
+	UserProfileDeletedTimeout          = 10 * time.Minute
+	AppInServiceTimeout                = 10 * time.Minute
+	AppDeletedTimeout                  = 10 * time.Minute
+	FlowDefinitionActiveTimeout        = 2 * time.Minute
+	FlowDefinitionDeletedTimeout       = 2 * time.Minute
+	ProjectCreatedTimeout              = 15 * time.Minute
+	ProjectDeletedTimeout              = 15 * time.Minute
+	WorkforceActiveTimeout             = 10 * time.Minute
+	WorkforceDeletedTimeout            = 10 * time.Minute
+	SpaceDeletedTimeout                = 10 * time.Minute
+	SpaceInServiceTimeout              = 10 * time.Minute
+	ModelCardDeletedTimeout            = 10 * time.Minute
+	MonitoringScheduleScheduledTimeout = 2 * time.Minute
+	MonitoringScheduleDeletedTimeout   = 2 * time.Minute
+	PipelineDeletedTimeout             = 10 * time.Minute
 )
 
 // WaitNotebookInstanceInService waits for a NotebookInstance to return InService
@@ -644,3 +649,110 @@ func WaitSpaceDeleted(ctx context.Context, conn *sagemaker.SageMaker, domainId,
 
 	return nil, err
 }
+
+func WaitModelCardDeleted(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeModelCardOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			sagemaker.ModelCardProcessingStatusDeletePending,
+			sagemaker.ModelCardProcessingStatusDeleteInProgress,
+			sagemaker.ModelCardProcessingStatusContentDeleted,
+			sagemaker.ModelCardProcessingStatusExportJobsDeleted,
+			sagemaker.ModelCardProcessingStatusDeleteCompleted,
+		},
+		Target:  []string{},
+		Refresh: StatusModelCardProcessing(ctx, conn, name),
+		Timeout: ModelCardDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeModelCardOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitMonitoringScheduleScheduled(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeMonitoringScheduleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.ScheduleStatusPending},
+		Target:  []string{sagemaker.ScheduleStatusScheduled},
+		Refresh: StatusMonitoringSchedule(ctx, conn, name),
+		Timeout: MonitoringScheduleScheduledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeMonitoringScheduleOutput); ok {
+		if status, reason := aws.StringValue(output.MonitoringScheduleStatus), aws.StringValue(output.FailureReason); status == sagemaker.ScheduleStatusFailed && reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitMonitoringScheduleDeleted(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeMonitoringScheduleOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.ScheduleStatusScheduled, sagemaker.ScheduleStatusPending, sagemaker.ScheduleStatusStopped},
+		Target:  []string{},
+		Refresh: StatusMonitoringSchedule(ctx, conn, name),
+		Timeout: MonitoringScheduleDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeMonitoringScheduleOutput); ok {
+		if status, reason := aws.StringValue(output.MonitoringScheduleStatus), aws.StringValue(output.FailureReason); status == sagemaker.ScheduleStatusFailed && reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitPipelineDeleted waits for a Pipeline to return Deleted
+func WaitPipelineDeleted(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribePipelineOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.PipelineStatusDeleting},
+		Target:  []string{},
+		Refresh: StatusPipeline(ctx, conn, name),
+		Timeout: PipelineDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribePipelineOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitFeatureGroupUpdated waits for a Feature Group update to complete
+func WaitFeatureGroupUpdated(ctx context.Context, conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeFeatureGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.LastUpdateStatusValueInProgress},
+		Target:  []string{sagemaker.LastUpdateStatusValueSuccessful},
+		Refresh: StatusFeatureGroupUpdate(ctx, conn, name),
+		Timeout: FeatureGroupUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sagemaker.DescribeFeatureGroupOutput); ok {
+		if output.LastUpdateStatus != nil {
+			if status, reason := aws.StringValue(output.LastUpdateStatus.Status), aws.StringValue(output.LastUpdateStatus.FailureReason); status == sagemaker.LastUpdateStatusValueFailed && reason != "" {
+				tfresource.SetLastError(err, errors.New(reason))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,41 @@
+package simspaceweaver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindSimulationByName(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string) (*simspaceweaver.DescribeSimulationOutput, error) {
+	in := &simspaceweaver.DescribeSimulationInput{
+		Simulation: aws.String(name),
+	}
+	out, err := conn.DescribeSimulationWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, simspaceweaver.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if status := aws.StringValue(out.Status); status == simspaceweaver.SimulationStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
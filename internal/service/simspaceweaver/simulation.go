@@ -0,0 +1,403 @@
+package simspaceweaver
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceSimulation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSimulationCreate,
+		ReadWithoutTimeout:   resourceSimulationRead,
+		UpdateWithoutTimeout: resourceSimulationUpdate,
+		DeleteWithoutTimeout: resourceSimulationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 500),
+			},
+			"execution_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"live_simulation_state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"clocks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"domains": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lifecycle": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"maximum_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{1,5}[mMhHdD]$`), "must be a number of minutes (m), hours (h) or days (d), e.g. 14D"),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 2048),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"schema_s3_location":   s3LocationSchema(),
+			"snapshot_s3_location": s3LocationSchema(),
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameSimulation = "Simulation"
+)
+
+func s3LocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"schema_s3_location", "snapshot_s3_location"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(3, 63),
+				},
+				"object_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func resourceSimulationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn()
+
+	name := d.Get("name").(string)
+	in := &simspaceweaver.StartSimulationInput{
+		Name:    aws.String(name),
+		RoleArn: aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maximum_duration"); ok {
+		in.MaximumDuration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("schema_s3_location"); ok {
+		in.SchemaS3Location = expandS3Location(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("snapshot_s3_location"); ok {
+		in.SnapshotS3Location = expandS3Location(v.([]interface{}))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.StartSimulationWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionCreating, ResNameSimulation, name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitSimulationStarted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionWaitingForCreation, ResNameSimulation, d.Id(), err)
+	}
+
+	return resourceSimulationRead(ctx, d, meta)
+}
+
+func resourceSimulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn()
+
+	out, err := FindSimulationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SimSpace Weaver Simulation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionReading, ResNameSimulation, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("creation_time", aws.TimeValue(out.CreationTime).Format(time.RFC3339))
+	d.Set("description", out.Description)
+	d.Set("execution_id", out.ExecutionId)
+
+	if err := d.Set("live_simulation_state", flattenLiveSimulationState(out.LiveSimulationState)); err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionSetting, ResNameSimulation, d.Id(), err)
+	}
+
+	d.Set("maximum_duration", out.MaximumDuration)
+	d.Set("name", out.Name)
+	d.Set("role_arn", out.RoleArn)
+
+	if err := d.Set("schema_s3_location", flattenS3Location(out.SchemaS3Location)); err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionSetting, ResNameSimulation, d.Id(), err)
+	}
+
+	if err := d.Set("snapshot_s3_location", flattenS3Location(out.SnapshotS3Location)); err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionSetting, ResNameSimulation, d.Id(), err)
+	}
+
+	d.Set("status", out.Status)
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionReading, ResNameSimulation, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionSetting, ResNameSimulation, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionSetting, ResNameSimulation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceSimulationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.SimSpaceWeaver, create.ErrActionUpdating, ResNameSimulation, d.Id(), err)
+		}
+	}
+
+	return resourceSimulationRead(ctx, d, meta)
+}
+
+func resourceSimulationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SimSpaceWeaverConn()
+
+	// A simulation must be stopped before it can be deleted.
+	out, err := FindSimulationByName(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionDeleting, ResNameSimulation, d.Id(), err)
+	}
+
+	if status := aws.StringValue(out.Status); status != simspaceweaver.SimulationStatusStopped && status != simspaceweaver.SimulationStatusFailed {
+		if status == simspaceweaver.SimulationStatusStarted {
+			log.Printf("[INFO] Stopping SimSpace Weaver Simulation %s", d.Id())
+
+			_, err := conn.StopSimulationWithContext(ctx, &simspaceweaver.StopSimulationInput{
+				Simulation: aws.String(d.Id()),
+			})
+
+			if tfawserr.ErrCodeEquals(err, simspaceweaver.ErrCodeResourceNotFoundException) {
+				return nil
+			}
+
+			if err != nil {
+				return create.DiagError(names.SimSpaceWeaver, create.ErrActionDeleting, ResNameSimulation, d.Id(), err)
+			}
+		}
+
+		if _, err := waitSimulationStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return create.DiagError(names.SimSpaceWeaver, create.ErrActionWaitingForDeletion, ResNameSimulation, d.Id(), err)
+		}
+	}
+
+	log.Printf("[INFO] Deleting SimSpace Weaver Simulation %s", d.Id())
+
+	_, err = conn.DeleteSimulationWithContext(ctx, &simspaceweaver.DeleteSimulationInput{
+		Simulation: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, simspaceweaver.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionDeleting, ResNameSimulation, d.Id(), err)
+	}
+
+	if _, err := waitSimulationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.SimSpaceWeaver, create.ErrActionWaitingForDeletion, ResNameSimulation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3Location(tfList []interface{}) *simspaceweaver.S3Location {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &simspaceweaver.S3Location{
+		BucketName: aws.String(tfMap["bucket_name"].(string)),
+		ObjectKey:  aws.String(tfMap["object_key"].(string)),
+	}
+}
+
+func flattenS3Location(apiObject *simspaceweaver.S3Location) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"bucket_name": aws.StringValue(apiObject.BucketName),
+			"object_key":  aws.StringValue(apiObject.ObjectKey),
+		},
+	}
+}
+
+func flattenLiveSimulationState(apiObject *simspaceweaver.LiveSimulationState) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var clocks []interface{}
+
+	for _, v := range apiObject.Clocks {
+		if v == nil {
+			continue
+		}
+
+		clocks = append(clocks, map[string]interface{}{
+			"status":        aws.StringValue(v.Status),
+			"target_status": aws.StringValue(v.TargetStatus),
+		})
+	}
+
+	var domains []interface{}
+
+	for _, v := range apiObject.Domains {
+		if v == nil {
+			continue
+		}
+
+		domains = append(domains, map[string]interface{}{
+			"lifecycle": aws.StringValue(v.Lifecycle),
+			"name":      aws.StringValue(v.Name),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"clocks":  clocks,
+			"domains": domains,
+		},
+	}
+}
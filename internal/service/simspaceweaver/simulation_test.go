@@ -0,0 +1,329 @@
+package simspaceweaver_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfsimspaceweaver "github.com/hashicorp/terraform-provider-aws/internal/service/simspaceweaver"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// A simulation can only be started from a schema that references app packages,
+// so the tests use a schema that has been uploaded ahead of time.
+func testAccSchemaS3Location(t *testing.T) (string, string) {
+	bucket := os.Getenv("SIMSPACEWEAVER_SCHEMA_S3_BUCKET")
+	key := os.Getenv("SIMSPACEWEAVER_SCHEMA_S3_KEY")
+
+	if bucket == "" || key == "" {
+		t.Skip("Environment variable SIMSPACEWEAVER_SCHEMA_S3_BUCKET or SIMSPACEWEAVER_SCHEMA_S3_KEY is not set")
+	}
+
+	return bucket, key
+}
+
+func TestAccSimSpaceWeaverSimulation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucket, key := testAccSchemaS3Location(t)
+	var simulation simspaceweaver.DescribeSimulationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SimSpaceWeaver, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, simspaceweaver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSimulationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationConfig_basic(rName, bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &simulation),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "simspaceweaver", regexp.MustCompile(`simulation/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "execution_id"),
+					resource.TestCheckResourceAttr(resourceName, "live_simulation_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_duration", "1H"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "schema_s3_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema_s3_location.0.bucket_name", bucket),
+					resource.TestCheckResourceAttr(resourceName, "schema_s3_location.0.object_key", key),
+					resource.TestCheckResourceAttr(resourceName, "status", simspaceweaver.SimulationStatusStarted),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"live_simulation_state"},
+			},
+		},
+	})
+}
+
+func TestAccSimSpaceWeaverSimulation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucket, key := testAccSchemaS3Location(t)
+	var simulation simspaceweaver.DescribeSimulationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SimSpaceWeaver, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, simspaceweaver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSimulationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationConfig_basic(rName, bucket, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &simulation),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsimspaceweaver.ResourceSimulation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSimSpaceWeaverSimulation_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucket, key := testAccSchemaS3Location(t)
+	var simulation simspaceweaver.DescribeSimulationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_simspaceweaver_simulation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SimSpaceWeaver, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, simspaceweaver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSimulationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationConfig_tags1(rName, bucket, key, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &simulation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"live_simulation_state"},
+			},
+			{
+				Config: testAccSimulationConfig_tags2(rName, bucket, key, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &simulation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSimulationConfig_tags1(rName, bucket, key, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSimulationExists(ctx, resourceName, &simulation),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSimulationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SimSpaceWeaverConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_simspaceweaver_simulation" {
+				continue
+			}
+
+			_, err := tfsimspaceweaver.FindSimulationByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.SimSpaceWeaver, create.ErrActionCheckingDestroyed, tfsimspaceweaver.ResNameSimulation, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSimulationExists(ctx context.Context, name string, simulation *simspaceweaver.DescribeSimulationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.SimSpaceWeaver, create.ErrActionCheckingExistence, tfsimspaceweaver.ResNameSimulation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SimSpaceWeaver, create.ErrActionCheckingExistence, tfsimspaceweaver.ResNameSimulation, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SimSpaceWeaverConn()
+
+		output, err := tfsimspaceweaver.FindSimulationByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.SimSpaceWeaver, create.ErrActionCheckingExistence, tfsimspaceweaver.ResNameSimulation, rs.Primary.ID, err)
+		}
+
+		*simulation = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SimSpaceWeaverConn()
+
+	input := &simspaceweaver.ListSimulationsInput{}
+	_, err := conn.ListSimulationsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccSimulationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "simspaceweaver.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:ListBucket",
+        "logs:CreateLogGroup",
+        "logs:CreateLogStream",
+        "logs:PutLogEvents",
+        "cloudwatch:PutMetricData",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccSimulationConfig_basic(rName, bucket, key string) string {
+	return acctest.ConfigCompose(testAccSimulationConfig_base(rName), fmt.Sprintf(`
+resource "aws_simspaceweaver_simulation" "test" {
+  name             = %[1]q
+  role_arn         = aws_iam_role.test.arn
+  maximum_duration = "1H"
+
+  schema_s3_location {
+    bucket_name = %[2]q
+    object_key  = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, bucket, key))
+}
+
+func testAccSimulationConfig_tags1(rName, bucket, key, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccSimulationConfig_base(rName), fmt.Sprintf(`
+resource "aws_simspaceweaver_simulation" "test" {
+  name             = %[1]q
+  role_arn         = aws_iam_role.test.arn
+  maximum_duration = "1H"
+
+  schema_s3_location {
+    bucket_name = %[2]q
+    object_key  = %[3]q
+  }
+
+  tags = {
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, bucket, key, tagKey1, tagValue1))
+}
+
+func testAccSimulationConfig_tags2(rName, bucket, key, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccSimulationConfig_base(rName), fmt.Sprintf(`
+resource "aws_simspaceweaver_simulation" "test" {
+  name             = %[1]q
+  role_arn         = aws_iam_role.test.arn
+  maximum_duration = "1H"
+
+  schema_s3_location {
+    bucket_name = %[2]q
+    object_key  = %[3]q
+  }
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, bucket, key, tagKey1, tagValue1, tagKey2, tagValue2))
+}
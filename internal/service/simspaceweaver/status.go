@@ -0,0 +1,26 @@
+package simspaceweaver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusSimulation(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindSimulationByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, aws.StringValue(out.Status), nil
+	}
+}
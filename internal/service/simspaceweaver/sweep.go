@@ -0,0 +1,70 @@
+//go:build sweep
+// +build sweep
+
+package simspaceweaver
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_simspaceweaver_simulation", &resource.Sweeper{
+		Name: "aws_simspaceweaver_simulation",
+		F:    sweepSimulations,
+	})
+}
+
+func sweepSimulations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SimSpaceWeaverConn()
+	input := &simspaceweaver.ListSimulationsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListSimulationsPagesWithContext(ctx, input, func(page *simspaceweaver.ListSimulationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Simulations {
+			if status := aws.StringValue(v.Status); status == simspaceweaver.SimulationStatusDeleting || status == simspaceweaver.SimulationStatusDeleted {
+				continue
+			}
+
+			r := ResourceSimulation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SimSpace Weaver Simulation sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing SimSpace Weaver Simulations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SimSpace Weaver Simulations (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package simspaceweaver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/simspaceweaver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitSimulationStarted(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{simspaceweaver.SimulationStatusStarting},
+		Target:  []string{simspaceweaver.SimulationStatusStarted},
+		Refresh: statusSimulation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		if v := aws.StringValue(out.StartError); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitSimulationStopped(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{simspaceweaver.SimulationStatusStarted, simspaceweaver.SimulationStatusStopping, simspaceweaver.SimulationStatusSnapshotInProgress},
+		Target:  []string{simspaceweaver.SimulationStatusStopped, simspaceweaver.SimulationStatusFailed},
+		Refresh: statusSimulation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitSimulationDeleted(ctx context.Context, conn *simspaceweaver.SimSpaceWeaver, name string, timeout time.Duration) (*simspaceweaver.DescribeSimulationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{simspaceweaver.SimulationStatusStopped, simspaceweaver.SimulationStatusFailed, simspaceweaver.SimulationStatusDeleting},
+		Target:  []string{},
+		Refresh: statusSimulation(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*simspaceweaver.DescribeSimulationOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
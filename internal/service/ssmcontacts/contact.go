@@ -0,0 +1,187 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceContact() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactCreate,
+		ReadWithoutTimeout:   resourceContactRead,
+		UpdateWithoutTimeout: resourceContactUpdate,
+		DeleteWithoutTimeout: resourceContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alias": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9_\-]{1,255}$`), "must be 1 to 255 lowercase alphanumeric characters, hyphens or underscores"),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{ssmcontacts.ContactTypePersonal, ssmcontacts.ContactTypeEscalation}, false),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameContact = "Contact"
+)
+
+func resourceContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	alias := d.Get("alias").(string)
+	in := &ssmcontacts.CreateContactInput{
+		Alias: aws.String(alias),
+		// The engagement plan is managed by the aws_ssmcontacts_plan resource.
+		Plan: &ssmcontacts.Plan{
+			Stages: []*ssmcontacts.Stage{},
+		},
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		in.DisplayName = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateContactWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionCreating, ResNameContact, alias, err)
+	}
+
+	d.SetId(aws.StringValue(out.ContactArn))
+
+	return resourceContactRead(ctx, d, meta)
+}
+
+func resourceContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	out, err := FindContactByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNameContact, d.Id(), err)
+	}
+
+	d.Set("alias", out.Alias)
+	d.Set("arn", out.ContactArn)
+	d.Set("display_name", out.DisplayName)
+	d.Set("type", out.Type)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNameContact, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionSetting, ResNameContact, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionSetting, ResNameContact, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	if d.HasChange("display_name") {
+		in := &ssmcontacts.UpdateContactInput{
+			ContactId:   aws.String(d.Id()),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating SSM Contacts Contact (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateContactWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.SSMContacts, create.ErrActionUpdating, ResNameContact, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.SSMContacts, create.ErrActionUpdating, ResNameContact, d.Id(), err)
+		}
+	}
+
+	return resourceContactRead(ctx, d, meta)
+}
+
+func resourceContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	log.Printf("[INFO] Deleting SSM Contacts Contact %s", d.Id())
+
+	_, err := conn.DeleteContactWithContext(ctx, &ssmcontacts.DeleteContactInput{
+		ContactId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionDeleting, ResNameContact, d.Id(), err)
+	}
+
+	return nil
+}
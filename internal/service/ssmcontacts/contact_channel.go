@@ -0,0 +1,196 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceContactChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContactChannelCreate,
+		ReadWithoutTimeout:   resourceContactChannelRead,
+		UpdateWithoutTimeout: resourceContactChannelUpdate,
+		DeleteWithoutTimeout: resourceContactChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"activation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"delivery_address": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 320),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssmcontacts.ChannelType_Values(), false),
+			},
+		},
+	}
+}
+
+const (
+	ResNameContactChannel = "Contact Channel"
+)
+
+func resourceContactChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	name := d.Get("name").(string)
+	in := &ssmcontacts.CreateContactChannelInput{
+		ContactId:       aws.String(d.Get("contact_id").(string)),
+		DeferActivation: aws.Bool(true),
+		DeliveryAddress: expandContactChannelAddress(d.Get("delivery_address").([]interface{})),
+		Name:            aws.String(name),
+		Type:            aws.String(d.Get("type").(string)),
+	}
+
+	out, err := conn.CreateContactChannelWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionCreating, ResNameContactChannel, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.ContactChannelArn))
+
+	return resourceContactChannelRead(ctx, d, meta)
+}
+
+func resourceContactChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	out, err := FindContactChannelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Contact Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNameContactChannel, d.Id(), err)
+	}
+
+	d.Set("activation_status", out.ActivationStatus)
+	d.Set("arn", out.ContactChannelArn)
+	d.Set("contact_id", out.ContactArn)
+
+	if err := d.Set("delivery_address", flattenContactChannelAddress(out.DeliveryAddress)); err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionSetting, ResNameContactChannel, d.Id(), err)
+	}
+
+	d.Set("name", out.Name)
+	d.Set("type", out.Type)
+
+	return nil
+}
+
+func resourceContactChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	in := &ssmcontacts.UpdateContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("delivery_address") {
+		in.DeliveryAddress = expandContactChannelAddress(d.Get("delivery_address").([]interface{}))
+	}
+
+	if d.HasChange("name") {
+		in.Name = aws.String(d.Get("name").(string))
+	}
+
+	log.Printf("[DEBUG] Updating SSM Contacts Contact Channel (%s): %#v", d.Id(), in)
+	_, err := conn.UpdateContactChannelWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionUpdating, ResNameContactChannel, d.Id(), err)
+	}
+
+	return resourceContactChannelRead(ctx, d, meta)
+}
+
+func resourceContactChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	log.Printf("[INFO] Deleting SSM Contacts Contact Channel %s", d.Id())
+
+	_, err := conn.DeleteContactChannelWithContext(ctx, &ssmcontacts.DeleteContactChannelInput{
+		ContactChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionDeleting, ResNameContactChannel, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandContactChannelAddress(tfList []interface{}) *ssmcontacts.ContactChannelAddress {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &ssmcontacts.ContactChannelAddress{
+		SimpleAddress: aws.String(tfMap["simple_address"].(string)),
+	}
+}
+
+func flattenContactChannelAddress(apiObject *ssmcontacts.ContactChannelAddress) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"simple_address": aws.StringValue(apiObject.SimpleAddress),
+		},
+	}
+}
@@ -0,0 +1,190 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccContactChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel ssmcontacts.GetContactChannelOutput
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+	contactResourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMContacts, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, "test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "activation_status", ssmcontacts.ActivationStatusNotActivated),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm-contacts", regexp.MustCompile(`contact-channel/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", contactResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", ssmcontacts.ChannelTypeEmail),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccContactChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel ssmcontacts.GetContactChannelOutput
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMContacts, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, "test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(ctx, resourceName, &channel),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssmcontacts.ResourceContactChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccContactChannel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel ssmcontacts.GetContactChannelOutput
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	rNameUpdated := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	resourceName := "aws_ssmcontacts_contact_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMContacts, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContactChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactChannelConfig_basic(rName, rName, "test@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccContactChannelConfig_basic(rName, rNameUpdated, "updated@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "delivery_address.0.simple_address", "updated@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContactChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmcontacts_contact_channel" {
+				continue
+			}
+
+			_, err := tfssmcontacts.FindContactChannelByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.SSMContacts, create.ErrActionCheckingDestroyed, tfssmcontacts.ResNameContactChannel, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContactChannelExists(ctx context.Context, name string, channel *ssmcontacts.GetContactChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameContactChannel, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameContactChannel, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn()
+
+		output, err := tfssmcontacts.FindContactChannelByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameContactChannel, rs.Primary.ID, err)
+		}
+
+		*channel = *output
+
+		return nil
+	}
+}
+
+func testAccContactChannelConfig_basic(alias, name, address string) string {
+	return acctest.ConfigCompose(testAccContactConfig_basic(alias), fmt.Sprintf(`
+resource "aws_ssmcontacts_contact_channel" "test" {
+  contact_id = aws_ssmcontacts_contact.test.arn
+  name       = %[1]q
+  type       = "EMAIL"
+
+  delivery_address {
+    simple_address = %[2]q
+  }
+}
+`, name, address))
+}
@@ -0,0 +1,57 @@
+package ssmcontacts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindContactByID(ctx context.Context, conn *ssmcontacts.SSMContacts, id string) (*ssmcontacts.GetContactOutput, error) {
+	in := &ssmcontacts.GetContactInput{
+		ContactId: aws.String(id),
+	}
+	out, err := conn.GetContactWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func FindContactChannelByID(ctx context.Context, conn *ssmcontacts.SSMContacts, id string) (*ssmcontacts.GetContactChannelOutput, error) {
+	in := &ssmcontacts.GetContactChannelInput{
+		ContactChannelId: aws.String(id),
+	}
+	out, err := conn.GetContactChannelWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
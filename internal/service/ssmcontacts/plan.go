@@ -0,0 +1,283 @@
+package ssmcontacts
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ResourcePlan manages the engagement plan of an existing contact.
+// The plan is part of the contact, so deleting this resource empties the plan rather than deleting anything.
+func ResourcePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePlanPut,
+		ReadWithoutTimeout:   resourcePlanRead,
+		UpdateWithoutTimeout: resourcePlanPut,
+		DeleteWithoutTimeout: resourcePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"contact_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"stage": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_minutes": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 30),
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_channel_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"retry_interval_in_minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(0, 60),
+												},
+											},
+										},
+									},
+									"contact_target_info": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contact_id": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"is_essential": {
+													Type:     schema.TypeBool,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNamePlan = "Plan"
+)
+
+func resourcePlanPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	contactID := d.Get("contact_id").(string)
+	in := &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(contactID),
+		Plan: &ssmcontacts.Plan{
+			Stages: expandStages(d.Get("stage").([]interface{})),
+		},
+	}
+
+	_, err := conn.UpdateContactWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionUpdating, ResNamePlan, contactID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(contactID)
+	}
+
+	return resourcePlanRead(ctx, d, meta)
+}
+
+func resourcePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	out, err := FindContactByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Contacts Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionReading, ResNamePlan, d.Id(), err)
+	}
+
+	d.Set("contact_id", out.ContactArn)
+
+	if err := d.Set("stage", flattenStages(out.Plan.Stages)); err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionSetting, ResNamePlan, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourcePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMContactsConn()
+
+	log.Printf("[INFO] Deleting SSM Contacts Plan %s", d.Id())
+
+	_, err := conn.UpdateContactWithContext(ctx, &ssmcontacts.UpdateContactInput{
+		ContactId: aws.String(d.Id()),
+		Plan: &ssmcontacts.Plan{
+			Stages: []*ssmcontacts.Stage{},
+		},
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssmcontacts.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMContacts, create.ErrActionDeleting, ResNamePlan, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandStages(tfList []interface{}) []*ssmcontacts.Stage {
+	apiObjects := []*ssmcontacts.Stage{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ssmcontacts.Stage{
+			DurationInMinutes: aws.Int64(int64(tfMap["duration_in_minutes"].(int))),
+			Targets:           expandTargets(tfMap["target"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTargets(tfList []interface{}) []*ssmcontacts.Target {
+	apiObjects := []*ssmcontacts.Target{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssmcontacts.Target{}
+
+		if v, ok := tfMap["channel_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.ChannelTargetInfo = &ssmcontacts.ChannelTargetInfo{
+				ContactChannelId: aws.String(m["contact_channel_id"].(string)),
+			}
+
+			if v, ok := m["retry_interval_in_minutes"].(int); ok && v != 0 {
+				apiObject.ChannelTargetInfo.RetryIntervalInMinutes = aws.Int64(int64(v))
+			}
+		}
+
+		if v, ok := tfMap["contact_target_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			apiObject.ContactTargetInfo = &ssmcontacts.ContactTargetInfo{
+				IsEssential: aws.Bool(m["is_essential"].(bool)),
+			}
+
+			if v, ok := m["contact_id"].(string); ok && v != "" {
+				apiObject.ContactTargetInfo.ContactId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenStages(apiObjects []*ssmcontacts.Stage) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"duration_in_minutes": aws.Int64Value(apiObject.DurationInMinutes),
+			"target":              flattenTargets(apiObject.Targets),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTargets(apiObjects []*ssmcontacts.Target) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.ChannelTargetInfo; v != nil {
+			tfMap["channel_target_info"] = []interface{}{
+				map[string]interface{}{
+					"contact_channel_id":        aws.StringValue(v.ContactChannelId),
+					"retry_interval_in_minutes": aws.Int64Value(v.RetryIntervalInMinutes),
+				},
+			}
+		}
+
+		if v := apiObject.ContactTargetInfo; v != nil {
+			tfMap["contact_target_info"] = []interface{}{
+				map[string]interface{}{
+					"contact_id":   aws.StringValue(v.ContactId),
+					"is_essential": aws.BoolValue(v.IsEssential),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,166 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	resourceName := "aws_ssmcontacts_plan.test"
+	contactResourceName := "aws_ssmcontacts_contact.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMContacts, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "contact_id", contactResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "stage.0.target.0.channel_target_info.0.contact_channel_id", "aws_ssmcontacts_contact_channel.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.target.0.channel_target_info.0.retry_interval_in_minutes", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPlan_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandStringFromCharSet(10, sdkacctest.CharSetAlpha)
+	resourceName := "aws_ssmcontacts_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMContacts, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ssmcontacts.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlanConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "1"),
+				),
+			},
+			{
+				Config: testAccPlanConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.duration_in_minutes", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmcontacts_plan" {
+				continue
+			}
+
+			output, err := tfssmcontacts.FindContactByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.Plan == nil || len(output.Plan.Stages) == 0 {
+				continue
+			}
+
+			return create.Error(names.SSMContacts, create.ErrActionCheckingDestroyed, tfssmcontacts.ResNamePlan, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPlanExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNamePlan, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNamePlan, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn()
+
+		output, err := tfssmcontacts.FindContactByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNamePlan, rs.Primary.ID, err)
+		}
+
+		if output.Plan == nil || len(output.Plan.Stages) == 0 {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNamePlan, rs.Primary.ID, errors.New("empty plan"))
+		}
+
+		return nil
+	}
+}
+
+func testAccPlanConfig_basic(rName string, duration int) string {
+	return acctest.ConfigCompose(testAccContactChannelConfig_basic(rName, rName, "test@example.com"), fmt.Sprintf(`
+resource "aws_ssmcontacts_plan" "test" {
+  contact_id = aws_ssmcontacts_contact.test.arn
+
+  stage {
+    duration_in_minutes = %[1]d
+
+    target {
+      channel_target_info {
+        contact_channel_id        = aws_ssmcontacts_contact_channel.test.arn
+        retry_interval_in_minutes = 5
+      }
+    }
+  }
+}
+`, duration))
+}
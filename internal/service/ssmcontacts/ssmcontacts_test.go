@@ -0,0 +1,62 @@
+package ssmcontacts_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Contacts can only be created once an Incident Manager replication set exists,
+// and an account can only have one replication set, so all tests run serially.
+func TestAccSSMContacts_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Contact": {
+			"basic":      testAccContact_basic,
+			"disappears": testAccContact_disappears,
+			"update":     testAccContact_update,
+			"tags":       testAccContact_tags,
+		},
+		"ContactChannel": {
+			"basic":      testAccContactChannel_basic,
+			"disappears": testAccContactChannel_disappears,
+			"update":     testAccContactChannel_update,
+		},
+		"Plan": {
+			"basic":  testAccPlan_basic,
+			"update": testAccPlan_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsConn()
+
+	input := &ssmcontacts.ListContactsInput{}
+	_, err := conn.ListContactsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccConfig_base() string {
+	return fmt.Sprintf(`
+resource "aws_ssmincidents_replication_set" "test" {
+  region {
+    name = %[1]q
+  }
+}
+`, acctest.Region())
+}
@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package ssmcontacts
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssmcontacts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ssmcontacts_contact", &resource.Sweeper{
+		Name: "aws_ssmcontacts_contact",
+		F:    sweepContacts,
+	})
+}
+
+func sweepContacts(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SSMContactsConn()
+	input := &ssmcontacts.ListContactsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListContactsPagesWithContext(ctx, input, func(page *ssmcontacts.ListContactsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contacts {
+			r := ResourceContact()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ContactArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SSM Contacts Contact sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing SSM Contacts Contacts (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SSM Contacts Contacts (%s): %w", region, err)
+	}
+
+	return nil
+}
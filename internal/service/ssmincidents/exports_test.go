@@ -0,0 +1,7 @@
+package ssmincidents
+
+// Exports for use in tests only.
+var (
+	FindReplicationSetByARN = findReplicationSetByARN
+	FindResponsePlanByARN   = findResponsePlanByARN
+)
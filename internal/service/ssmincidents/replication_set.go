@@ -0,0 +1,324 @@
+package ssmincidents
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// defaultKMSKey is the key identifier the service uses for an AWS owned key.
+	defaultKMSKey = "DefaultKey"
+)
+
+func ResourceReplicationSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReplicationSetCreate,
+		ReadWithoutTimeout:   resourceReplicationSetRead,
+		UpdateWithoutTimeout: resourceReplicationSetUpdate,
+		DeleteWithoutTimeout: resourceReplicationSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deletion_protected": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      replicationSetRegionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_arn": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  defaultKMSKey,
+							ValidateFunc: validation.Any(
+								verify.ValidARN,
+								validation.StringInSlice([]string{defaultKMSKey}, false),
+							),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidRegionName,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameReplicationSet = "Replication Set"
+)
+
+func resourceReplicationSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	in := &ssmincidents.CreateReplicationSetInput{
+		Regions: expandRegionMapInputValues(d.Get("region").(*schema.Set).List()),
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateReplicationSet(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionCreating, ResNameReplicationSet, "", err)
+	}
+
+	if out == nil || out.Arn == nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionCreating, ResNameReplicationSet, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Arn))
+
+	if _, err := waitReplicationSetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionWaitingForCreation, ResNameReplicationSet, d.Id(), err)
+	}
+
+	return resourceReplicationSetRead(ctx, d, meta)
+}
+
+func resourceReplicationSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	out, err := findReplicationSetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incidents Replication Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionReading, ResNameReplicationSet, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+	d.Set("created_by", out.CreatedBy)
+	d.Set("deletion_protected", out.DeletionProtected)
+	d.Set("last_modified_by", out.LastModifiedBy)
+
+	if err := d.Set("region", flattenRegionInfos(out.RegionMap)); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameReplicationSet, d.Id(), err)
+	}
+
+	d.Set("status", out.Status)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionReading, ResNameReplicationSet, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameReplicationSet, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameReplicationSet, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceReplicationSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	if d.HasChange("region") {
+		o, n := d.GetChange("region")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// The service accepts one region change per request, and a region's key can only be changed by
+		// removing and re-adding the region, so removals are applied before additions.
+		var actions []types.UpdateReplicationSetAction
+
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			actions = append(actions, &types.UpdateReplicationSetActionMemberDeleteRegionAction{
+				Value: types.DeleteRegionAction{
+					RegionName: aws.String(tfMap["name"].(string)),
+				},
+			})
+		}
+
+		for _, tfMapRaw := range ns.Difference(os).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			action := types.AddRegionAction{
+				RegionName: aws.String(tfMap["name"].(string)),
+			}
+
+			if v := tfMap["kms_key_arn"].(string); v != "" && v != defaultKMSKey {
+				action.SseKmsKeyId = aws.String(v)
+			}
+
+			actions = append(actions, &types.UpdateReplicationSetActionMemberAddRegionAction{
+				Value: action,
+			})
+		}
+
+		for _, action := range actions {
+			in := &ssmincidents.UpdateReplicationSetInput{
+				Actions: []types.UpdateReplicationSetAction{action},
+				Arn:     aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Updating SSM Incidents Replication Set (%s): %#v", d.Id(), in)
+			if _, err := conn.UpdateReplicationSet(ctx, in); err != nil {
+				return create.DiagError(names.SSMIncidents, create.ErrActionUpdating, ResNameReplicationSet, d.Id(), err)
+			}
+
+			if _, err := waitReplicationSetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.DiagError(names.SSMIncidents, create.ErrActionWaitingForUpdate, ResNameReplicationSet, d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.SSMIncidents, create.ErrActionUpdating, ResNameReplicationSet, d.Id(), err)
+		}
+	}
+
+	return resourceReplicationSetRead(ctx, d, meta)
+}
+
+func resourceReplicationSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	log.Printf("[INFO] Deleting SSM Incidents Replication Set %s", d.Id())
+
+	_, err := conn.DeleteReplicationSet(ctx, &ssmincidents.DeleteReplicationSetInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.SSMIncidents, create.ErrActionDeleting, ResNameReplicationSet, d.Id(), err)
+	}
+
+	if _, err := waitReplicationSetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionWaitingForDeletion, ResNameReplicationSet, d.Id(), err)
+	}
+
+	return nil
+}
+
+func replicationSetRegionHash(v interface{}) int {
+	tfMap := v.(map[string]interface{})
+
+	return create.StringHashcode(fmt.Sprintf("%s-%s", tfMap["name"].(string), tfMap["kms_key_arn"].(string)))
+}
+
+func expandRegionMapInputValues(tfList []interface{}) map[string]types.RegionMapInputValue {
+	apiObject := make(map[string]types.RegionMapInputValue)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		v := types.RegionMapInputValue{}
+
+		if kmsKeyARN := tfMap["kms_key_arn"].(string); kmsKeyARN != "" && kmsKeyARN != defaultKMSKey {
+			v.SseKmsKeyId = aws.String(kmsKeyARN)
+		}
+
+		apiObject[tfMap["name"].(string)] = v
+	}
+
+	return apiObject
+}
+
+func flattenRegionInfos(apiObject map[string]types.RegionInfo) []interface{} {
+	var tfList []interface{}
+
+	for name, v := range apiObject {
+		kmsKeyARN := aws.ToString(v.SseKmsKeyId)
+		if kmsKeyARN == "" {
+			kmsKeyARN = defaultKMSKey
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"kms_key_arn":    kmsKeyARN,
+			"name":           name,
+			"status":         string(v.Status),
+			"status_message": aws.ToString(v.StatusMessage),
+		})
+	}
+
+	return tfList
+}
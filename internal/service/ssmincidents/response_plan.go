@@ -0,0 +1,636 @@
+package ssmincidents
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceResponsePlan() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResponsePlanCreate,
+		ReadWithoutTimeout:   resourceResponsePlanRead,
+		UpdateWithoutTimeout: resourceResponsePlanUpdate,
+		DeleteWithoutTimeout: resourceResponsePlanDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssm_automation": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"document_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"document_version": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"dynamic_parameters": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(enum.Values[types.VariableType](), false),
+										},
+									},
+									"parameter": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"values": {
+													Type:     schema.TypeSet,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"target_account": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.SsmTargetAccount](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chat_channel": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"engagements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"incident_template": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dedupe_string": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+						"impact": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+						"incident_tags": tftags.TagsSchema(),
+						"notification_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sns_topic_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"summary": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 4000),
+						},
+						"title": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+			"integration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pagerduty": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"secret_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"service_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]{1,200}$`), "must be 1 to 200 alphanumeric characters, hyphens or underscores"),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameResponsePlan = "Response Plan"
+)
+
+func resourceResponsePlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	name := d.Get("name").(string)
+	in := &ssmincidents.CreateResponsePlanInput{
+		Actions:          expandActions(d.Get("action").([]interface{})),
+		ChatChannel:      expandChatChannel(d.Get("chat_channel").(*schema.Set)),
+		Engagements:      flex.ExpandStringValueSet(d.Get("engagements").(*schema.Set)),
+		IncidentTemplate: expandIncidentTemplate(d.Get("incident_template").([]interface{})),
+		Integrations:     expandIntegrations(d.Get("integration").([]interface{})),
+		Name:             aws.String(name),
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		in.DisplayName = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateResponsePlan(ctx, in)
+	if err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionCreating, ResNameResponsePlan, name, err)
+	}
+
+	if out == nil || out.Arn == nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionCreating, ResNameResponsePlan, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Arn))
+
+	return resourceResponsePlanRead(ctx, d, meta)
+}
+
+func resourceResponsePlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	out, err := findResponsePlanByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Incidents Response Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionReading, ResNameResponsePlan, d.Id(), err)
+	}
+
+	if err := d.Set("action", flattenActions(out.Actions)); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameResponsePlan, d.Id(), err)
+	}
+
+	d.Set("arn", out.Arn)
+
+	if err := d.Set("chat_channel", flattenChatChannel(out.ChatChannel)); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameResponsePlan, d.Id(), err)
+	}
+
+	d.Set("display_name", out.DisplayName)
+	d.Set("engagements", out.Engagements)
+
+	if err := d.Set("incident_template", flattenIncidentTemplate(out.IncidentTemplate)); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameResponsePlan, d.Id(), err)
+	}
+
+	if err := d.Set("integration", flattenIntegrations(out.Integrations)); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameResponsePlan, d.Id(), err)
+	}
+
+	d.Set("name", out.Name)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionReading, ResNameResponsePlan, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameResponsePlan, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.SSMIncidents, create.ErrActionSetting, ResNameResponsePlan, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		in := &ssmincidents.UpdateResponsePlanInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("action") {
+			in.Actions = expandActions(d.Get("action").([]interface{}))
+		}
+
+		if d.HasChange("chat_channel") {
+			in.ChatChannel = expandChatChannel(d.Get("chat_channel").(*schema.Set))
+		}
+
+		if d.HasChange("display_name") {
+			in.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("engagements") {
+			in.Engagements = flex.ExpandStringValueSet(d.Get("engagements").(*schema.Set))
+		}
+
+		if d.HasChange("incident_template") {
+			template := expandIncidentTemplate(d.Get("incident_template").([]interface{}))
+
+			in.IncidentTemplateDedupeString = template.DedupeString
+			in.IncidentTemplateImpact = template.Impact
+			in.IncidentTemplateNotificationTargets = template.NotificationTargets
+			in.IncidentTemplateSummary = template.Summary
+			in.IncidentTemplateTags = template.IncidentTags
+			in.IncidentTemplateTitle = template.Title
+
+			// An empty list or map is needed to clear these on update.
+			if in.IncidentTemplateNotificationTargets == nil {
+				in.IncidentTemplateNotificationTargets = []types.NotificationTargetItem{}
+			}
+
+			if in.IncidentTemplateTags == nil {
+				in.IncidentTemplateTags = map[string]string{}
+			}
+		}
+
+		if d.HasChange("integration") {
+			in.Integrations = expandIntegrations(d.Get("integration").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating SSM Incidents Response Plan (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateResponsePlan(ctx, in)
+		if err != nil {
+			return create.DiagError(names.SSMIncidents, create.ErrActionUpdating, ResNameResponsePlan, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.SSMIncidents, create.ErrActionUpdating, ResNameResponsePlan, d.Id(), err)
+		}
+	}
+
+	return resourceResponsePlanRead(ctx, d, meta)
+}
+
+func resourceResponsePlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSMIncidentsClient()
+
+	log.Printf("[INFO] Deleting SSM Incidents Response Plan %s", d.Id())
+
+	_, err := conn.DeleteResponsePlan(ctx, &ssmincidents.DeleteResponsePlanInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+
+		return create.DiagError(names.SSMIncidents, create.ErrActionDeleting, ResNameResponsePlan, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIncidentTemplate(tfList []interface{}) *types.IncidentTemplate {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &types.IncidentTemplate{
+		Impact: aws.Int32(int32(tfMap["impact"].(int))),
+		Title:  aws.String(tfMap["title"].(string)),
+	}
+
+	if v, ok := tfMap["dedupe_string"].(string); ok && v != "" {
+		apiObject.DedupeString = aws.String(v)
+	}
+
+	if v, ok := tfMap["incident_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.IncidentTags = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["notification_target"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			m := tfMapRaw.(map[string]interface{})
+			apiObject.NotificationTargets = append(apiObject.NotificationTargets, &types.NotificationTargetItemMemberSnsTopicArn{
+				Value: m["sns_topic_arn"].(string),
+			})
+		}
+	}
+
+	if v, ok := tfMap["summary"].(string); ok && v != "" {
+		apiObject.Summary = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIncidentTemplate(apiObject *types.IncidentTemplate) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var notificationTargets []interface{}
+
+	for _, v := range apiObject.NotificationTargets {
+		if v, ok := v.(*types.NotificationTargetItemMemberSnsTopicArn); ok {
+			notificationTargets = append(notificationTargets, map[string]interface{}{
+				"sns_topic_arn": v.Value,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"dedupe_string":       aws.ToString(apiObject.DedupeString),
+			"impact":              aws.ToInt32(apiObject.Impact),
+			"incident_tags":       apiObject.IncidentTags,
+			"notification_target": notificationTargets,
+			"summary":             aws.ToString(apiObject.Summary),
+			"title":               aws.ToString(apiObject.Title),
+		},
+	}
+}
+
+func expandChatChannel(tfSet *schema.Set) types.ChatChannel {
+	if tfSet.Len() == 0 {
+		return &types.ChatChannelMemberEmpty{
+			Value: types.EmptyChatChannel{},
+		}
+	}
+
+	return &types.ChatChannelMemberChatbotSns{
+		Value: flex.ExpandStringValueSet(tfSet),
+	}
+}
+
+func flattenChatChannel(apiObject types.ChatChannel) []string {
+	if v, ok := apiObject.(*types.ChatChannelMemberChatbotSns); ok {
+		return v.Value
+	}
+
+	return nil
+}
+
+func expandActions(tfList []interface{}) []types.Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return []types.Action{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObjects := []types.Action{}
+
+	for _, tfMapRaw := range tfMap["ssm_automation"].([]interface{}) {
+		m, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.SsmAutomation{
+			DocumentName: aws.String(m["document_name"].(string)),
+			RoleArn:      aws.String(m["role_arn"].(string)),
+		}
+
+		if v, ok := m["document_version"].(string); ok && v != "" {
+			apiObject.DocumentVersion = aws.String(v)
+		}
+
+		if v, ok := m["dynamic_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DynamicParameters = make(map[string]types.DynamicSsmParameterValue, len(v))
+
+			for k, v := range v {
+				apiObject.DynamicParameters[k] = &types.DynamicSsmParameterValueMemberVariable{
+					Value: types.VariableType(v.(string)),
+				}
+			}
+		}
+
+		if v, ok := m["parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Parameters = make(map[string][]string, v.Len())
+
+			for _, tfMapRaw := range v.List() {
+				p := tfMapRaw.(map[string]interface{})
+				apiObject.Parameters[p["name"].(string)] = flex.ExpandStringValueSet(p["values"].(*schema.Set))
+			}
+		}
+
+		if v, ok := m["target_account"].(string); ok && v != "" {
+			apiObject.TargetAccount = types.SsmTargetAccount(v)
+		}
+
+		apiObjects = append(apiObjects, &types.ActionMemberSsmAutomation{
+			Value: apiObject,
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenActions(apiObjects []types.Action) []interface{} {
+	var ssmAutomations []interface{}
+
+	for _, apiObject := range apiObjects {
+		v, ok := apiObject.(*types.ActionMemberSsmAutomation)
+		if !ok {
+			continue
+		}
+
+		dynamicParameters := make(map[string]interface{}, len(v.Value.DynamicParameters))
+		for k, v := range v.Value.DynamicParameters {
+			if v, ok := v.(*types.DynamicSsmParameterValueMemberVariable); ok {
+				dynamicParameters[k] = string(v.Value)
+			}
+		}
+
+		var parameters []interface{}
+		for k, v := range v.Value.Parameters {
+			parameters = append(parameters, map[string]interface{}{
+				"name":   k,
+				"values": v,
+			})
+		}
+
+		ssmAutomations = append(ssmAutomations, map[string]interface{}{
+			"document_name":      aws.ToString(v.Value.DocumentName),
+			"document_version":   aws.ToString(v.Value.DocumentVersion),
+			"dynamic_parameters": dynamicParameters,
+			"parameter":          parameters,
+			"role_arn":           aws.ToString(v.Value.RoleArn),
+			"target_account":     string(v.Value.TargetAccount),
+		})
+	}
+
+	if len(ssmAutomations) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ssm_automation": ssmAutomations,
+		},
+	}
+}
+
+func expandIntegrations(tfList []interface{}) []types.Integration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return []types.Integration{}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObjects := []types.Integration{}
+
+	for _, tfMapRaw := range tfMap["pagerduty"].([]interface{}) {
+		m, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &types.IntegrationMemberPagerDutyConfiguration{
+			Value: types.PagerDutyConfiguration{
+				Name: aws.String(m["name"].(string)),
+				PagerDutyIncidentConfiguration: &types.PagerDutyIncidentConfiguration{
+					ServiceId: aws.String(m["service_id"].(string)),
+				},
+				SecretId: aws.String(m["secret_id"].(string)),
+			},
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenIntegrations(apiObjects []types.Integration) []interface{} {
+	var pagerDutyConfigurations []interface{}
+
+	for _, apiObject := range apiObjects {
+		v, ok := apiObject.(*types.IntegrationMemberPagerDutyConfiguration)
+		if !ok {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":      aws.ToString(v.Value.Name),
+			"secret_id": aws.ToString(v.Value.SecretId),
+		}
+
+		if v.Value.PagerDutyIncidentConfiguration != nil {
+			tfMap["service_id"] = aws.ToString(v.Value.PagerDutyIncidentConfiguration.ServiceId)
+		}
+
+		pagerDutyConfigurations = append(pagerDutyConfigurations, tfMap)
+	}
+
+	if len(pagerDutyConfigurations) == 0 {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"pagerduty": pagerDutyConfigurations,
+		},
+	}
+}
@@ -0,0 +1,263 @@
+package ssmincidents_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssmincidents "github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccResponsePlan_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMIncidentsEndpointID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName, "title", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "0"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ssm-incidents", regexp.MustCompile(`response-plan/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "engagements.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "3"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", "title"),
+					resource.TestCheckResourceAttr(resourceName, "integration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMIncidentsEndpointID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName, "title", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssmincidents.ResourceResponsePlan(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResponsePlan_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmincidents_response_plan.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.SSMIncidentsEndpointID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResponsePlanConfig_basic(rName, "title", 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "3"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", "title"),
+				),
+			},
+			{
+				Config: testAccResponsePlanConfig_full(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.document_name", "AWSIncidents-CriticalIncidentRunbookTemplate"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.dynamic_parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.ssm_automation.0.target_account", "RESPONSE_PLAN_OWNER_ACCOUNT"),
+					resource.TestCheckResourceAttr(resourceName, "chat_channel.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "display"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.dedupe_string", "dedupe"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.impact", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.notification_target.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.summary", "summary"),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.title", "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResponsePlanDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsClient()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmincidents_response_plan" {
+				continue
+			}
+
+			_, err := tfssmincidents.FindResponsePlanByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.SSMIncidents, create.ErrActionCheckingDestroyed, tfssmincidents.ResNameResponsePlan, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResponsePlanExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return create.Error(names.SSMIncidents, create.ErrActionCheckingExistence, tfssmincidents.ResNameResponsePlan, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSMIncidents, create.ErrActionCheckingExistence, tfssmincidents.ResNameResponsePlan, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsClient()
+
+		_, err := tfssmincidents.FindResponsePlanByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.SSMIncidents, create.ErrActionCheckingExistence, tfssmincidents.ResNameResponsePlan, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccResponsePlanConfig_basic(rName, title string, impact int) string {
+	return acctest.ConfigCompose(testAccReplicationSetConfig_basic(), fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[2]q
+    impact = %[3]d
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName, title, impact))
+}
+
+func testAccResponsePlanConfig_full(rName string) string {
+	return acctest.ConfigCompose(testAccReplicationSetConfig_basic(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ssm-incidents.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_ssmincidents_response_plan" "test" {
+  name         = %[1]q
+  display_name = "display"
+  chat_channel = [aws_sns_topic.test.arn]
+
+  incident_template {
+    title         = "updated"
+    impact        = 1
+    dedupe_string = "dedupe"
+    summary       = "summary"
+
+    incident_tags = {
+      key = "value"
+    }
+
+    notification_target {
+      sns_topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  action {
+    ssm_automation {
+      document_name  = "AWSIncidents-CriticalIncidentRunbookTemplate"
+      role_arn       = aws_iam_role.test.arn
+      target_account = "RESPONSE_PLAN_OWNER_ACCOUNT"
+
+      dynamic_parameters = {
+        incidentARN = "INCIDENT_RECORD_ARN"
+      }
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test]
+}
+`, rName))
+}
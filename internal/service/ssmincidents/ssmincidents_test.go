@@ -0,0 +1,45 @@
+package ssmincidents_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// An account can only have one replication set, and response plans require one, so all tests run serially.
+func TestAccSSMIncidents_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"ReplicationSet": {
+			"basic":      testAccReplicationSet_basic,
+			"disappears": testAccReplicationSet_disappears,
+			"tags":       testAccReplicationSet_tags,
+		},
+		"ResponsePlan": {
+			"basic":      testAccResponsePlan_basic,
+			"disappears": testAccResponsePlan_disappears,
+			"update":     testAccResponsePlan_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMIncidentsClient()
+
+	input := &ssmincidents.ListReplicationSetsInput{}
+	_, err := conn.ListReplicationSets(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
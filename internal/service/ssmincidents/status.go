@@ -0,0 +1,24 @@
+package ssmincidents
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusReplicationSet(ctx context.Context, conn *ssmincidents.Client, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findReplicationSetByARN(ctx, conn, arn)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
@@ -0,0 +1,118 @@
+//go:build sweep
+// +build sweep
+
+package ssmincidents
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_ssmincidents_replication_set", &resource.Sweeper{
+		Name: "aws_ssmincidents_replication_set",
+		F:    sweepReplicationSets,
+		Dependencies: []string{
+			"aws_ssmcontacts_contact",
+			"aws_ssmincidents_response_plan",
+		},
+	})
+
+	resource.AddTestSweepers("aws_ssmincidents_response_plan", &resource.Sweeper{
+		Name: "aws_ssmincidents_response_plan",
+		F:    sweepResponsePlans,
+	})
+}
+
+func sweepReplicationSets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SSMIncidentsClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := ssmincidents.NewListReplicationSetsPaginator(conn, &ssmincidents.ListReplicationSetsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SSM Incidents Replication Set sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing SSM Incidents Replication Sets for %s: %w", region, err))
+			break
+		}
+
+		for _, arn := range page.ReplicationSetArns {
+			r := ResourceReplicationSet()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping SSM Incidents Replication Sets for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepResponsePlans(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SSMIncidentsClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := ssmincidents.NewListResponsePlansPaginator(conn, &ssmincidents.ListResponsePlansInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping SSM Incidents Response Plan sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing SSM Incidents Response Plans for %s: %w", region, err))
+			break
+		}
+
+		for _, v := range page.ResponsePlanSummaries {
+			r := ResourceResponsePlan()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping SSM Incidents Response Plans for %s: %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,42 @@
+package ssmincidents
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitReplicationSetActive(ctx context.Context, conn *ssmincidents.Client, arn string, timeout time.Duration) (*types.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.ReplicationSetStatusCreating), string(types.ReplicationSetStatusUpdating)},
+		Target:  []string{string(types.ReplicationSetStatusActive)},
+		Refresh: statusReplicationSet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.ReplicationSet); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationSetDeleted(ctx context.Context, conn *ssmincidents.Client, arn string, timeout time.Duration) (*types.ReplicationSet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(types.ReplicationSetStatusDeleting), string(types.ReplicationSetStatusActive)},
+		Target:  []string{},
+		Refresh: statusReplicationSet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*types.ReplicationSet); ok {
+		return out, err
+	}
+
+	return nil, err
+}
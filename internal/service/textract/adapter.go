@@ -0,0 +1,247 @@
+package textract
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAdapter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAdapterCreate,
+		ReadWithoutTimeout:   resourceAdapterRead,
+		UpdateWithoutTimeout: resourceAdapterUpdate,
+		DeleteWithoutTimeout: resourceAdapterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"adapter_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"adapter_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_update": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(textract.AutoUpdate_Values(), false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"feature_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(textract.FeatureType_Values(), false),
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAdapterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("adapter_name").(string)
+	input := &textract.CreateAdapterInput{
+		AdapterName:  aws.String(name),
+		FeatureTypes: flex.ExpandStringSet(d.Get("feature_types").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("auto_update"); ok {
+		input.AutoUpdate = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAdapterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Textract Adapter (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AdapterId))
+
+	return append(diags, resourceAdapterRead(ctx, d, meta)...)
+}
+
+func resourceAdapterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAdapterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Textract Adapter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Textract Adapter (%s): %s", d.Id(), err)
+	}
+
+	arn := adapterARN(meta.(*conns.AWSClient), d.Id())
+	d.Set("adapter_id", output.AdapterId)
+	d.Set("adapter_name", output.AdapterName)
+	d.Set("arn", arn)
+	d.Set("auto_update", output.AutoUpdate)
+	d.Set("description", output.Description)
+	d.Set("feature_types", aws.StringValueSlice(output.FeatureTypes))
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Textract Adapter (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAdapterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &textract.UpdateAdapterInput{
+			AdapterId:   aws.String(d.Id()),
+			AdapterName: aws.String(d.Get("adapter_name").(string)),
+		}
+
+		if v, ok := d.GetOk("auto_update"); ok {
+			input.AutoUpdate = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAdapterWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Textract Adapter (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Textract Adapter (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAdapterRead(ctx, d, meta)...)
+}
+
+func resourceAdapterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+
+	log.Printf("[DEBUG] Deleting Textract Adapter: %s", d.Id())
+	_, err := conn.DeleteAdapterWithContext(ctx, &textract.DeleteAdapterInput{
+		AdapterId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, textract.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Textract Adapter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func adapterARN(client *conns.AWSClient, id string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Region:    client.Region,
+		Service:   "textract",
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("/adapters/%s", id),
+	}.String()
+}
+
+func FindAdapterByID(ctx context.Context, conn *textract.Textract, id string) (*textract.GetAdapterOutput, error) {
+	input := &textract.GetAdapterInput{
+		AdapterId: aws.String(id),
+	}
+
+	output, err := conn.GetAdapterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, textract.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
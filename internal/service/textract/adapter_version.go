@@ -0,0 +1,431 @@
+package textract
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAdapterVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAdapterVersionCreate,
+		ReadWithoutTimeout:   resourceAdapterVersionRead,
+		UpdateWithoutTimeout: resourceAdapterVersionUpdate,
+		DeleteWithoutTimeout: resourceAdapterVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"adapter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"adapter_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dataset_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"manifest_s3_object": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"feature_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"s3_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAdapterVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	adapterID := d.Get("adapter_id").(string)
+	input := &textract.CreateAdapterVersionInput{
+		AdapterId:     aws.String(adapterID),
+		DatasetConfig: expandAdapterVersionDatasetConfig(d.Get("dataset_config").([]interface{})),
+		OutputConfig:  expandOutputConfig(d.Get("output_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KMSKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAdapterVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Textract Adapter Version (%s): %s", adapterID, err)
+	}
+
+	d.SetId(AdapterVersionCreateResourceID(adapterID, aws.StringValue(output.AdapterVersion)))
+
+	if _, err := waitAdapterVersionActive(ctx, conn, adapterID, aws.StringValue(output.AdapterVersion), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Textract Adapter Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAdapterVersionRead(ctx, d, meta)...)
+}
+
+func resourceAdapterVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	adapterID, adapterVersion, err := AdapterVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindAdapterVersionByTwoPartKey(ctx, conn, adapterID, adapterVersion)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Textract Adapter Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Textract Adapter Version (%s): %s", d.Id(), err)
+	}
+
+	arn := adapterVersionARN(meta.(*conns.AWSClient), adapterID, adapterVersion)
+	d.Set("adapter_id", output.AdapterId)
+	d.Set("adapter_version", output.AdapterVersion)
+	d.Set("arn", arn)
+	if err := d.Set("dataset_config", flattenAdapterVersionDatasetConfig(output.DatasetConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dataset_config: %s", err)
+	}
+	d.Set("feature_types", aws.StringValueSlice(output.FeatureTypes))
+	d.Set("kms_key_id", output.KMSKeyId)
+	if err := d.Set("output_config", flattenOutputConfig(output.OutputConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_config: %s", err)
+	}
+	d.Set("status", output.Status)
+	d.Set("status_message", output.StatusMessage)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Textract Adapter Version (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAdapterVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Textract Adapter Version (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAdapterVersionRead(ctx, d, meta)...)
+}
+
+func resourceAdapterVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).TextractConn()
+
+	adapterID, adapterVersion, err := AdapterVersionParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Textract Adapter Version: %s", d.Id())
+	_, err = conn.DeleteAdapterVersionWithContext(ctx, &textract.DeleteAdapterVersionInput{
+		AdapterId:      aws.String(adapterID),
+		AdapterVersion: aws.String(adapterVersion),
+	})
+
+	if tfawserr.ErrCodeEquals(err, textract.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Textract Adapter Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func adapterVersionARN(client *conns.AWSClient, adapterID, adapterVersion string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Region:    client.Region,
+		Service:   "textract",
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("/adapters/%s/versions/%s", adapterID, adapterVersion),
+	}.String()
+}
+
+const adapterVersionResourceIDSeparator = ","
+
+func AdapterVersionCreateResourceID(adapterID, adapterVersion string) string {
+	parts := []string{adapterID, adapterVersion}
+	id := strings.Join(parts, adapterVersionResourceIDSeparator)
+
+	return id
+}
+
+func AdapterVersionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, adapterVersionResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected adapter-id%[2]sadapter-version", id, adapterVersionResourceIDSeparator)
+}
+
+func FindAdapterVersionByTwoPartKey(ctx context.Context, conn *textract.Textract, adapterID, adapterVersion string) (*textract.GetAdapterVersionOutput, error) {
+	input := &textract.GetAdapterVersionInput{
+		AdapterId:      aws.String(adapterID),
+		AdapterVersion: aws.String(adapterVersion),
+	}
+
+	output, err := conn.GetAdapterVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, textract.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAdapterVersion(ctx context.Context, conn *textract.Textract, adapterID, adapterVersion string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAdapterVersionByTwoPartKey(ctx, conn, adapterID, adapterVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAdapterVersionActive(ctx context.Context, conn *textract.Textract, adapterID, adapterVersion string, timeout time.Duration) (*textract.GetAdapterVersionOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{textract.AdapterVersionStatusCreationInProgress},
+		Target:  []string{textract.AdapterVersionStatusActive},
+		Refresh: statusAdapterVersion(ctx, conn, adapterID, adapterVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*textract.GetAdapterVersionOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAdapterVersionDatasetConfig(tfList []interface{}) *textract.AdapterVersionDatasetConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &textract.AdapterVersionDatasetConfig{}
+
+	if v, ok := tfMap["manifest_s3_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3Object := &textract.S3Object{
+			Bucket: aws.String(tfMap["bucket"].(string)),
+			Name:   aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["version"].(string); ok && v != "" {
+			s3Object.Version = aws.String(v)
+		}
+
+		apiObject.ManifestS3Object = s3Object
+	}
+
+	return apiObject
+}
+
+func flattenAdapterVersionDatasetConfig(apiObject *textract.AdapterVersionDatasetConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ManifestS3Object; v != nil {
+		tfMap["manifest_s3_object"] = []interface{}{map[string]interface{}{
+			"bucket":  aws.StringValue(v.Bucket),
+			"name":    aws.StringValue(v.Name),
+			"version": aws.StringValue(v.Version),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandOutputConfig(tfList []interface{}) *textract.OutputConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &textract.OutputConfig{
+		S3Bucket: aws.String(tfMap["s3_bucket"].(string)),
+	}
+
+	if v, ok := tfMap["s3_prefix"].(string); ok && v != "" {
+		apiObject.S3Prefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputConfig(apiObject *textract.OutputConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"s3_bucket": aws.StringValue(apiObject.S3Bucket),
+		"s3_prefix": aws.StringValue(apiObject.S3Prefix),
+	}}
+}
@@ -0,0 +1,151 @@
+package textract_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/textract"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftextract "github.com/hashicorp/terraform-provider-aws/internal/service/textract"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Training an adapter version requires a manifest of annotated documents,
+// so the adapter version tests require an existing manifest in S3.
+func TestAccTextractAdapterVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucketKey := "TEXTRACT_ADAPTER_MANIFEST_BUCKET"
+	bucket := os.Getenv(bucketKey)
+	if bucket == "" {
+		t.Skipf("Environment variable %s is not set", bucketKey)
+	}
+	nameKey := "TEXTRACT_ADAPTER_MANIFEST_NAME"
+	name := os.Getenv(nameKey)
+	if name == "" {
+		t.Skipf("Environment variable %s is not set", nameKey)
+	}
+
+	var v textract.GetAdapterVersionOutput
+	resourceName := "aws_textract_adapter_version.test"
+	adapterResourceName := "aws_textract_adapter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, textract.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAdapterVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAdapterVersionConfig_basic(rName, bucket, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAdapterVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "adapter_id", adapterResourceName, "adapter_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "adapter_version"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "textract", regexp.MustCompile(`/adapters/.+/versions/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "dataset_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dataset_config.0.manifest_s3_object.0.bucket", bucket),
+					resource.TestCheckResourceAttr(resourceName, "dataset_config.0.manifest_s3_object.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "output_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_config.0.s3_bucket", bucket),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAdapterVersionExists(ctx context.Context, n string, v *textract.GetAdapterVersionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Textract Adapter Version ID is set")
+		}
+
+		adapterID, adapterVersion, err := tftextract.AdapterVersionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TextractConn()
+
+		output, err := tftextract.FindAdapterVersionByTwoPartKey(ctx, conn, adapterID, adapterVersion)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAdapterVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TextractConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_textract_adapter_version" {
+				continue
+			}
+
+			adapterID, adapterVersion, err := tftextract.AdapterVersionParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tftextract.FindAdapterVersionByTwoPartKey(ctx, conn, adapterID, adapterVersion)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Textract Adapter Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAdapterVersionConfig_basic(rName, bucket, name string) string {
+	return acctest.ConfigCompose(testAccAdapterConfig_basic(rName), fmt.Sprintf(`
+resource "aws_textract_adapter_version" "test" {
+  adapter_id = aws_textract_adapter.test.adapter_id
+
+  dataset_config {
+    manifest_s3_object {
+      bucket = %[1]q
+      name   = %[2]q
+    }
+  }
+
+  output_config {
+    s3_bucket = %[1]q
+    s3_prefix = "output"
+  }
+}
+`, bucket, name))
+}
@@ -0,0 +1,118 @@
+//go:build sweep
+// +build sweep
+
+package textract
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_textract_adapter", &resource.Sweeper{
+		Name: "aws_textract_adapter",
+		F:    sweepAdapters,
+		Dependencies: []string{
+			"aws_textract_adapter_version",
+		},
+	})
+
+	resource.AddTestSweepers("aws_textract_adapter_version", &resource.Sweeper{
+		Name: "aws_textract_adapter_version",
+		F:    sweepAdapterVersions,
+	})
+}
+
+func sweepAdapters(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TextractConn()
+	input := &textract.ListAdaptersInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAdaptersPagesWithContext(ctx, input, func(page *textract.ListAdaptersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Adapters {
+			r := ResourceAdapter()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.AdapterId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Textract Adapter sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Textract Adapters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Textract Adapters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAdapterVersions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TextractConn()
+	input := &textract.ListAdapterVersionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListAdapterVersionsPagesWithContext(ctx, input, func(page *textract.ListAdapterVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AdapterVersions {
+			r := ResourceAdapterVersion()
+			d := r.Data(nil)
+			d.SetId(AdapterVersionCreateResourceID(aws.StringValue(v.AdapterId), aws.StringValue(v.AdapterVersion)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Textract Adapter Version sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Textract Adapter Versions (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Textract Adapter Versions (%s): %w", region, err)
+	}
+
+	return nil
+}
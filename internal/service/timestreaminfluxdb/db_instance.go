@@ -0,0 +1,420 @@
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceDBInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBInstanceCreate,
+		ReadWithoutTimeout:   resourceDBInstanceRead,
+		UpdateWithoutTimeout: resourceDBInstanceUpdate,
+		DeleteWithoutTimeout: resourceDBInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_storage": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(20, 16384),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// bucket, organization, password and username are not returned by the API.
+			"bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 64),
+					validation.StringMatch(regexp.MustCompile(`^[^_][^"]*$`), "must not start with an underscore or contain double quotes"),
+				),
+			},
+			"db_instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(timestreaminfluxdb.DbInstanceType_Values(), false),
+			},
+			"db_parameter_group_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]+$`), "must contain only alphanumeric characters"),
+				),
+			},
+			"db_storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(timestreaminfluxdb.DbStorageType_Values(), false),
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(timestreaminfluxdb.DeploymentType_Values(), false),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"influx_auth_parameters_secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_delivery_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 40),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$`), "must start with a letter, contain only alphanumeric characters and hyphens, and must not end with a hyphen or contain two consecutive hyphens"),
+				),
+			},
+			"organization": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 64),
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"secondary_availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameDBInstance = "DB Instance"
+)
+
+func resourceDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn()
+
+	name := d.Get("name").(string)
+	in := &timestreaminfluxdb.CreateDbInstanceInput{
+		AllocatedStorage:    aws.Int64(int64(d.Get("allocated_storage").(int))),
+		DbInstanceType:      aws.String(d.Get("db_instance_type").(string)),
+		Name:                aws.String(name),
+		Password:            aws.String(d.Get("password").(string)),
+		VpcSecurityGroupIds: flex.ExpandStringSet(d.Get("vpc_security_group_ids").(*schema.Set)),
+		VpcSubnetIds:        flex.ExpandStringSet(d.Get("vpc_subnet_ids").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("bucket"); ok {
+		in.Bucket = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_parameter_group_identifier"); ok {
+		in.DbParameterGroupIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_storage_type"); ok {
+		in.DbStorageType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_type"); ok {
+		in.DeploymentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_delivery_configuration"); ok {
+		in.LogDeliveryConfiguration = expandLogDeliveryConfiguration(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("organization"); ok {
+		in.Organization = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("publicly_accessible"); ok {
+		in.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		in.Username = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateDbInstanceWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionCreating, ResNameDBInstance, name, err)
+	}
+
+	d.SetId(aws.StringValue(out.Id))
+
+	if _, err := waitDBInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionWaitingForCreation, ResNameDBInstance, d.Id(), err)
+	}
+
+	return resourceDBInstanceRead(ctx, d, meta)
+}
+
+func resourceDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn()
+
+	out, err := FindDBInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionReading, ResNameDBInstance, d.Id(), err)
+	}
+
+	d.Set("allocated_storage", out.AllocatedStorage)
+	d.Set("arn", out.Arn)
+	d.Set("availability_zone", out.AvailabilityZone)
+	d.Set("db_instance_type", out.DbInstanceType)
+	d.Set("db_parameter_group_identifier", out.DbParameterGroupIdentifier)
+	d.Set("db_storage_type", out.DbStorageType)
+	d.Set("deployment_type", out.DeploymentType)
+	d.Set("endpoint", out.Endpoint)
+	d.Set("influx_auth_parameters_secret_arn", out.InfluxAuthParametersSecretArn)
+
+	if err := d.Set("log_delivery_configuration", flattenLogDeliveryConfiguration(out.LogDeliveryConfiguration)); err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionSetting, ResNameDBInstance, d.Id(), err)
+	}
+
+	d.Set("name", out.Name)
+	d.Set("publicly_accessible", out.PubliclyAccessible)
+	d.Set("secondary_availability_zone", out.SecondaryAvailabilityZone)
+	d.Set("status", out.Status)
+	d.Set("vpc_security_group_ids", aws.StringValueSlice(out.VpcSecurityGroupIds))
+	d.Set("vpc_subnet_ids", aws.StringValueSlice(out.VpcSubnetIds))
+
+	tags, err := ListTags(ctx, conn, aws.StringValue(out.Arn))
+	if err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionReading, ResNameDBInstance, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionSetting, ResNameDBInstance, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionSetting, ResNameDBInstance, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn()
+
+	if d.HasChanges("db_parameter_group_identifier", "log_delivery_configuration") {
+		in := &timestreaminfluxdb.UpdateDbInstanceInput{
+			Identifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("db_parameter_group_identifier") {
+			in.DbParameterGroupIdentifier = aws.String(d.Get("db_parameter_group_identifier").(string))
+		}
+
+		if d.HasChange("log_delivery_configuration") {
+			in.LogDeliveryConfiguration = expandLogDeliveryConfiguration(d.Get("log_delivery_configuration").([]interface{}))
+		}
+
+		if _, err := conn.UpdateDbInstanceWithContext(ctx, in); err != nil {
+			return create.DiagError(names.TimestreamInfluxDB, create.ErrActionUpdating, ResNameDBInstance, d.Id(), err)
+		}
+
+		if _, err := waitDBInstanceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.TimestreamInfluxDB, create.ErrActionWaitingForUpdate, ResNameDBInstance, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.TimestreamInfluxDB, create.ErrActionUpdating, ResNameDBInstance, d.Id(), err)
+		}
+	}
+
+	return resourceDBInstanceRead(ctx, d, meta)
+}
+
+func resourceDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn()
+
+	log.Printf("[INFO] Deleting Timestream for InfluxDB DB Instance %s", d.Id())
+
+	_, err := conn.DeleteDbInstanceWithContext(ctx, &timestreaminfluxdb.DeleteDbInstanceInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, timestreaminfluxdb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionDeleting, ResNameDBInstance, d.Id(), err)
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.TimestreamInfluxDB, create.ErrActionWaitingForDeletion, ResNameDBInstance, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLogDeliveryConfiguration(tfList []interface{}) *timestreaminfluxdb.LogDeliveryConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &timestreaminfluxdb.LogDeliveryConfiguration{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3Configuration = &timestreaminfluxdb.S3Configuration{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+			Enabled:    aws.Bool(tfMap["enabled"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenLogDeliveryConfiguration(apiObject *timestreaminfluxdb.LogDeliveryConfiguration) []interface{} {
+	if apiObject == nil || apiObject.S3Configuration == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"s3_configuration": []interface{}{
+				map[string]interface{}{
+					"bucket_name": aws.StringValue(apiObject.S3Configuration.BucketName),
+					"enabled":     aws.BoolValue(apiObject.S3Configuration.Enabled),
+				},
+			},
+		},
+	}
+}
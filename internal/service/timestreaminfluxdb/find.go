@@ -0,0 +1,41 @@
+package timestreaminfluxdb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDBInstanceByID(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	in := &timestreaminfluxdb.GetDbInstanceInput{
+		Identifier: aws.String(id),
+	}
+	out, err := conn.GetDbInstanceWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, timestreaminfluxdb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if state := aws.StringValue(out.Status); state == timestreaminfluxdb.StatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     state,
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,60 @@
+package timestreaminfluxdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitDBInstanceCreated(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{timestreaminfluxdb.StatusCreating},
+		Target:  []string{timestreaminfluxdb.StatusAvailable},
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceUpdated(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{timestreaminfluxdb.StatusModifying, timestreaminfluxdb.StatusUpdating},
+		Target:  []string{timestreaminfluxdb.StatusAvailable},
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceDeleted(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{timestreaminfluxdb.StatusAvailable, timestreaminfluxdb.StatusDeleting},
+		Target:  []string{},
+		Refresh: statusDBInstance(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,574 @@
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceIdentitySource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIdentitySourceCreate,
+		ReadWithoutTimeout:   resourceIdentitySourceRead,
+		UpdateWithoutTimeout: resourceIdentitySourceUpdate,
+		DeleteWithoutTimeout: resourceIdentitySourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cognito_user_pool_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.cognito_user_pool_configuration", "configuration.0.open_id_connect_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_ids": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"group_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"group_entity_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 200),
+												},
+											},
+										},
+									},
+									"user_pool_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"open_id_connect_configuration": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"configuration.0.cognito_user_pool_configuration", "configuration.0.open_id_connect_configuration"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entity_id_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"group_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"group_claim": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"group_entity_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 200),
+												},
+											},
+										},
+									},
+									"issuer": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+									"token_selection": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"access_token_only": {
+													Type:         schema.TypeList,
+													Optional:     true,
+													MaxItems:     1,
+													ExactlyOneOf: []string{"configuration.0.open_id_connect_configuration.0.token_selection.0.access_token_only", "configuration.0.open_id_connect_configuration.0.token_selection.0.identity_token_only"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"audiences": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"principal_id_claim": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Computed: true,
+															},
+														},
+													},
+												},
+												"identity_token_only": {
+													Type:         schema.TypeList,
+													Optional:     true,
+													MaxItems:     1,
+													ExactlyOneOf: []string{"configuration.0.open_id_connect_configuration.0.token_selection.0.access_token_only", "configuration.0.open_id_connect_configuration.0.token_selection.0.identity_token_only"},
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"client_ids": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"principal_id_claim": {
+																Type:     schema.TypeString,
+																Optional: true,
+																Computed: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"identity_source_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_entity_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 200),
+			},
+		},
+	}
+}
+
+func resourceIdentitySourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn()
+
+	policyStoreID := d.Get("policy_store_id").(string)
+	input := &verifiedpermissions.CreateIdentitySourceInput{
+		ClientToken:   aws.String(resource.UniqueId()),
+		Configuration: expandConfiguration(d.Get("configuration").([]interface{})),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	if v, ok := d.GetOk("principal_entity_type"); ok {
+		input.PrincipalEntityType = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateIdentitySourceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Verified Permissions Policy Store (%s) Identity Source: %s", policyStoreID, err)
+	}
+
+	d.SetId(IdentitySourceCreateResourceID(policyStoreID, aws.StringValue(output.IdentitySourceId)))
+
+	return append(diags, resourceIdentitySourceRead(ctx, d, meta)...)
+}
+
+func resourceIdentitySourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn()
+
+	policyStoreID, identitySourceID, err := IdentitySourceParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindIdentitySourceByTwoPartKey(ctx, conn, policyStoreID, identitySourceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Permissions Identity Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Verified Permissions Identity Source (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("configuration", flattenConfigurationDetail(output.Configuration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration: %s", err)
+	}
+	d.Set("identity_source_id", output.IdentitySourceId)
+	d.Set("policy_store_id", output.PolicyStoreId)
+	d.Set("principal_entity_type", output.PrincipalEntityType)
+
+	return diags
+}
+
+func resourceIdentitySourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn()
+
+	policyStoreID, identitySourceID, err := IdentitySourceParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &verifiedpermissions.UpdateIdentitySourceInput{
+		IdentitySourceId:    aws.String(identitySourceID),
+		PolicyStoreId:       aws.String(policyStoreID),
+		UpdateConfiguration: expandUpdateConfiguration(d.Get("configuration").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("principal_entity_type"); ok {
+		input.PrincipalEntityType = aws.String(v.(string))
+	}
+
+	_, err = conn.UpdateIdentitySourceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Verified Permissions Identity Source (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIdentitySourceRead(ctx, d, meta)...)
+}
+
+func resourceIdentitySourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VerifiedPermissionsConn()
+
+	policyStoreID, identitySourceID, err := IdentitySourceParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Verified Permissions Identity Source: %s", d.Id())
+	_, err = conn.DeleteIdentitySourceWithContext(ctx, &verifiedpermissions.DeleteIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Verified Permissions Identity Source (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const identitySourceResourceIDSeparator = "/"
+
+func IdentitySourceCreateResourceID(policyStoreID, identitySourceID string) string {
+	parts := []string{policyStoreID, identitySourceID}
+	id := strings.Join(parts, identitySourceResourceIDSeparator)
+
+	return id
+}
+
+func IdentitySourceParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, identitySourceResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected POLICY-STORE-ID%[2]sIDENTITY-SOURCE-ID", id, identitySourceResourceIDSeparator)
+}
+
+func FindIdentitySourceByTwoPartKey(ctx context.Context, conn *verifiedpermissions.VerifiedPermissions, policyStoreID, identitySourceID string) (*verifiedpermissions.GetIdentitySourceOutput, error) {
+	input := &verifiedpermissions.GetIdentitySourceInput{
+		IdentitySourceId: aws.String(identitySourceID),
+		PolicyStoreId:    aws.String(policyStoreID),
+	}
+
+	output, err := conn.GetIdentitySourceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, verifiedpermissions.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandConfiguration(tfList []interface{}) *verifiedpermissions.Configuration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &verifiedpermissions.Configuration{}
+
+	if v, ok := tfMap["cognito_user_pool_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &verifiedpermissions.CognitoUserPoolConfiguration{
+			UserPoolArn: aws.String(tfMap["user_pool_arn"].(string)),
+		}
+
+		if v, ok := tfMap["client_ids"].([]interface{}); ok && len(v) > 0 {
+			config.ClientIds = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			config.GroupConfiguration = &verifiedpermissions.CognitoGroupConfiguration{
+				GroupEntityType: aws.String(v[0].(map[string]interface{})["group_entity_type"].(string)),
+			}
+		}
+
+		apiObject.CognitoUserPoolConfiguration = config
+	}
+
+	if v, ok := tfMap["open_id_connect_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &verifiedpermissions.OpenIdConnectConfiguration{
+			Issuer:         aws.String(tfMap["issuer"].(string)),
+			TokenSelection: &verifiedpermissions.OpenIdConnectTokenSelection{},
+		}
+
+		if v, ok := tfMap["entity_id_prefix"].(string); ok && v != "" {
+			config.EntityIdPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			config.GroupConfiguration = &verifiedpermissions.OpenIdConnectGroupConfiguration{
+				GroupClaim:      aws.String(tfMap["group_claim"].(string)),
+				GroupEntityType: aws.String(tfMap["group_entity_type"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["token_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["access_token_only"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				tokenConfig := &verifiedpermissions.OpenIdConnectAccessTokenConfiguration{}
+
+				if v, ok := tfMap["audiences"].([]interface{}); ok && len(v) > 0 {
+					tokenConfig.Audiences = flex.ExpandStringList(v)
+				}
+
+				if v, ok := tfMap["principal_id_claim"].(string); ok && v != "" {
+					tokenConfig.PrincipalIdClaim = aws.String(v)
+				}
+
+				config.TokenSelection.AccessTokenOnly = tokenConfig
+			}
+
+			if v, ok := tfMap["identity_token_only"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				tokenConfig := &verifiedpermissions.OpenIdConnectIdentityTokenConfiguration{}
+
+				if v, ok := tfMap["client_ids"].([]interface{}); ok && len(v) > 0 {
+					tokenConfig.ClientIds = flex.ExpandStringList(v)
+				}
+
+				if v, ok := tfMap["principal_id_claim"].(string); ok && v != "" {
+					tokenConfig.PrincipalIdClaim = aws.String(v)
+				}
+
+				config.TokenSelection.IdentityTokenOnly = tokenConfig
+			}
+		}
+
+		apiObject.OpenIdConnectConfiguration = config
+	}
+
+	return apiObject
+}
+
+func expandUpdateConfiguration(tfList []interface{}) *verifiedpermissions.UpdateConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &verifiedpermissions.UpdateConfiguration{}
+
+	if v, ok := tfMap["cognito_user_pool_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &verifiedpermissions.UpdateCognitoUserPoolConfiguration{
+			UserPoolArn: aws.String(tfMap["user_pool_arn"].(string)),
+		}
+
+		if v, ok := tfMap["client_ids"].([]interface{}); ok && len(v) > 0 {
+			config.ClientIds = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			config.GroupConfiguration = &verifiedpermissions.UpdateCognitoGroupConfiguration{
+				GroupEntityType: aws.String(v[0].(map[string]interface{})["group_entity_type"].(string)),
+			}
+		}
+
+		apiObject.CognitoUserPoolConfiguration = config
+	}
+
+	if v, ok := tfMap["open_id_connect_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		config := &verifiedpermissions.UpdateOpenIdConnectConfiguration{
+			Issuer:         aws.String(tfMap["issuer"].(string)),
+			TokenSelection: &verifiedpermissions.UpdateOpenIdConnectTokenSelection{},
+		}
+
+		if v, ok := tfMap["entity_id_prefix"].(string); ok && v != "" {
+			config.EntityIdPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["group_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			config.GroupConfiguration = &verifiedpermissions.UpdateOpenIdConnectGroupConfiguration{
+				GroupClaim:      aws.String(tfMap["group_claim"].(string)),
+				GroupEntityType: aws.String(tfMap["group_entity_type"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["token_selection"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["access_token_only"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				tokenConfig := &verifiedpermissions.UpdateOpenIdConnectAccessTokenConfiguration{}
+
+				if v, ok := tfMap["audiences"].([]interface{}); ok && len(v) > 0 {
+					tokenConfig.Audiences = flex.ExpandStringList(v)
+				}
+
+				if v, ok := tfMap["principal_id_claim"].(string); ok && v != "" {
+					tokenConfig.PrincipalIdClaim = aws.String(v)
+				}
+
+				config.TokenSelection.AccessTokenOnly = tokenConfig
+			}
+
+			if v, ok := tfMap["identity_token_only"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				tokenConfig := &verifiedpermissions.UpdateOpenIdConnectIdentityTokenConfiguration{}
+
+				if v, ok := tfMap["client_ids"].([]interface{}); ok && len(v) > 0 {
+					tokenConfig.ClientIds = flex.ExpandStringList(v)
+				}
+
+				if v, ok := tfMap["principal_id_claim"].(string); ok && v != "" {
+					tokenConfig.PrincipalIdClaim = aws.String(v)
+				}
+
+				config.TokenSelection.IdentityTokenOnly = tokenConfig
+			}
+		}
+
+		apiObject.OpenIdConnectConfiguration = config
+	}
+
+	return apiObject
+}
+
+func flattenConfigurationDetail(apiObject *verifiedpermissions.ConfigurationDetail) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CognitoUserPoolConfiguration; v != nil {
+		config := map[string]interface{}{
+			"client_ids":    aws.StringValueSlice(v.ClientIds),
+			"user_pool_arn": aws.StringValue(v.UserPoolArn),
+		}
+
+		if v := v.GroupConfiguration; v != nil {
+			config["group_configuration"] = []interface{}{map[string]interface{}{
+				"group_entity_type": aws.StringValue(v.GroupEntityType),
+			}}
+		}
+
+		tfMap["cognito_user_pool_configuration"] = []interface{}{config}
+	}
+
+	if v := apiObject.OpenIdConnectConfiguration; v != nil {
+		config := map[string]interface{}{
+			"entity_id_prefix": aws.StringValue(v.EntityIdPrefix),
+			"issuer":           aws.StringValue(v.Issuer),
+		}
+
+		if v := v.GroupConfiguration; v != nil {
+			config["group_configuration"] = []interface{}{map[string]interface{}{
+				"group_claim":       aws.StringValue(v.GroupClaim),
+				"group_entity_type": aws.StringValue(v.GroupEntityType),
+			}}
+		}
+
+		if v := v.TokenSelection; v != nil {
+			tokenSelection := map[string]interface{}{}
+
+			if v := v.AccessTokenOnly; v != nil {
+				tokenSelection["access_token_only"] = []interface{}{map[string]interface{}{
+					"audiences":          aws.StringValueSlice(v.Audiences),
+					"principal_id_claim": aws.StringValue(v.PrincipalIdClaim),
+				}}
+			}
+
+			if v := v.IdentityTokenOnly; v != nil {
+				tokenSelection["identity_token_only"] = []interface{}{map[string]interface{}{
+					"client_ids":         aws.StringValueSlice(v.ClientIds),
+					"principal_id_claim": aws.StringValue(v.PrincipalIdClaim),
+				}}
+			}
+
+			config["token_selection"] = []interface{}{tokenSelection}
+		}
+
+		tfMap["open_id_connect_configuration"] = []interface{}{config}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,85 @@
+//go:build sweep
+// +build sweep
+
+package verifiedpermissions
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/verifiedpermissions"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_verifiedpermissions_identity_source", &resource.Sweeper{
+		Name: "aws_verifiedpermissions_identity_source",
+		F:    sweepIdentitySources,
+	})
+}
+
+func sweepIdentitySources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).VerifiedPermissionsConn()
+	input := &verifiedpermissions.ListPolicyStoresInput{}
+	var errs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListPolicyStoresPagesWithContext(ctx, input, func(page *verifiedpermissions.ListPolicyStoresOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PolicyStores {
+			policyStoreID := aws.StringValue(v.PolicyStoreId)
+			input := &verifiedpermissions.ListIdentitySourcesInput{
+				PolicyStoreId: aws.String(policyStoreID),
+			}
+
+			err := conn.ListIdentitySourcesPagesWithContext(ctx, input, func(page *verifiedpermissions.ListIdentitySourcesOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.IdentitySources {
+					r := ResourceIdentitySource()
+					d := r.Data(nil)
+					d.SetId(IdentitySourceCreateResourceID(policyStoreID, aws.StringValue(v.IdentitySourceId)))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Verified Permissions Identity Sources for Policy Store (%s): %w", policyStoreID, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Verified Permissions Identity Source sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Verified Permissions Policy Stores (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Verified Permissions Identity Sources (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -152,6 +152,63 @@ func expandCaptchaAction(l []interface{}) *wafv2.CaptchaAction {
 	return action
 }
 
+func expandOuterCaptchaConfig(l []interface{}) *wafv2.CaptchaConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	captchaConfig := &wafv2.CaptchaConfig{}
+
+	if v, ok := m["immunity_time_property"].([]interface{}); ok && len(v) > 0 {
+		captchaConfig.ImmunityTimeProperty = expandImmunityTimeProperty(v)
+	}
+
+	return captchaConfig
+}
+
+func expandOuterChallengeConfig(l []interface{}) *wafv2.ChallengeConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	challengeConfig := &wafv2.ChallengeConfig{}
+
+	if v, ok := m["immunity_time_property"].([]interface{}); ok && len(v) > 0 {
+		challengeConfig.ImmunityTimeProperty = expandImmunityTimeProperty(v)
+	}
+
+	return challengeConfig
+}
+
+func expandImmunityTimeProperty(l []interface{}) *wafv2.ImmunityTimeProperty {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	immunityTimeProperty := &wafv2.ImmunityTimeProperty{}
+
+	if v, ok := m["immunity_time"].(int); ok && v != 0 {
+		immunityTimeProperty.ImmunityTime = aws.Int64(int64(v))
+	}
+
+	return immunityTimeProperty
+}
+
 func expandChallengeAction(l []interface{}) *wafv2.ChallengeAction {
 	action := &wafv2.ChallengeAction{}
 
@@ -1286,6 +1343,46 @@ func flattenCaptcha(a *wafv2.CaptchaAction) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenOuterCaptchaConfig(config *wafv2.CaptchaConfig) interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.ImmunityTimeProperty != nil {
+		m["immunity_time_property"] = flattenImmunityTimeProperty(config.ImmunityTimeProperty)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenOuterChallengeConfig(config *wafv2.ChallengeConfig) interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if config.ImmunityTimeProperty != nil {
+		m["immunity_time_property"] = flattenImmunityTimeProperty(config.ImmunityTimeProperty)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenImmunityTimeProperty(property *wafv2.ImmunityTimeProperty) interface{} {
+	if property == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"immunity_time": aws.Int64Value(property.ImmunityTime),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenChallenge(a *wafv2.ChallengeAction) []interface{} {
 	if a == nil {
 		return []interface{}{}
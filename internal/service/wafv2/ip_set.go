@@ -53,7 +53,10 @@ func ResourceIPSet() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				MaxItems: 10000,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					o, n := d.GetChange("addresses")
 					oldAddresses := o.(*schema.Set).List()
@@ -76,6 +79,10 @@ func ResourceIPSet() *schema.Resource {
 					return false
 				},
 			},
+			"addresses_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -171,6 +178,7 @@ func resourceIPSetRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	ipSet := output.IPSet
 	d.Set("addresses", aws.StringValueSlice(ipSet.Addresses))
+	d.Set("addresses_count", len(ipSet.Addresses))
 	arn := aws.StringValue(ipSet.ARN)
 	d.Set("arn", arn)
 	d.Set("description", ipSet.Description)
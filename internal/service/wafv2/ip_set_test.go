@@ -38,6 +38,7 @@ func TestAccWAFV2IPSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scope", wafv2.ScopeRegional),
 					resource.TestCheckResourceAttr(resourceName, "ip_address_version", wafv2.IPAddressVersionIpv4),
 					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "addresses_count", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Tag1", "Value1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Tag2", "Value2"),
@@ -53,6 +54,7 @@ func TestAccWAFV2IPSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scope", wafv2.ScopeRegional),
 					resource.TestCheckResourceAttr(resourceName, "ip_address_version", wafv2.IPAddressVersionIpv4),
 					resource.TestCheckResourceAttr(resourceName, "addresses.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "addresses_count", "3"),
 				),
 			},
 			{
@@ -273,6 +275,7 @@ func TestAccWAFV2IPSet_large(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scope", wafv2.ScopeRegional),
 					resource.TestCheckResourceAttr(resourceName, "ip_address_version", wafv2.IPAddressVersionIpv4),
 					resource.TestCheckResourceAttr(resourceName, "addresses.#", "50"),
+					resource.TestCheckResourceAttr(resourceName, "addresses_count", "50"),
 				),
 			},
 			{
@@ -285,6 +288,28 @@ func TestAccWAFV2IPSet_large(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2IPSet_invalidAddress(t *testing.T) {
+	ctx := acctest.Context(t)
+	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, wafv2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIPSetConfig_addresses(ipSetName, "1.2.3.4"),
+				ExpectError: regexp.MustCompile(`"1.2.3.4" is not a valid CIDR block`),
+			},
+			{
+				Config:      testAccIPSetConfig_addresses(ipSetName, "10.1.2.3/16"),
+				ExpectError: regexp.MustCompile(`did you mean "10.1.0.0/16"`),
+			},
+		},
+	})
+}
+
 func testAccCheckIPSetDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -391,6 +416,17 @@ resource "aws_wafv2_ip_set" "ip_set" {
 `, name)
 }
 
+func testAccIPSetConfig_addresses(name, address string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_ip_set" "ip_set" {
+  name               = %[1]q
+  scope              = "REGIONAL"
+  ip_address_version = "IPV4"
+  addresses          = [%[2]q]
+}
+`, name, address)
+}
+
 func testAccIPSetConfig_oneTag(name, tagKey, tagValue string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_ip_set" "ip_set" {
@@ -0,0 +1,213 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceBrowserSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBrowserSettingsCreate,
+		ReadWithoutTimeout:   resourceBrowserSettingsRead,
+		UpdateWithoutTimeout: resourceBrowserSettingsUpdate,
+		DeleteWithoutTimeout: resourceBrowserSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"associated_portal_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"browser_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameBrowserSettings = "Browser Settings"
+)
+
+func resourceBrowserSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	policy, err := structure.NormalizeJsonString(d.Get("browser_policy").(string))
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionCreating, ResNameBrowserSettings, "", err)
+	}
+
+	in := &workspacesweb.CreateBrowserSettingsInput{
+		BrowserPolicy: aws.String(policy),
+	}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		in.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		in.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreateBrowserSettingsWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionCreating, ResNameBrowserSettings, "", err)
+	}
+
+	d.SetId(aws.StringValue(out.BrowserSettingsArn))
+
+	return resourceBrowserSettingsRead(ctx, d, meta)
+}
+
+func resourceBrowserSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	out, err := FindBrowserSettingsByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Browser Settings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameBrowserSettings, d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(out.AdditionalEncryptionContext))
+	d.Set("arn", out.BrowserSettingsArn)
+	d.Set("associated_portal_arns", aws.StringValueSlice(out.AssociatedPortalArns))
+
+	policy, err := structure.NormalizeJsonString(aws.StringValue(out.BrowserPolicy))
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionSetting, ResNameBrowserSettings, d.Id(), err)
+	}
+
+	d.Set("browser_policy", policy)
+	d.Set("customer_managed_key", out.CustomerManagedKey)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameBrowserSettings, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionSetting, ResNameBrowserSettings, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionSetting, ResNameBrowserSettings, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBrowserSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	if d.HasChange("browser_policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("browser_policy").(string))
+		if err != nil {
+			return create.DiagError(names.WorkSpacesWeb, create.ErrActionUpdating, ResNameBrowserSettings, d.Id(), err)
+		}
+
+		in := &workspacesweb.UpdateBrowserSettingsInput{
+			BrowserPolicy:      aws.String(policy),
+			BrowserSettingsArn: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces Web Browser Settings (%s)", d.Id())
+		_, err = conn.UpdateBrowserSettingsWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.WorkSpacesWeb, create.ErrActionUpdating, ResNameBrowserSettings, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.WorkSpacesWeb, create.ErrActionUpdating, ResNameBrowserSettings, d.Id(), err)
+		}
+	}
+
+	return resourceBrowserSettingsRead(ctx, d, meta)
+}
+
+func resourceBrowserSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	log.Printf("[INFO] Deleting WorkSpaces Web Browser Settings %s", d.Id())
+
+	_, err := conn.DeleteBrowserSettingsWithContext(ctx, &workspacesweb.DeleteBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionDeleting, ResNameBrowserSettings, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceBrowserSettingsAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBrowserSettingsAssociationCreate,
+		ReadWithoutTimeout:   resourceBrowserSettingsAssociationRead,
+		DeleteWithoutTimeout: resourceBrowserSettingsAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"browser_settings_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	ResNameBrowserSettingsAssociation = "Browser Settings Association"
+
+	browserSettingsAssociationIDSeparator = ","
+)
+
+func resourceBrowserSettingsAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	browserSettingsARN := d.Get("browser_settings_arn").(string)
+	portalARN := d.Get("portal_arn").(string)
+	id := BrowserSettingsAssociationCreateResourceID(browserSettingsARN, portalARN)
+
+	_, err := conn.AssociateBrowserSettingsWithContext(ctx, &workspacesweb.AssociateBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(browserSettingsARN),
+		PortalArn:          aws.String(portalARN),
+	})
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionCreating, ResNameBrowserSettingsAssociation, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceBrowserSettingsAssociationRead(ctx, d, meta)
+}
+
+func resourceBrowserSettingsAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	browserSettingsARN, portalARN, err := BrowserSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameBrowserSettingsAssociation, d.Id(), err)
+	}
+
+	err = FindBrowserSettingsAssociation(ctx, conn, browserSettingsARN, portalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Browser Settings Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameBrowserSettingsAssociation, d.Id(), err)
+	}
+
+	d.Set("browser_settings_arn", browserSettingsARN)
+	d.Set("portal_arn", portalARN)
+
+	return nil
+}
+
+func resourceBrowserSettingsAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	log.Printf("[INFO] Deleting WorkSpaces Web Browser Settings Association %s", d.Id())
+
+	_, err := conn.DisassociateBrowserSettingsWithContext(ctx, &workspacesweb.DisassociateBrowserSettingsInput{
+		PortalArn: aws.String(d.Get("portal_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionDeleting, ResNameBrowserSettingsAssociation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindBrowserSettingsAssociation(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, browserSettingsARN, portalARN string) error {
+	portal, err := FindPortalByARN(ctx, conn, portalARN)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(portal.BrowserSettingsArn) != browserSettingsARN {
+		return &resource.NotFoundError{
+			Message: fmt.Sprintf("browser settings (%s) not associated with portal (%s)", browserSettingsARN, portalARN),
+		}
+	}
+
+	return nil
+}
+
+func BrowserSettingsAssociationCreateResourceID(browserSettingsARN, portalARN string) string {
+	parts := []string{browserSettingsARN, portalARN}
+	id := strings.Join(parts, browserSettingsAssociationIDSeparator)
+
+	return id
+}
+
+func BrowserSettingsAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, browserSettingsAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BROWSER-SETTINGS-ARN%[2]sPORTAL-ARN", id, browserSettingsAssociationIDSeparator)
+}
@@ -0,0 +1,103 @@
+package workspacesweb
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindPortalByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.Portal, error) {
+	in := &workspacesweb.GetPortalInput{
+		PortalArn: aws.String(arn),
+	}
+	out, err := conn.GetPortalWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Portal == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Portal, nil
+}
+
+func FindBrowserSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.BrowserSettings, error) {
+	in := &workspacesweb.GetBrowserSettingsInput{
+		BrowserSettingsArn: aws.String(arn),
+	}
+	out, err := conn.GetBrowserSettingsWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.BrowserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.BrowserSettings, nil
+}
+
+func FindNetworkSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.NetworkSettings, error) {
+	in := &workspacesweb.GetNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(arn),
+	}
+	out, err := conn.GetNetworkSettingsWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.NetworkSettings == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.NetworkSettings, nil
+}
+
+func FindUserSettingsByARN(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, arn string) (*workspacesweb.UserSettings, error) {
+	in := &workspacesweb.GetUserSettingsInput{
+		UserSettingsArn: aws.String(arn),
+	}
+	out, err := conn.GetUserSettingsWithContext(ctx, in)
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.UserSettings == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.UserSettings, nil
+}
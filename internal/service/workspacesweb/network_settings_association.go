@@ -0,0 +1,154 @@
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceNetworkSettingsAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkSettingsAssociationCreate,
+		ReadWithoutTimeout:   resourceNetworkSettingsAssociationRead,
+		DeleteWithoutTimeout: resourceNetworkSettingsAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_settings_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	ResNameNetworkSettingsAssociation = "Network Settings Association"
+
+	networkSettingsAssociationIDSeparator = ","
+)
+
+func resourceNetworkSettingsAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	networkSettingsARN := d.Get("network_settings_arn").(string)
+	portalARN := d.Get("portal_arn").(string)
+	id := NetworkSettingsAssociationCreateResourceID(networkSettingsARN, portalARN)
+
+	_, err := conn.AssociateNetworkSettingsWithContext(ctx, &workspacesweb.AssociateNetworkSettingsInput{
+		NetworkSettingsArn: aws.String(networkSettingsARN),
+		PortalArn:          aws.String(portalARN),
+	})
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionCreating, ResNameNetworkSettingsAssociation, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNetworkSettingsAssociationRead(ctx, d, meta)
+}
+
+func resourceNetworkSettingsAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	networkSettingsARN, portalARN, err := NetworkSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameNetworkSettingsAssociation, d.Id(), err)
+	}
+
+	err = FindNetworkSettingsAssociation(ctx, conn, networkSettingsARN, portalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Network Settings Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameNetworkSettingsAssociation, d.Id(), err)
+	}
+
+	d.Set("network_settings_arn", networkSettingsARN)
+	d.Set("portal_arn", portalARN)
+
+	return nil
+}
+
+func resourceNetworkSettingsAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	log.Printf("[INFO] Deleting WorkSpaces Web Network Settings Association %s", d.Id())
+
+	_, err := conn.DisassociateNetworkSettingsWithContext(ctx, &workspacesweb.DisassociateNetworkSettingsInput{
+		PortalArn: aws.String(d.Get("portal_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionDeleting, ResNameNetworkSettingsAssociation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindNetworkSettingsAssociation(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, networkSettingsARN, portalARN string) error {
+	portal, err := FindPortalByARN(ctx, conn, portalARN)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(portal.NetworkSettingsArn) != networkSettingsARN {
+		return &resource.NotFoundError{
+			Message: fmt.Sprintf("network settings (%s) not associated with portal (%s)", networkSettingsARN, portalARN),
+		}
+	}
+
+	return nil
+}
+
+func NetworkSettingsAssociationCreateResourceID(networkSettingsARN, portalARN string) string {
+	parts := []string{networkSettingsARN, portalARN}
+	id := strings.Join(parts, networkSettingsAssociationIDSeparator)
+
+	return id
+}
+
+func NetworkSettingsAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, networkSettingsAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NETWORK-SETTINGS-ARN%[2]sPORTAL-ARN", id, networkSettingsAssociationIDSeparator)
+}
@@ -0,0 +1,285 @@
+package workspacesweb
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourcePortal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortalCreate,
+		ReadWithoutTimeout:   resourcePortalRead,
+		UpdateWithoutTimeout: resourcePortalUpdate,
+		DeleteWithoutTimeout: resourcePortalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_encryption_context": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authentication_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.AuthenticationType_Values(), false),
+			},
+			"browser_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"browser_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer_managed_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(workspacesweb.InstanceType_Values(), false),
+			},
+			"max_concurrent_sessions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"network_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portal_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renderer_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"user_settings_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNamePortal = "Portal"
+)
+
+func resourcePortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	in := &workspacesweb.CreatePortalInput{}
+
+	if v, ok := d.GetOk("additional_encryption_context"); ok && len(v.(map[string]interface{})) > 0 {
+		in.AdditionalEncryptionContext = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("authentication_type"); ok {
+		in.AuthenticationType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_managed_key"); ok {
+		in.CustomerManagedKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		in.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_type"); ok {
+		in.InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_concurrent_sessions"); ok {
+		in.MaxConcurrentSessions = aws.Int64(int64(v.(int)))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		in.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	out, err := conn.CreatePortalWithContext(ctx, in)
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionCreating, ResNamePortal, d.Get("display_name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(out.PortalArn))
+
+	return resourcePortalRead(ctx, d, meta)
+}
+
+func resourcePortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	out, err := FindPortalByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web Portal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNamePortal, d.Id(), err)
+	}
+
+	d.Set("additional_encryption_context", aws.StringValueMap(out.AdditionalEncryptionContext))
+	d.Set("arn", out.PortalArn)
+	d.Set("authentication_type", out.AuthenticationType)
+	d.Set("browser_settings_arn", out.BrowserSettingsArn)
+	d.Set("browser_type", out.BrowserType)
+	if out.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(out.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("customer_managed_key", out.CustomerManagedKey)
+	d.Set("display_name", out.DisplayName)
+	d.Set("instance_type", out.InstanceType)
+	d.Set("max_concurrent_sessions", out.MaxConcurrentSessions)
+	d.Set("network_settings_arn", out.NetworkSettingsArn)
+	d.Set("portal_endpoint", out.PortalEndpoint)
+	d.Set("portal_status", out.PortalStatus)
+	d.Set("renderer_type", out.RendererType)
+	d.Set("status_reason", out.StatusReason)
+	d.Set("user_settings_arn", out.UserSettingsArn)
+
+	tags, err := ListTags(ctx, conn, d.Id())
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNamePortal, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionSetting, ResNamePortal, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionSetting, ResNamePortal, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourcePortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	if d.HasChanges("authentication_type", "display_name", "instance_type", "max_concurrent_sessions") {
+		in := &workspacesweb.UpdatePortalInput{
+			PortalArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("authentication_type") {
+			in.AuthenticationType = aws.String(d.Get("authentication_type").(string))
+		}
+
+		if d.HasChange("display_name") {
+			in.DisplayName = aws.String(d.Get("display_name").(string))
+		}
+
+		if d.HasChange("instance_type") {
+			in.InstanceType = aws.String(d.Get("instance_type").(string))
+		}
+
+		if d.HasChange("max_concurrent_sessions") {
+			in.MaxConcurrentSessions = aws.Int64(int64(d.Get("max_concurrent_sessions").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating WorkSpaces Web Portal (%s): %#v", d.Id(), in)
+		_, err := conn.UpdatePortalWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.WorkSpacesWeb, create.ErrActionUpdating, ResNamePortal, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.WorkSpacesWeb, create.ErrActionUpdating, ResNamePortal, d.Id(), err)
+		}
+	}
+
+	return resourcePortalRead(ctx, d, meta)
+}
+
+func resourcePortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	log.Printf("[INFO] Deleting WorkSpaces Web Portal %s", d.Id())
+
+	_, err := conn.DeletePortalWithContext(ctx, &workspacesweb.DeletePortalInput{
+		PortalArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionDeleting, ResNamePortal, d.Id(), err)
+	}
+
+	return nil
+}
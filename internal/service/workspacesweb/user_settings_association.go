@@ -0,0 +1,154 @@
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/workspacesweb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceUserSettingsAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUserSettingsAssociationCreate,
+		ReadWithoutTimeout:   resourceUserSettingsAssociationRead,
+		DeleteWithoutTimeout: resourceUserSettingsAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_settings_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"portal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	ResNameUserSettingsAssociation = "User Settings Association"
+
+	userSettingsAssociationIDSeparator = ","
+)
+
+func resourceUserSettingsAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	userSettingsARN := d.Get("user_settings_arn").(string)
+	portalARN := d.Get("portal_arn").(string)
+	id := UserSettingsAssociationCreateResourceID(userSettingsARN, portalARN)
+
+	_, err := conn.AssociateUserSettingsWithContext(ctx, &workspacesweb.AssociateUserSettingsInput{
+		PortalArn:       aws.String(portalARN),
+		UserSettingsArn: aws.String(userSettingsARN),
+	})
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionCreating, ResNameUserSettingsAssociation, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceUserSettingsAssociationRead(ctx, d, meta)
+}
+
+func resourceUserSettingsAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	userSettingsARN, portalARN, err := UserSettingsAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameUserSettingsAssociation, d.Id(), err)
+	}
+
+	err = FindUserSettingsAssociation(ctx, conn, userSettingsARN, portalARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] WorkSpaces Web User Settings Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionReading, ResNameUserSettingsAssociation, d.Id(), err)
+	}
+
+	d.Set("user_settings_arn", userSettingsARN)
+	d.Set("portal_arn", portalARN)
+
+	return nil
+}
+
+func resourceUserSettingsAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).WorkSpacesWebConn()
+
+	log.Printf("[INFO] Deleting WorkSpaces Web User Settings Association %s", d.Id())
+
+	_, err := conn.DisassociateUserSettingsWithContext(ctx, &workspacesweb.DisassociateUserSettingsInput{
+		PortalArn: aws.String(d.Get("portal_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, workspacesweb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.WorkSpacesWeb, create.ErrActionDeleting, ResNameUserSettingsAssociation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindUserSettingsAssociation(ctx context.Context, conn *workspacesweb.WorkSpacesWeb, userSettingsARN, portalARN string) error {
+	portal, err := FindPortalByARN(ctx, conn, portalARN)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(portal.UserSettingsArn) != userSettingsARN {
+		return &resource.NotFoundError{
+			Message: fmt.Sprintf("user settings (%s) not associated with portal (%s)", userSettingsARN, portalARN),
+		}
+	}
+
+	return nil
+}
+
+func UserSettingsAssociationCreateResourceID(userSettingsARN, portalARN string) string {
+	parts := []string{userSettingsARN, portalARN}
+	id := strings.Join(parts, userSettingsAssociationIDSeparator)
+
+	return id
+}
+
+func UserSettingsAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userSettingsAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected USER-SETTINGS-ARN%[2]sPORTAL-ARN", id, userSettingsAssociationIDSeparator)
+}
@@ -24,7 +24,10 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
@@ -43,12 +46,14 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dataexchange"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dax"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/deadline"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/devicefarm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/drs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -65,20 +70,26 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/entityresolution"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/events"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fis"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/forecast"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/glue"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/greengrassv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iottwinmaker"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
@@ -89,26 +100,37 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lexv2models"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/logs"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/managedblockchain"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/memorydb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mq"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/omics"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/paymentcryptography"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/personalize"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ram"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftserverless"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rekognition"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resiliencehub"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/resourceexplorer2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
@@ -125,20 +147,27 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/simspaceweaver"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssmincidents"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/textract"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
@@ -10,7 +10,6 @@ const (
 	APIGatewayV2                 = "apigatewayv2"
 	AccessAnalyzer               = "accessanalyzer"
 	Account                      = "account"
-	AlexaForBusiness             = "alexaforbusiness"
 	Amplify                      = "amplify"
 	AmplifyBackend               = "amplifybackend"
 	AmplifyUIBuilder             = "amplifyuibuilder"
@@ -32,6 +31,8 @@ const (
 	Backup                       = "backup"
 	BackupGateway                = "backupgateway"
 	Batch                        = "batch"
+	Bedrock                      = "bedrock"
+	BedrockAgent                 = "bedrockagent"
 	BillingConductor             = "billingconductor"
 	Braket                       = "braket"
 	Budgets                      = "budgets"
@@ -41,6 +42,7 @@ const (
 	ChimeSDKIdentity             = "chimesdkidentity"
 	ChimeSDKMeetings             = "chimesdkmeetings"
 	ChimeSDKMessaging            = "chimesdkmessaging"
+	CleanRooms                   = "cleanrooms"
 	Cloud9                       = "cloud9"
 	CloudControl                 = "cloudcontrol"
 	CloudDirectory               = "clouddirectory"
@@ -81,6 +83,7 @@ const (
 	DataExchange                 = "dataexchange"
 	DataPipeline                 = "datapipeline"
 	DataSync                     = "datasync"
+	Deadline                     = "deadline"
 	Deploy                       = "deploy"
 	Detective                    = "detective"
 	DevOpsGuru                   = "devopsguru"
@@ -108,6 +111,7 @@ const (
 	ElasticInference             = "elasticinference"
 	ElasticTranscoder            = "elastictranscoder"
 	Elasticsearch                = "elasticsearch"
+	EntityResolution             = "entityresolution"
 	Events                       = "events"
 	Evidently                    = "evidently"
 	FIS                          = "fis"
@@ -130,10 +134,10 @@ const (
 	GuardDuty                    = "guardduty"
 	Health                       = "health"
 	HealthLake                   = "healthlake"
-	Honeycode                    = "honeycode"
 	IAM                          = "iam"
 	IVS                          = "ivs"
 	IVSChat                      = "ivschat"
+	IVSRealTime                  = "ivsrealtime"
 	IdentityStore                = "identitystore"
 	ImageBuilder                 = "imagebuilder"
 	Inspector                    = "inspector"
@@ -168,7 +172,7 @@ const (
 	LakeFormation                = "lakeformation"
 	Lambda                       = "lambda"
 	LexModels                    = "lexmodels"
-	LexModelsV2                  = "lexmodelsv2"
+	LexModelsV2                  = "lexv2models"
 	LexRuntime                   = "lexruntime"
 	LexRuntimeV2                 = "lexruntimev2"
 	LicenseManager               = "licensemanager"
@@ -182,7 +186,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
@@ -193,6 +196,7 @@ const (
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
 	MediaPackage                 = "mediapackage"
+	MediaPackageV2               = "mediapackagev2"
 	MediaPackageVOD              = "mediapackagevod"
 	MediaStore                   = "mediastore"
 	MediaStoreData               = "mediastoredata"
@@ -203,11 +207,11 @@ const (
 	MigrationHubConfig           = "migrationhubconfig"
 	MigrationHubRefactorSpaces   = "migrationhubrefactorspaces"
 	MigrationHubStrategy         = "migrationhubstrategy"
-	Mobile                       = "mobile"
 	Neptune                      = "neptune"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	Nimble                       = "nimble"
+	Omics                        = "omics"
 	OpenSearch                   = "opensearch"
 	OpenSearchServerless         = "opensearchserverless"
 	OpsWorks                     = "opsworks"
@@ -216,6 +220,7 @@ const (
 	Outposts                     = "outposts"
 	PI                           = "pi"
 	Panorama                     = "panorama"
+	PaymentCryptography          = "paymentcryptography"
 	Personalize                  = "personalize"
 	PersonalizeEvents            = "personalizeevents"
 	PersonalizeRuntime           = "personalizeruntime"
@@ -226,6 +231,7 @@ const (
 	Polly                        = "polly"
 	Pricing                      = "pricing"
 	Proton                       = "proton"
+	QBusiness                    = "qbusiness"
 	QLDB                         = "qldb"
 	QLDBSession                  = "qldbsession"
 	QuickSight                   = "quicksight"
@@ -284,6 +290,7 @@ const (
 	ServiceQuotas                = "servicequotas"
 	Shield                       = "shield"
 	Signer                       = "signer"
+	SimSpaceWeaver               = "simspaceweaver"
 	SimpleDB                     = "simpledb"
 	SnowDeviceManagement         = "snowdevicemanagement"
 	Snowball                     = "snowball"
@@ -291,12 +298,14 @@ const (
 	Support                      = "support"
 	Synthetics                   = "synthetics"
 	Textract                     = "textract"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamQuery              = "timestreamquery"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	TranscribeStreaming          = "transcribestreaming"
 	Transfer                     = "transfer"
 	Translate                    = "translate"
+	VerifiedPermissions          = "verifiedpermissions"
 	VoiceID                      = "voiceid"
 	WAF                          = "waf"
 	WAFRegional                  = "wafregional"